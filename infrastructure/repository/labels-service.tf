@@ -181,7 +181,6 @@ variable "service_labels" {
     "lookoutmetrics",
     "lookoutvision",
     "machinelearning",
-    "macie",
     "macie2",
     "managedblockchain",
     "marketplacecatalog",
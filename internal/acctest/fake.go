@@ -0,0 +1,442 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// FakeAccountID and FakeRegion are the AWS account ID and Region of providers configured by a FakeBackend.
+	FakeAccountID = "123456789012"
+	FakeRegion    = "us-west-2" //lintignore:AWSAT003
+
+	fakePartition = "aws"
+)
+
+// FakeProtocol is the AWS API protocol of a request to a FakeBackend.
+type FakeProtocol int
+
+const (
+	// FakeProtocolREST is the REST-XML protocol used by S3.
+	FakeProtocolREST FakeProtocol = iota
+	// FakeProtocolQuery is the Query protocol used by, for example, SNS, IAM and STS.
+	FakeProtocolQuery
+	// FakeProtocolJSON is the JSON protocol used by, for example, DynamoDB and SQS.
+	FakeProtocolJSON
+)
+
+// FakeRequest is a request to a FakeBackend.
+type FakeRequest struct {
+	*http.Request
+
+	// Service is the request's SigV4 signing name, e.g. "s3" or "dynamodb".
+	Service string
+	// Operation is the API operation name, e.g. "CreateQueue".
+	Operation string
+	Protocol  FakeProtocol
+	Body      []byte
+	// Params are the form parameters of a Query protocol request.
+	Params url.Values
+
+	backend *FakeBackend
+}
+
+// FakeResponse is a response from a FakeBackend.
+type FakeResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// FakeOperationFunc answers a request to a FakeBackend.
+// Returning nil passes the request on to the next handler: an earlier scripted handler or the service's stateful fake.
+type FakeOperationFunc func(*FakeRequest) *FakeResponse
+
+// FakeService is a stateful fake of an AWS service.
+type FakeService interface {
+	// Handle answers the request, returning nil if the fake doesn't implement the operation.
+	Handle(*FakeRequest) *FakeResponse
+}
+
+// FakeBackend is a local stand-in for AWS service APIs, for unit testing resource CRUD logic without credentials.
+//
+// Providers configured by the backend send requests for every service to it.
+// Each request is answered by the handlers registered for its operation with On, most recent first,
+// and then by the stateful fake registered for its service.
+// Requests that nothing answers fail with a NotImplemented error.
+// Stateful fakes of S3, SQS, SNS, IAM, DynamoDB and STS are registered by default.
+type FakeBackend struct {
+	Server *httptest.Server
+
+	mu       sync.Mutex
+	calls    map[string]int
+	handlers map[string][]FakeOperationFunc
+	services map[string]FakeService
+
+	providerOnce sync.Once
+	provider     *schema.Provider
+}
+
+// NewFakeBackend starts a FakeBackend that is stopped when the test and its subtests complete.
+func NewFakeBackend(t *testing.T) *FakeBackend {
+	t.Helper()
+
+	b := &FakeBackend{
+		calls:    make(map[string]int),
+		handlers: make(map[string][]FakeOperationFunc),
+		services: make(map[string]FakeService),
+	}
+	b.Server = httptest.NewServer(b)
+	t.Cleanup(b.Server.Close)
+
+	b.RegisterService("dynamodb", newFakeDynamoDB())
+	b.RegisterService("iam", newFakeIAM())
+	b.RegisterService("s3", newFakeS3())
+	b.RegisterService("sns", newFakeSNS())
+	b.RegisterService("sqs", newFakeSQS(b))
+	b.RegisterService("sts", fakeSTS{})
+
+	return b
+}
+
+// RegisterService registers the stateful fake for the service with the specified SigV4 signing name, replacing any existing fake.
+func (b *FakeBackend) RegisterService(service string, fake FakeService) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.services[service] = fake
+}
+
+// On registers a handler for requests to the specified service and operation.
+// Handlers are run before the service's stateful fake, most recently registered first.
+func (b *FakeBackend) On(service, operation string, f FakeOperationFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	k := fakeOperationKey(service, operation)
+	b.handlers[k] = append(b.handlers[k], f)
+}
+
+// Calls returns the number of requests received for the specified service and operation.
+func (b *FakeBackend) Calls(service, operation string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.calls[fakeOperationKey(service, operation)]
+}
+
+// ProviderConfig returns the provider configuration used to send requests for every service to the backend.
+func (b *FakeBackend) ProviderConfig() map[string]any {
+	endpoints := make(map[string]any)
+
+	for _, v := range names.Aliases() {
+		endpoints[v] = b.Server.URL
+	}
+
+	return map[string]any{
+		"access_key":                  "mock_access_key",
+		"endpoints":                   []any{endpoints},
+		"max_retries":                 1,
+		"region":                      FakeRegion,
+		"s3_use_path_style":           true,
+		"secret_key":                  "mock_secret_key",
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+		"skip_credentials_validation": false,
+	}
+}
+
+// Provider returns a provider configured to send requests for every service to the backend.
+func (b *FakeBackend) Provider(t *testing.T) *schema.Provider {
+	t.Helper()
+
+	b.providerOnce.Do(func() {
+		ctx := context.Background()
+		p, err := provider.New(ctx)
+
+		if err != nil {
+			t.Fatalf("creating provider: %s", err)
+		}
+
+		if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(b.ProviderConfig())); diags.HasError() {
+			t.Fatalf("configuring provider: %v", diags)
+		}
+
+		b.provider = p
+	})
+
+	if b.provider == nil {
+		t.Fatal("provider not configured")
+	}
+
+	return b.provider
+}
+
+// Meta returns the client of a provider configured to send requests for every service to the backend.
+func (b *FakeBackend) Meta(t *testing.T) *conns.AWSClient {
+	t.Helper()
+
+	return b.Provider(t).Meta().(*conns.AWSClient)
+}
+
+// Context returns a context for running resource CRUD functions against the backend.
+// Waiters and retries keep their delays, so a resource's CRUD functions take at least as long as its waiters poll for.
+func (b *FakeBackend) Context(t *testing.T) context.Context {
+	return Context(t)
+}
+
+func (b *FakeBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := b.newRequest(r)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := b.handle(req)
+
+	for k, v := range response.Header {
+		w.Header()[k] = v
+	}
+	if w.Header().Get("X-Amzn-Requestid") == "" {
+		w.Header().Set("X-Amzn-Requestid", fakeRequestID)
+	}
+	if w.Header().Get("X-Amz-Request-Id") == "" {
+		w.Header().Set("X-Amz-Request-Id", fakeRequestID)
+	}
+
+	statusCode := response.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(response.Body)
+}
+
+func (b *FakeBackend) handle(r *FakeRequest) *FakeResponse {
+	k := fakeOperationKey(r.Service, r.Operation)
+
+	b.mu.Lock()
+	b.calls[k]++
+	handlers := append([]FakeOperationFunc(nil), b.handlers[k]...)
+	service := b.services[r.Service]
+	b.mu.Unlock()
+
+	for i := len(handlers) - 1; i >= 0; i-- {
+		if response := handlers[i](r); response != nil {
+			return response
+		}
+	}
+
+	if service != nil {
+		if response := service.Handle(r); response != nil {
+			return response
+		}
+	}
+
+	// 501 responses aren't retried by the AWS SDKs.
+	return r.Error(http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("%s.%s is not implemented by the fake backend", r.Service, r.Operation))
+}
+
+var fakeCredentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/\d{8}/[^/]+/([^/]+)/aws4_request`)
+
+func (b *FakeBackend) newRequest(r *http.Request) (*FakeRequest, error) {
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req := &FakeRequest{
+		Request: r,
+		Body:    body,
+		backend: b,
+	}
+
+	if m := fakeCredentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		req.Service = m[1]
+	}
+
+	switch {
+	case r.Header.Get("X-Amz-Target") != "":
+		req.Protocol = FakeProtocolJSON
+		_, req.Operation, _ = strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+	case r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded"):
+		req.Protocol = FakeProtocolQuery
+		req.Params, err = url.ParseQuery(string(body))
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.Operation = req.Params.Get("Action")
+	default:
+		req.Protocol = FakeProtocolREST
+
+		if req.Service == "s3" {
+			req.Operation = fakeS3Operation(r)
+		}
+	}
+
+	return req, nil
+}
+
+func fakeOperationKey(service, operation string) string {
+	return service + "." + operation
+}
+
+const fakeRequestID = "00000000-0000-0000-0000-000000000000"
+
+// ARN returns an ARN in the backend's partition, Region and account.
+// Global services should pass an empty Region.
+func (r *FakeRequest) ARN(region, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", fakePartition, r.Service, region, FakeAccountID, resource)
+}
+
+// BaseURL returns the backend's URL.
+func (r *FakeRequest) BaseURL() string {
+	return r.backend.Server.URL
+}
+
+// Error returns an error response in the request's protocol.
+func (r *FakeRequest) Error(statusCode int, code, message string) *FakeResponse {
+	var body []byte
+	header := make(http.Header)
+
+	switch r.Protocol {
+	case FakeProtocolJSON:
+		header.Set("Content-Type", "application/x-amz-json-1.0")
+		header.Set("X-Amzn-Errortype", code)
+		body, _ = json.Marshal(map[string]string{
+			"__type":  code,
+			"message": message,
+		})
+	case FakeProtocolQuery:
+		header.Set("Content-Type", "text/xml")
+		body = []byte(fmt.Sprintf("<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>",
+			fakeXMLEscape(code), fakeXMLEscape(message), fakeRequestID))
+	default:
+		header.Set("Content-Type", "application/xml")
+		// HEAD responses have no body, so REST-XML clients map the status code to an error code.
+		if r.Method != http.MethodHead {
+			body = []byte(fmt.Sprintf("<Error><Code>%s</Code><Message>%s</Message><RequestId>%s</RequestId></Error>",
+				fakeXMLEscape(code), fakeXMLEscape(message), fakeRequestID))
+		}
+	}
+
+	return &FakeResponse{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       body,
+	}
+}
+
+// JSONResult returns a JSON protocol response with `v` encoded as the body.
+func (r *FakeRequest) JSONResult(v any) *FakeResponse {
+	body, err := json.Marshal(v)
+
+	if err != nil {
+		return r.Error(http.StatusInternalServerError, "InternalFailure", err.Error())
+	}
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/x-amz-json-1.0")
+
+	return &FakeResponse{
+		Header: header,
+		Body:   body,
+	}
+}
+
+// QueryResult returns a Query protocol response whose result element contains the specified XML.
+func (r *FakeRequest) QueryResult(result string) *FakeResponse {
+	header := make(http.Header)
+	header.Set("Content-Type", "text/xml")
+
+	return &FakeResponse{
+		Header: header,
+		Body: []byte(fmt.Sprintf("<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>%[3]s</RequestId></ResponseMetadata></%[1]sResponse>",
+			r.Operation, result, fakeRequestID)),
+	}
+}
+
+// XMLResult returns a REST-XML protocol response with the specified XML document as the body.
+func (r *FakeRequest) XMLResult(document string) *FakeResponse {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/xml")
+
+	return &FakeResponse{
+		Header: header,
+		Body:   []byte(xml.Header + document),
+	}
+}
+
+// DecodeJSON decodes the body of a JSON protocol request into `v`.
+func (r *FakeRequest) DecodeJSON(v any) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(r.Body))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+// ParamList returns the values of a Query protocol list parameter, e.g. "AttributeName" for "AttributeName.1", "AttributeName.2".
+func (r *FakeRequest) ParamList(prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		k := prefix + "." + strconv.Itoa(i)
+
+		if !r.Params.Has(k) {
+			return values
+		}
+
+		values = append(values, r.Params.Get(k))
+	}
+}
+
+// ParamMap returns the entries of a Query protocol map or list of key-value structures,
+// e.g. "Attribute", "Name", "Value" for "Attribute.1.Name", "Attribute.1.Value".
+func (r *FakeRequest) ParamMap(prefix, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k := prefix + "." + strconv.Itoa(i) + "."
+
+		if !r.Params.Has(k + keyName) {
+			return m
+		}
+
+		m[r.Params.Get(k+keyName)] = r.Params.Get(k + valueName)
+	}
+}
+
+func fakeXMLEscape(s string) string {
+	var buf bytes.Buffer
+
+	_ = xml.EscapeText(&buf, []byte(s))
+
+	return buf.String()
+}
@@ -0,0 +1,319 @@
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+// fakeDynamoDB is a stateful fake of DynamoDB tables, their items, tags, point-in-time recovery and TTL settings.
+type fakeDynamoDB struct {
+	mu     sync.Mutex
+	tables map[string]*fakeTable
+}
+
+type fakeTable struct {
+	description map[string]any
+	items       []map[string]any
+	pitr        bool
+	ttl         map[string]any
+	tags        map[string]string
+}
+
+var _ FakeService = (*fakeDynamoDB)(nil)
+
+func newFakeDynamoDB() *fakeDynamoDB {
+	return &fakeDynamoDB{
+		tables: make(map[string]*fakeTable),
+	}
+}
+
+func (f *fakeDynamoDB) Handle(r *FakeRequest) *FakeResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var input map[string]any
+
+	if err := r.DecodeJSON(&input); err != nil {
+		return r.Error(http.StatusBadRequest, "SerializationException", err.Error())
+	}
+
+	switch r.Operation {
+	case "CreateTable":
+		return f.createTable(r, input)
+	case "ListTables":
+		return r.JSONResult(map[string]any{"TableNames": fakeSortedKeys(f.tables)})
+	case "ListTagsOfResource", "TagResource", "UntagResource":
+		table := f.tableByARN(input["ResourceArn"])
+
+		if table == nil {
+			return fakeDynamoDBResourceNotFound(r, input["ResourceArn"])
+		}
+
+		switch r.Operation {
+		case "ListTagsOfResource":
+			tags := []map[string]string{}
+			for _, k := range fakeSortedKeys(table.tags) {
+				tags = append(tags, map[string]string{"Key": k, "Value": table.tags[k]})
+			}
+
+			return r.JSONResult(map[string]any{"Tags": tags})
+		case "TagResource":
+			for k, v := range fakeDynamoDBTags(input["Tags"]) {
+				table.tags[k] = v
+			}
+		case "UntagResource":
+			keys, _ := input["TagKeys"].([]any)
+			for _, k := range keys {
+				delete(table.tags, fmt.Sprint(k))
+			}
+		}
+
+		return r.JSONResult(map[string]any{})
+	}
+
+	name, _ := input["TableName"].(string)
+	table, ok := f.tables[name]
+
+	if !ok {
+		return fakeDynamoDBResourceNotFound(r, name)
+	}
+
+	switch r.Operation {
+	case "DeleteItem":
+		if i := table.itemIndex(input["Key"]); i >= 0 {
+			table.items = append(table.items[:i], table.items[i+1:]...)
+		}
+
+		return r.JSONResult(map[string]any{})
+	case "DeleteTable":
+		delete(f.tables, name)
+		table.description["TableStatus"] = "DELETING"
+
+		return r.JSONResult(map[string]any{"TableDescription": table.description})
+	case "DescribeContinuousBackups":
+		return r.JSONResult(map[string]any{"ContinuousBackupsDescription": table.continuousBackupsDescription()})
+	case "DescribeKinesisStreamingDestination":
+		return r.JSONResult(map[string]any{
+			"KinesisDataStreamDestinations": []any{},
+			"TableName":                     name,
+		})
+	case "DescribeTable":
+		return r.JSONResult(map[string]any{"Table": table.description})
+	case "DescribeTimeToLive":
+		return r.JSONResult(map[string]any{"TimeToLiveDescription": table.ttl})
+	case "GetItem":
+		if i := table.itemIndex(input["Key"]); i >= 0 {
+			return r.JSONResult(map[string]any{"Item": table.items[i]})
+		}
+
+		return r.JSONResult(map[string]any{})
+	case "PutItem":
+		item, _ := input["Item"].(map[string]any)
+		key := make(map[string]any)
+
+		for _, v := range table.keyAttributeNames() {
+			key[v] = item[v]
+		}
+
+		if i := table.itemIndex(key); i >= 0 {
+			table.items[i] = item
+		} else {
+			table.items = append(table.items, item)
+		}
+
+		return r.JSONResult(map[string]any{})
+	case "UpdateContinuousBackups":
+		if v, ok := input["PointInTimeRecoverySpecification"].(map[string]any); ok {
+			table.pitr, _ = v["PointInTimeRecoveryEnabled"].(bool)
+		}
+
+		return r.JSONResult(map[string]any{"ContinuousBackupsDescription": table.continuousBackupsDescription()})
+	case "UpdateTable":
+		for _, k := range []string{"AttributeDefinitions", "ProvisionedThroughput", "SSESpecification", "StreamSpecification", "TableClass"} {
+			if v, ok := input[k]; ok {
+				table.description[k] = v
+			}
+		}
+
+		if v, ok := input["BillingMode"]; ok {
+			table.description["BillingModeSummary"] = map[string]any{"BillingMode": v}
+		}
+
+		return r.JSONResult(map[string]any{"TableDescription": table.description})
+	case "UpdateTimeToLive":
+		spec, _ := input["TimeToLiveSpecification"].(map[string]any)
+
+		if enabled, _ := spec["Enabled"].(bool); enabled {
+			table.ttl = map[string]any{"AttributeName": spec["AttributeName"], "TimeToLiveStatus": "ENABLED"}
+		} else {
+			table.ttl = map[string]any{"TimeToLiveStatus": "DISABLED"}
+		}
+
+		return r.JSONResult(map[string]any{"TimeToLiveSpecification": spec})
+	}
+
+	return nil
+}
+
+func (f *fakeDynamoDB) createTable(r *FakeRequest, input map[string]any) *FakeResponse {
+	name, _ := input["TableName"].(string)
+
+	if _, ok := f.tables[name]; ok {
+		return r.Error(http.StatusBadRequest, "ResourceInUseException", fmt.Sprintf("Table already exists: %s", name))
+	}
+
+	arn := r.ARN(FakeRegion, "table/"+name)
+	description := map[string]any{
+		"CreationDateTime": 1672531200,
+		"ItemCount":        0,
+		"TableArn":         arn,
+		"TableId":          fmt.Sprintf("00000000-0000-0000-0000-%012d", len(f.tables)+1),
+		"TableName":        name,
+		"TableSizeBytes":   0,
+		"TableStatus":      "ACTIVE",
+	}
+
+	for _, k := range []string{"AttributeDefinitions", "KeySchema", "ProvisionedThroughput", "StreamSpecification", "TableClass"} {
+		if v, ok := input[k]; ok {
+			description[k] = v
+		}
+	}
+
+	billingMode := "PROVISIONED"
+	if v, ok := input["BillingMode"].(string); ok {
+		billingMode = v
+	}
+	description["BillingModeSummary"] = map[string]any{"BillingMode": billingMode}
+
+	for _, k := range []string{"GlobalSecondaryIndexes", "LocalSecondaryIndexes"} {
+		indexes, _ := input[k].([]any)
+
+		for _, v := range indexes {
+			if index, ok := v.(map[string]any); ok {
+				index["IndexArn"] = fmt.Sprintf("%s/index/%s", arn, index["IndexName"])
+				if k == "GlobalSecondaryIndexes" {
+					index["IndexStatus"] = "ACTIVE"
+				}
+			}
+		}
+
+		if len(indexes) > 0 {
+			description[k] = indexes
+		}
+	}
+
+	if v, ok := input["StreamSpecification"].(map[string]any); ok {
+		if enabled, _ := v["StreamEnabled"].(bool); enabled {
+			description["LatestStreamArn"] = arn + "/stream/2023-01-01T00:00:00.000"
+			description["LatestStreamLabel"] = "2023-01-01T00:00:00.000"
+		}
+	}
+
+	if v, ok := input["SSESpecification"].(map[string]any); ok {
+		if enabled, _ := v["Enabled"].(bool); enabled {
+			description["SSEDescription"] = map[string]any{"SSEType": "KMS", "Status": "ENABLED", "KMSMasterKeyArn": v["KMSMasterKeyId"]}
+		}
+	}
+
+	table := &fakeTable{
+		description: description,
+		ttl:         map[string]any{"TimeToLiveStatus": "DISABLED"},
+		tags:        fakeDynamoDBTags(input["Tags"]),
+	}
+	f.tables[name] = table
+
+	return r.JSONResult(map[string]any{"TableDescription": description})
+}
+
+func (f *fakeDynamoDB) tableByARN(arn any) *fakeTable {
+	for _, table := range f.tables {
+		if table.description["TableArn"] == arn {
+			return table
+		}
+	}
+
+	return nil
+}
+
+func (t *fakeTable) continuousBackupsDescription() map[string]any {
+	status := "DISABLED"
+	if t.pitr {
+		status = "ENABLED"
+	}
+
+	return map[string]any{
+		"ContinuousBackupsStatus": "ENABLED",
+		"PointInTimeRecoveryDescription": map[string]any{
+			"PointInTimeRecoveryStatus": status,
+		},
+	}
+}
+
+func (t *fakeTable) keyAttributeNames() []string {
+	var names []string
+
+	keySchema, _ := t.description["KeySchema"].([]any)
+	for _, v := range keySchema {
+		if element, ok := v.(map[string]any); ok {
+			names = append(names, fmt.Sprint(element["AttributeName"]))
+		}
+	}
+
+	return names
+}
+
+// itemIndex returns the index of the item with the specified key, or -1 if there is none.
+func (t *fakeTable) itemIndex(key any) int {
+	k, _ := key.(map[string]any)
+
+	for i, item := range t.items {
+		match := true
+
+		for _, name := range t.keyAttributeNames() {
+			if !reflect.DeepEqual(fakeNormalizeJSON(item[name]), fakeNormalizeJSON(k[name])) {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func fakeDynamoDBTags(v any) map[string]string {
+	tags := make(map[string]string)
+
+	list, _ := v.([]any)
+	for _, v := range list {
+		if tag, ok := v.(map[string]any); ok {
+			tags[fmt.Sprint(tag["Key"])] = fmt.Sprint(tag["Value"])
+		}
+	}
+
+	return tags
+}
+
+func fakeDynamoDBResourceNotFound(r *FakeRequest, name any) *FakeResponse {
+	return r.Error(http.StatusBadRequest, "ResourceNotFoundException", fmt.Sprintf("Requested resource not found: Table: %v not found", name))
+}
+
+// fakeNormalizeJSON returns `v` after a round trip through JSON, so that values decoded from different requests compare equal.
+func fakeNormalizeJSON(v any) any {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return v
+	}
+
+	var normalized any
+	_ = json.Unmarshal(b, &normalized)
+
+	return normalized
+}
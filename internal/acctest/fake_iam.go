@@ -0,0 +1,211 @@
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// fakeIAM is a stateful fake of IAM roles and their inline policies, attached managed policies and tags.
+type fakeIAM struct {
+	mu    sync.Mutex
+	roles map[string]*fakeRole
+}
+
+type fakeRole struct {
+	id                       string
+	path                     string
+	assumeRolePolicyDocument string
+	description              string
+	maxSessionDuration       string
+	permissionsBoundary      string
+	inlinePolicies           map[string]string
+	attachedPolicies         map[string]bool
+	tags                     map[string]string
+}
+
+var _ FakeService = (*fakeIAM)(nil)
+
+func newFakeIAM() *fakeIAM {
+	return &fakeIAM{
+		roles: make(map[string]*fakeRole),
+	}
+}
+
+func (f *fakeIAM) Handle(r *FakeRequest) *FakeResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Operation {
+	case "CreateRole":
+		name := r.Params.Get("RoleName")
+
+		if _, ok := f.roles[name]; ok {
+			return r.Error(http.StatusConflict, "EntityAlreadyExists", fmt.Sprintf("Role with name %s already exists.", name))
+		}
+
+		role := &fakeRole{
+			id:                       fmt.Sprintf("AROA%016d", len(f.roles)+1),
+			path:                     r.Params.Get("Path"),
+			assumeRolePolicyDocument: r.Params.Get("AssumeRolePolicyDocument"),
+			description:              r.Params.Get("Description"),
+			maxSessionDuration:       r.Params.Get("MaxSessionDuration"),
+			permissionsBoundary:      r.Params.Get("PermissionsBoundary"),
+			inlinePolicies:           make(map[string]string),
+			attachedPolicies:         make(map[string]bool),
+			tags:                     r.ParamMap("Tags.member", "Key", "Value"),
+		}
+		if role.path == "" {
+			role.path = "/"
+		}
+		if role.maxSessionDuration == "" {
+			role.maxSessionDuration = "3600"
+		}
+
+		f.roles[name] = role
+
+		return r.QueryResult(f.roleXML(r, name, role))
+	case "ListRoles":
+		var result strings.Builder
+		result.WriteString("<Roles>")
+		for _, name := range fakeSortedKeys(f.roles) {
+			fmt.Fprintf(&result, "<member>%s</member>", strings.TrimSuffix(strings.TrimPrefix(f.roleXML(r, name, f.roles[name]), "<Role>"), "</Role>"))
+		}
+		result.WriteString("</Roles><IsTruncated>false</IsTruncated>")
+
+		return r.QueryResult(result.String())
+	}
+
+	name := r.Params.Get("RoleName")
+	role, ok := f.roles[name]
+
+	if !ok {
+		if strings.Contains(r.Operation, "Role") {
+			return r.Error(http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role with name %s cannot be found.", name))
+		}
+
+		return nil
+	}
+
+	switch r.Operation {
+	case "AttachRolePolicy":
+		role.attachedPolicies[r.Params.Get("PolicyArn")] = true
+	case "DeleteRole":
+		if len(role.inlinePolicies) > 0 || len(role.attachedPolicies) > 0 {
+			return r.Error(http.StatusConflict, "DeleteConflict", "Cannot delete entity, must delete policies first.")
+		}
+
+		delete(f.roles, name)
+	case "DeleteRolePermissionsBoundary":
+		role.permissionsBoundary = ""
+	case "DeleteRolePolicy":
+		policyName := r.Params.Get("PolicyName")
+
+		if _, ok := role.inlinePolicies[policyName]; !ok {
+			return r.Error(http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role policy with name %s cannot be found.", policyName))
+		}
+
+		delete(role.inlinePolicies, policyName)
+	case "DetachRolePolicy":
+		policyARN := r.Params.Get("PolicyArn")
+
+		if !role.attachedPolicies[policyARN] {
+			return r.Error(http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("Policy %s was not found.", policyARN))
+		}
+
+		delete(role.attachedPolicies, policyARN)
+	case "GetRole":
+		return r.QueryResult(f.roleXML(r, name, role))
+	case "GetRolePolicy":
+		policyName := r.Params.Get("PolicyName")
+		document, ok := role.inlinePolicies[policyName]
+
+		if !ok {
+			return r.Error(http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role policy with name %s cannot be found.", policyName))
+		}
+
+		return r.QueryResult(fmt.Sprintf("<RoleName>%s</RoleName><PolicyName>%s</PolicyName><PolicyDocument>%s</PolicyDocument>",
+			fakeXMLEscape(name), fakeXMLEscape(policyName), fakeXMLEscape(url.QueryEscape(document))))
+	case "ListAttachedRolePolicies":
+		var result strings.Builder
+		result.WriteString("<AttachedPolicies>")
+		for _, arn := range fakeSortedKeys(role.attachedPolicies) {
+			fmt.Fprintf(&result, "<member><PolicyArn>%s</PolicyArn><PolicyName>%s</PolicyName></member>", fakeXMLEscape(arn), fakeXMLEscape(arn[strings.LastIndex(arn, "/")+1:]))
+		}
+		result.WriteString("</AttachedPolicies><IsTruncated>false</IsTruncated>")
+
+		return r.QueryResult(result.String())
+	case "ListInstanceProfilesForRole":
+		return r.QueryResult("<InstanceProfiles></InstanceProfiles><IsTruncated>false</IsTruncated>")
+	case "ListRolePolicies":
+		var result strings.Builder
+		result.WriteString("<PolicyNames>")
+		for _, policyName := range fakeSortedKeys(role.inlinePolicies) {
+			fmt.Fprintf(&result, "<member>%s</member>", fakeXMLEscape(policyName))
+		}
+		result.WriteString("</PolicyNames><IsTruncated>false</IsTruncated>")
+
+		return r.QueryResult(result.String())
+	case "ListRoleTags":
+		return r.QueryResult("<Tags>" + fakeQueryEntries(role.tags, "member", "Key", "Value") + "</Tags><IsTruncated>false</IsTruncated>")
+	case "PutRolePermissionsBoundary":
+		role.permissionsBoundary = r.Params.Get("PermissionsBoundary")
+	case "PutRolePolicy":
+		role.inlinePolicies[r.Params.Get("PolicyName")] = r.Params.Get("PolicyDocument")
+	case "TagRole":
+		for k, v := range r.ParamMap("Tags.member", "Key", "Value") {
+			role.tags[k] = v
+		}
+	case "UntagRole":
+		for _, k := range r.ParamList("TagKeys.member") {
+			delete(role.tags, k)
+		}
+	case "UpdateAssumeRolePolicy":
+		role.assumeRolePolicyDocument = r.Params.Get("PolicyDocument")
+	case "UpdateRole":
+		if r.Params.Has("Description") {
+			role.description = r.Params.Get("Description")
+		}
+		if r.Params.Has("MaxSessionDuration") {
+			role.maxSessionDuration = r.Params.Get("MaxSessionDuration")
+		}
+	case "UpdateRoleDescription":
+		role.description = r.Params.Get("Description")
+
+		return r.QueryResult(f.roleXML(r, name, role))
+	default:
+		return nil
+	}
+
+	return r.QueryResult("")
+}
+
+// roleXML returns the Query protocol XML of a role.
+// As in IAM, the assume role policy document is URL-encoded.
+func (f *fakeIAM) roleXML(r *FakeRequest, name string, role *fakeRole) string {
+	var result strings.Builder
+
+	result.WriteString("<Role>")
+	fmt.Fprintf(&result, "<Path>%s</Path>", fakeXMLEscape(role.path))
+	fmt.Fprintf(&result, "<RoleName>%s</RoleName>", fakeXMLEscape(name))
+	fmt.Fprintf(&result, "<RoleId>%s</RoleId>", role.id)
+	fmt.Fprintf(&result, "<Arn>%s</Arn>", fakeXMLEscape(r.ARN("", "role"+role.path+name)))
+	result.WriteString("<CreateDate>2023-01-01T00:00:00Z</CreateDate>")
+	fmt.Fprintf(&result, "<AssumeRolePolicyDocument>%s</AssumeRolePolicyDocument>", fakeXMLEscape(url.QueryEscape(role.assumeRolePolicyDocument)))
+	if role.description != "" {
+		fmt.Fprintf(&result, "<Description>%s</Description>", fakeXMLEscape(role.description))
+	}
+	fmt.Fprintf(&result, "<MaxSessionDuration>%s</MaxSessionDuration>", role.maxSessionDuration)
+	if role.permissionsBoundary != "" {
+		fmt.Fprintf(&result, "<PermissionsBoundary><PermissionsBoundaryType>Policy</PermissionsBoundaryType><PermissionsBoundaryArn>%s</PermissionsBoundaryArn></PermissionsBoundary>", fakeXMLEscape(role.permissionsBoundary))
+	}
+	if len(role.tags) > 0 {
+		result.WriteString("<Tags>" + fakeQueryEntries(role.tags, "member", "Key", "Value") + "</Tags>")
+	}
+	result.WriteString("<RoleLastUsed/>")
+	result.WriteString("</Role>")
+
+	return result.String()
+}
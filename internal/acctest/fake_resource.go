@@ -0,0 +1,192 @@
+package acctest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// FakeResource runs the CRUD functions of a Terraform Plugin SDK resource against a FakeBackend
+// in the way that Terraform does, keeping the resulting state between calls.
+//
+// The resource is the provider's registered implementation, including the provider's handler wrappers and interceptors.
+type FakeResource struct {
+	Resource *schema.Resource
+	// State is the resource's current state, nil if it doesn't exist.
+	State *terraform.InstanceState
+
+	backend *FakeBackend
+	t       *testing.T
+}
+
+// Resource returns a FakeResource for the specified Terraform resource type.
+func (b *FakeBackend) Resource(t *testing.T, typeName string) *FakeResource {
+	t.Helper()
+
+	r, ok := b.Provider(t).ResourcesMap[typeName]
+
+	if !ok {
+		t.Fatalf("resource type %s is not a Terraform Plugin SDK resource", typeName)
+	}
+
+	return &FakeResource{
+		Resource: r,
+		backend:  b,
+		t:        t,
+	}
+}
+
+// Apply plans and applies the specified configuration, creating the resource if it doesn't exist and otherwise updating or replacing it.
+func (r *FakeResource) Apply(config map[string]any) diag.Diagnostics {
+	r.t.Helper()
+
+	ctx := r.backend.Context(r.t)
+	meta := r.backend.Meta(r.t)
+
+	rawConfig, err := r.rawConfig(config)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if r.State != nil {
+		r.State.RawConfig = rawConfig
+	}
+
+	diff, err := r.Resource.Diff(ctx, r.State, terraform.NewResourceConfigRaw(config), meta)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diff == nil || diff.Empty() {
+		return nil
+	}
+
+	if diff.RequiresNew() && r.State != nil {
+		if diags := r.Destroy(); diags.HasError() {
+			return diags
+		}
+
+		return r.Apply(config)
+	}
+
+	diff.RawConfig = rawConfig
+
+	state, diags := r.Resource.Apply(ctx, r.State, diff, meta)
+
+	if state != nil && state.ID == "" {
+		state = nil
+	}
+
+	if state != nil {
+		state.RawConfig = rawConfig
+	}
+
+	r.State = state
+
+	return diags
+}
+
+// Read refreshes the resource's state, which is set to nil if the resource no longer exists.
+func (r *FakeResource) Read() diag.Diagnostics {
+	r.t.Helper()
+
+	if r.State == nil {
+		return nil
+	}
+
+	state, diags := r.Resource.RefreshWithoutUpgrade(r.backend.Context(r.t), r.State, r.backend.Meta(r.t))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if state != nil && state.ID == "" {
+		state = nil
+	}
+
+	r.State = state
+
+	return diags
+}
+
+// Import imports the resource with the specified import ID and reads its state.
+func (r *FakeResource) Import(importID string) diag.Diagnostics {
+	r.t.Helper()
+
+	d := r.Resource.Data(nil)
+	d.SetId(importID)
+
+	if v := r.Resource.Importer; v != nil && v.StateContext != nil {
+		ds, err := v.StateContext(r.backend.Context(r.t), d, r.backend.Meta(r.t))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(ds) == 0 {
+			r.State = nil
+
+			return nil
+		}
+
+		d = ds[0]
+	}
+
+	r.State = d.State()
+
+	return r.Read()
+}
+
+// Destroy deletes the resource.
+func (r *FakeResource) Destroy() diag.Diagnostics {
+	r.t.Helper()
+
+	if r.State == nil {
+		return nil
+	}
+
+	_, diags := r.Resource.Apply(r.backend.Context(r.t), r.State, &terraform.InstanceDiff{Destroy: true}, r.backend.Meta(r.t))
+
+	if !diags.HasError() {
+		r.State = nil
+	}
+
+	return diags
+}
+
+// ID returns the resource's ID, or an empty string if it doesn't exist.
+func (r *FakeResource) ID() string {
+	if r.State == nil {
+		return ""
+	}
+
+	return r.State.ID
+}
+
+// Attr returns the value of the specified flatmapped attribute in the resource's state, e.g. "tags.Name" or "tags.%".
+func (r *FakeResource) Attr(key string) string {
+	if r.State == nil {
+		return ""
+	}
+
+	return r.State.Attributes[key]
+}
+
+// rawConfig returns the configuration as the value that Terraform sends to providers,
+// which resources read with schema.ResourceData.GetRawConfig.
+func (r *FakeResource) rawConfig(config map[string]any) (cty.Value, error) {
+	b, err := json.Marshal(config)
+
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	return ctyjson.Unmarshal(b, r.Resource.CoreConfigSchema().ImpliedType())
+}
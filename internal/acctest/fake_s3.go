@@ -0,0 +1,433 @@
+package acctest
+
+import (
+	"crypto/md5" // nosemgrep:ci.crypto-weak-hash
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// fakeS3BucketSubresources maps the query string subresources of S3 bucket requests to the names of their operations.
+// Get, Put and Delete operations are named by prefixing the verb, e.g. GetBucketCors.
+var fakeS3BucketSubresources = map[string]string{
+	"accelerate":        "BucketAccelerateConfiguration",
+	"acl":               "BucketAcl",
+	"cors":              "BucketCors",
+	"encryption":        "BucketEncryption",
+	"lifecycle":         "BucketLifecycleConfiguration",
+	"logging":           "BucketLogging",
+	"notification":      "BucketNotificationConfiguration",
+	"object-lock":       "ObjectLockConfiguration",
+	"ownershipControls": "BucketOwnershipControls",
+	"policy":            "BucketPolicy",
+	"publicAccessBlock": "PublicAccessBlock",
+	"replication":       "BucketReplication",
+	"requestPayment":    "BucketRequestPayment",
+	"tagging":           "BucketTagging",
+	"versioning":        "BucketVersioning",
+	"website":           "BucketWebsite",
+}
+
+// fakeS3UnconfiguredSubresources are the errors returned when getting bucket subresources that haven't been configured.
+var fakeS3UnconfiguredSubresources = map[string]string{
+	"cors":              "NoSuchCORSConfiguration",
+	"lifecycle":         "NoSuchLifecycleConfiguration",
+	"object-lock":       "ObjectLockConfigurationNotFoundError",
+	"ownershipControls": "OwnershipControlsNotFoundError",
+	"policy":            "NoSuchBucketPolicy",
+	"publicAccessBlock": "NoSuchPublicAccessBlockConfiguration",
+	"replication":       "ReplicationConfigurationNotFoundError",
+	"tagging":           "NoSuchTagSet",
+	"website":           "NoSuchWebsiteConfiguration",
+}
+
+// fakeS3DefaultSubresources are the documents returned when getting bucket subresources that have default values.
+var fakeS3DefaultSubresources = map[string]string{
+	"accelerate":     `<AccelerateConfiguration/>`,
+	"acl":            `<AccessControlPolicy><Owner><ID>fake</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>fake</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`,
+	"encryption":     `<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`,
+	"logging":        `<BucketLoggingStatus/>`,
+	"notification":   `<NotificationConfiguration/>`,
+	"requestPayment": `<RequestPaymentConfiguration><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`,
+	"versioning":     `<VersioningConfiguration/>`,
+}
+
+// fakeS3ObjectHeaders are the request headers stored with objects and returned by HeadObject and GetObject.
+var fakeS3ObjectHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Type",
+	"Expires",
+	"X-Amz-Server-Side-Encryption",
+	"X-Amz-Storage-Class",
+	"X-Amz-Website-Redirect-Location",
+}
+
+// fakeS3Operation returns the name of the operation of a path-style S3 request.
+func fakeS3Operation(r *http.Request) string {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	verb := map[string]string{
+		http.MethodDelete: "Delete",
+		http.MethodGet:    "Get",
+		http.MethodHead:   "Head",
+		http.MethodPut:    "Put",
+	}[r.Method]
+
+	if bucket == "" {
+		return "ListBuckets"
+	}
+
+	if key != "" {
+		for _, v := range []string{"acl", "legal-hold", "retention", "tagging"} {
+			if query.Has(v) {
+				name := map[string]string{"acl": "Acl", "legal-hold": "LegalHold", "retention": "Retention", "tagging": "Tagging"}[v]
+
+				return verb + "Object" + name
+			}
+		}
+
+		if r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "" {
+			return "CopyObject"
+		}
+
+		return verb + "Object"
+	}
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("delete"):
+		return "DeleteObjects"
+	case r.Method == http.MethodGet && query.Has("location"):
+		return "GetBucketLocation"
+	case r.Method == http.MethodGet && query.Has("versions"):
+		return "ListObjectVersions"
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		return "ListObjectsV2"
+	}
+
+	for k, v := range fakeS3BucketSubresources {
+		if query.Has(k) {
+			if r.Method == http.MethodDelete && k == "lifecycle" {
+				return "DeleteBucketLifecycle"
+			}
+
+			return verb + v
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return "ListObjects"
+	case http.MethodPut:
+		return "CreateBucket"
+	}
+
+	return verb + "Bucket"
+}
+
+// fakeS3 is a stateful fake of S3 buckets, their configuration and objects.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]*fakeBucket
+}
+
+type fakeBucket struct {
+	// The documents last put for bucket subresources, keyed by subresource.
+	subresources map[string][]byte
+	objects      map[string]*fakeObject
+}
+
+type fakeObject struct {
+	body     []byte
+	etag     string
+	header   http.Header
+	metadata map[string]string
+	tags     []byte
+}
+
+var _ FakeService = (*fakeS3)(nil)
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		buckets: make(map[string]*fakeBucket),
+	}
+}
+
+func (f *fakeS3) Handle(r *FakeRequest) *FakeResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	name, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	switch r.Operation {
+	case "CreateBucket":
+		if _, ok := f.buckets[name]; ok {
+			return r.Error(http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+		}
+
+		f.buckets[name] = &fakeBucket{
+			subresources: make(map[string][]byte),
+			objects:      make(map[string]*fakeObject),
+		}
+
+		return &FakeResponse{Header: http.Header{"Location": []string{"/" + name}}}
+	case "ListBuckets":
+		var result strings.Builder
+		result.WriteString("<ListAllMyBucketsResult><Owner><ID>fake</ID></Owner><Buckets>")
+		for _, v := range fakeSortedKeys(f.buckets) {
+			fmt.Fprintf(&result, "<Bucket><Name>%s</Name><CreationDate>2023-01-01T00:00:00.000Z</CreationDate></Bucket>", fakeXMLEscape(v))
+		}
+		result.WriteString("</Buckets></ListAllMyBucketsResult>")
+
+		return r.XMLResult(result.String())
+	}
+
+	bucket, ok := f.buckets[name]
+
+	if !ok {
+		return r.Error(http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+	}
+
+	if key != "" {
+		return f.handleObject(r, bucket, key)
+	}
+
+	switch r.Operation {
+	case "DeleteBucket":
+		if len(bucket.objects) > 0 {
+			return r.Error(http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+		}
+
+		delete(f.buckets, name)
+
+		return &FakeResponse{StatusCode: http.StatusNoContent}
+	case "DeleteObjects":
+		var input struct {
+			Objects []struct {
+				Key string
+			} `xml:"Object"`
+		}
+
+		if err := xml.Unmarshal(r.Body, &input); err != nil {
+			return r.Error(http.StatusBadRequest, "MalformedXML", err.Error())
+		}
+
+		var result strings.Builder
+		result.WriteString("<DeleteResult>")
+		for _, v := range input.Objects {
+			delete(bucket.objects, v.Key)
+			fmt.Fprintf(&result, "<Deleted><Key>%s</Key></Deleted>", fakeXMLEscape(v.Key))
+		}
+		result.WriteString("</DeleteResult>")
+
+		return r.XMLResult(result.String())
+	case "GetBucketLocation":
+		return r.XMLResult(fmt.Sprintf("<LocationConstraint>%s</LocationConstraint>", FakeRegion))
+	case "HeadBucket":
+		return &FakeResponse{Header: http.Header{"X-Amz-Bucket-Region": []string{FakeRegion}}}
+	case "ListObjects", "ListObjectsV2", "ListObjectVersions":
+		return f.listObjects(r, name, bucket)
+	}
+
+	for k := range fakeS3BucketSubresources {
+		if !r.URL.Query().Has(k) {
+			continue
+		}
+
+		switch r.Method {
+		case http.MethodDelete:
+			delete(bucket.subresources, k)
+
+			return &FakeResponse{StatusCode: http.StatusNoContent}
+		case http.MethodGet:
+			if v, ok := bucket.subresources[k]; ok {
+				// Bucket policies are JSON documents.
+				if k == "policy" {
+					return &FakeResponse{Body: v}
+				}
+
+				return r.XMLResult(string(v))
+			}
+
+			if v, ok := fakeS3DefaultSubresources[k]; ok {
+				return r.XMLResult(v)
+			}
+
+			return r.Error(http.StatusNotFound, fakeS3UnconfiguredSubresources[k], fmt.Sprintf("The %s configuration does not exist", k))
+		case http.MethodPut:
+			bucket.subresources[k] = r.Body
+
+			return &FakeResponse{}
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeS3) handleObject(r *FakeRequest, bucket *fakeBucket, key string) *FakeResponse {
+	if r.Operation == "PutObject" || r.Operation == "CopyObject" {
+		object := &fakeObject{
+			body:     r.Body,
+			header:   make(http.Header),
+			metadata: make(map[string]string),
+		}
+
+		if r.Operation == "CopyObject" {
+			source, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+
+			if err != nil {
+				return r.Error(http.StatusBadRequest, "InvalidArgument", err.Error())
+			}
+
+			sourceBucket, sourceKey, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+
+			if v, ok := f.buckets[sourceBucket]; !ok || v.objects[sourceKey] == nil {
+				return r.Error(http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			} else {
+				object.body = v.objects[sourceKey].body
+			}
+		}
+
+		sum := md5.Sum(object.body) // nosemgrep:ci.crypto-weak-hash
+		object.etag = `"` + hex.EncodeToString(sum[:]) + `"`
+
+		for _, k := range fakeS3ObjectHeaders {
+			if v := r.Header.Get(k); v != "" {
+				object.header.Set(k, v)
+			}
+		}
+
+		for k, v := range r.Header {
+			if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+				object.metadata[k] = v[0]
+			}
+		}
+
+		if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+			tags, err := url.ParseQuery(v)
+
+			if err != nil {
+				return r.Error(http.StatusBadRequest, "InvalidArgument", err.Error())
+			}
+
+			object.tags = fakeS3Tagging(tags)
+		}
+
+		bucket.objects[key] = object
+
+		if r.Operation == "CopyObject" {
+			return r.XMLResult(fmt.Sprintf("<CopyObjectResult><ETag>%s</ETag><LastModified>2023-01-01T00:00:00.000Z</LastModified></CopyObjectResult>", fakeXMLEscape(object.etag)))
+		}
+
+		return &FakeResponse{Header: http.Header{"Etag": []string{object.etag}}}
+	}
+
+	object, ok := bucket.objects[key]
+
+	if !ok {
+		if r.Operation == "DeleteObject" {
+			return &FakeResponse{StatusCode: http.StatusNoContent}
+		}
+
+		return r.Error(http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+	}
+
+	switch r.Operation {
+	case "DeleteObject":
+		delete(bucket.objects, key)
+
+		return &FakeResponse{StatusCode: http.StatusNoContent}
+	case "DeleteObjectTagging":
+		object.tags = nil
+
+		return &FakeResponse{StatusCode: http.StatusNoContent}
+	case "GetObject", "HeadObject":
+		header := object.header.Clone()
+		header.Set("Content-Length", strconv.Itoa(len(object.body)))
+		header.Set("Etag", object.etag)
+		header.Set("Last-Modified", "Sun, 01 Jan 2023 00:00:00 GMT")
+		for k, v := range object.metadata {
+			header.Set(k, v)
+		}
+
+		response := &FakeResponse{Header: header}
+
+		if r.Operation == "GetObject" {
+			response.Body = object.body
+		}
+
+		return response
+	case "GetObjectAcl":
+		return r.XMLResult(fakeS3DefaultSubresources["acl"])
+	case "GetObjectTagging":
+		if object.tags == nil {
+			return r.XMLResult("<Tagging><TagSet></TagSet></Tagging>")
+		}
+
+		return r.XMLResult(string(object.tags))
+	case "PutObjectAcl":
+		return &FakeResponse{}
+	case "PutObjectTagging":
+		object.tags = r.Body
+
+		return &FakeResponse{}
+	}
+
+	return nil
+}
+
+func (f *fakeS3) listObjects(r *FakeRequest, name string, bucket *fakeBucket) *FakeResponse {
+	prefix := r.URL.Query().Get("prefix")
+
+	var keys []string
+	for _, k := range fakeSortedKeys(bucket.objects) {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+
+	var result strings.Builder
+
+	if r.Operation == "ListObjectVersions" {
+		fmt.Fprintf(&result, "<ListVersionsResult><Name>%s</Name><Prefix>%s</Prefix><IsTruncated>false</IsTruncated>", fakeXMLEscape(name), fakeXMLEscape(prefix))
+		for _, k := range keys {
+			object := bucket.objects[k]
+			fmt.Fprintf(&result, "<Version><Key>%s</Key><VersionId>null</VersionId><IsLatest>true</IsLatest><LastModified>2023-01-01T00:00:00.000Z</LastModified><ETag>%s</ETag><Size>%d</Size><StorageClass>STANDARD</StorageClass></Version>",
+				fakeXMLEscape(k), fakeXMLEscape(object.etag), len(object.body))
+		}
+		result.WriteString("</ListVersionsResult>")
+
+		return r.XMLResult(result.String())
+	}
+
+	fmt.Fprintf(&result, "<ListBucketResult><Name>%s</Name><Prefix>%s</Prefix><KeyCount>%d</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated>", fakeXMLEscape(name), fakeXMLEscape(prefix), len(keys))
+	for _, k := range keys {
+		object := bucket.objects[k]
+		fmt.Fprintf(&result, "<Contents><Key>%s</Key><LastModified>2023-01-01T00:00:00.000Z</LastModified><ETag>%s</ETag><Size>%d</Size><StorageClass>STANDARD</StorageClass></Contents>",
+			fakeXMLEscape(k), fakeXMLEscape(object.etag), len(object.body))
+	}
+	result.WriteString("</ListBucketResult>")
+
+	return r.XMLResult(result.String())
+}
+
+// fakeS3Tagging returns the S3 Tagging XML document of a set of tags.
+func fakeS3Tagging(tags url.Values) []byte {
+	var result strings.Builder
+
+	result.WriteString("<Tagging><TagSet>")
+	for _, k := range fakeSortedKeys(tags) {
+		fmt.Fprintf(&result, "<Tag><Key>%s</Key><Value>%s</Value></Tag>", fakeXMLEscape(k), fakeXMLEscape(tags.Get(k)))
+	}
+	result.WriteString("</TagSet></Tagging>")
+
+	return []byte(result.String())
+}
@@ -0,0 +1,122 @@
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// fakeSNS is a stateful fake of SNS topics and their attributes and tags.
+type fakeSNS struct {
+	mu     sync.Mutex
+	topics map[string]*fakeTopic
+}
+
+type fakeTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+var _ FakeService = (*fakeSNS)(nil)
+
+func newFakeSNS() *fakeSNS {
+	return &fakeSNS{
+		topics: make(map[string]*fakeTopic),
+	}
+}
+
+func (f *fakeSNS) Handle(r *FakeRequest) *FakeResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Operation {
+	case "CreateTopic":
+		name := r.Params.Get("Name")
+		arn := r.ARN(FakeRegion, name)
+
+		if _, ok := f.topics[arn]; !ok {
+			topic := &fakeTopic{
+				attributes: map[string]string{
+					"DisplayName":             "",
+					"Owner":                   FakeAccountID,
+					"Policy":                  fakeTopicDefaultPolicy(arn),
+					"SubscriptionsConfirmed":  "0",
+					"SubscriptionsDeleted":    "0",
+					"SubscriptionsPending":    "0",
+					"TopicArn":                arn,
+					"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`,
+				},
+				tags: r.ParamMap("Tags.member", "Key", "Value"),
+			}
+
+			for k, v := range r.ParamMap("Attributes.entry", "key", "value") {
+				topic.attributes[k] = v
+			}
+
+			f.topics[arn] = topic
+		}
+
+		return r.QueryResult(fmt.Sprintf("<TopicArn>%s</TopicArn>", fakeXMLEscape(arn)))
+	case "ListTopics":
+		var result strings.Builder
+		result.WriteString("<Topics>")
+		for _, arn := range fakeSortedKeys(f.topics) {
+			fmt.Fprintf(&result, "<member><TopicArn>%s</TopicArn></member>", fakeXMLEscape(arn))
+		}
+		result.WriteString("</Topics>")
+
+		return r.QueryResult(result.String())
+	case "ListTagsForResource", "TagResource", "UntagResource":
+		topic, ok := f.topics[r.Params.Get("ResourceArn")]
+
+		if !ok {
+			return r.Error(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+		}
+
+		switch r.Operation {
+		case "ListTagsForResource":
+			return r.QueryResult("<Tags>" + fakeQueryEntries(topic.tags, "member", "Key", "Value") + "</Tags>")
+		case "TagResource":
+			for k, v := range r.ParamMap("Tags.member", "Key", "Value") {
+				topic.tags[k] = v
+			}
+		case "UntagResource":
+			for _, k := range r.ParamList("TagKeys.member") {
+				delete(topic.tags, k)
+			}
+		}
+
+		return r.QueryResult("")
+	case "DeleteTopic", "GetTopicAttributes", "SetTopicAttributes":
+		arn := r.Params.Get("TopicArn")
+		topic, ok := f.topics[arn]
+
+		if !ok {
+			// Deleting a topic that doesn't exist succeeds.
+			if r.Operation == "DeleteTopic" {
+				return r.QueryResult("")
+			}
+
+			return r.Error(http.StatusNotFound, "NotFound", "Topic does not exist")
+		}
+
+		switch r.Operation {
+		case "DeleteTopic":
+			delete(f.topics, arn)
+		case "GetTopicAttributes":
+			return r.QueryResult("<Attributes>" + fakeQueryEntries(topic.attributes, "entry", "key", "value") + "</Attributes>")
+		case "SetTopicAttributes":
+			topic.attributes[r.Params.Get("AttributeName")] = r.Params.Get("AttributeValue")
+		}
+
+		return r.QueryResult("")
+	}
+
+	return nil
+}
+
+// fakeTopicDefaultPolicy returns the access policy that SNS attaches to new topics.
+func fakeTopicDefaultPolicy(arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%[1]q,"Condition":{"StringEquals":{"AWS:SourceOwner":%[2]q}}}]}`, arn, FakeAccountID)
+}
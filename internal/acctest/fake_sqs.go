@@ -0,0 +1,244 @@
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// fakeSQS is a stateful fake of SQS queues and their attributes and tags, using the JSON protocol.
+type fakeSQS struct {
+	backend *FakeBackend
+
+	mu     sync.Mutex
+	queues map[string]*fakeQueue
+}
+
+type fakeQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+var _ FakeService = (*fakeSQS)(nil)
+
+func newFakeSQS(backend *FakeBackend) *fakeSQS {
+	return &fakeSQS{
+		backend: backend,
+		queues:  make(map[string]*fakeQueue),
+	}
+}
+
+// fakeSQSInput is the union of the SQS operation inputs that the fake implements.
+// JSON object keys are matched case-insensitively, so Tags is also CreateQueue's tags member.
+type fakeSQSInput struct {
+	AttributeNames  []string
+	Attributes      map[string]string
+	QueueName       string
+	QueueNamePrefix string
+	QueueURL        string `json:"QueueUrl"`
+	TagKeys         []string
+	Tags            map[string]string
+}
+
+func (f *fakeSQS) Handle(r *FakeRequest) *FakeResponse {
+	var input fakeSQSInput
+
+	if err := r.DecodeJSON(&input); err != nil {
+		return r.Error(http.StatusBadRequest, "SerializationException", err.Error())
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Operation {
+	case "CreateQueue":
+		return f.createQueue(r, input)
+	case "GetQueueUrl":
+		if _, ok := f.queues[input.QueueName]; !ok {
+			return fakeQueueDoesNotExist(r)
+		}
+
+		return r.JSONResult(map[string]any{"QueueUrl": f.queueURL(input.QueueName)})
+	case "ListQueues":
+		urls := []string{}
+		for _, name := range fakeSortedKeys(f.queues) {
+			if strings.HasPrefix(name, input.QueueNamePrefix) {
+				urls = append(urls, f.queueURL(name))
+			}
+		}
+
+		return r.JSONResult(map[string]any{"QueueUrls": urls})
+	}
+
+	name, queue := f.queueFromURL(input.QueueURL)
+
+	if queue == nil {
+		switch r.Operation {
+		case "DeleteQueue", "GetQueueAttributes", "ListQueueTags", "SetQueueAttributes", "TagQueue", "UntagQueue":
+			return fakeQueueDoesNotExist(r)
+		}
+
+		return nil
+	}
+
+	switch r.Operation {
+	case "DeleteQueue":
+		delete(f.queues, name)
+
+		return r.JSONResult(struct{}{})
+	case "GetQueueAttributes":
+		all := len(input.AttributeNames) == 0 || fakeContains(input.AttributeNames, "All")
+		attributes := make(map[string]string)
+
+		for k, v := range queue.attributes {
+			if all || fakeContains(input.AttributeNames, k) {
+				attributes[k] = v
+			}
+		}
+
+		return r.JSONResult(map[string]any{"Attributes": attributes})
+	case "ListQueueTags":
+		return r.JSONResult(map[string]any{"Tags": queue.tags})
+	case "SetQueueAttributes":
+		for k, v := range input.Attributes {
+			queue.attributes[k] = v
+		}
+
+		return r.JSONResult(struct{}{})
+	case "TagQueue":
+		for k, v := range input.Tags {
+			queue.tags[k] = v
+		}
+
+		return r.JSONResult(struct{}{})
+	case "UntagQueue":
+		for _, k := range input.TagKeys {
+			delete(queue.tags, k)
+		}
+
+		return r.JSONResult(struct{}{})
+	}
+
+	return nil
+}
+
+func (f *fakeSQS) createQueue(r *FakeRequest, input fakeSQSInput) *FakeResponse {
+	name := input.QueueName
+	result := map[string]any{"QueueUrl": f.queueURL(name)}
+
+	if queue, ok := f.queues[name]; ok {
+		for k, v := range input.Attributes {
+			if queue.attributes[k] != v {
+				return fakeSQSError(r, "QueueNameExists", sqsErrCodeQueueNameExists, fmt.Sprintf("A queue already exists with the same name and a different value for attribute %s", k))
+			}
+		}
+
+		return r.JSONResult(result)
+	}
+
+	queue := &fakeQueue{
+		attributes: map[string]string{
+			"ApproximateNumberOfMessages":           "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"CreatedTimestamp":                      "1672531200",
+			"DelaySeconds":                          "0",
+			"LastModifiedTimestamp":                 "1672531200",
+			"MaximumMessageSize":                    "262144",
+			"MessageRetentionPeriod":                "345600",
+			"QueueArn":                              r.ARN(FakeRegion, name),
+			"ReceiveMessageWaitTimeSeconds":         "0",
+			"SqsManagedSseEnabled":                  "true",
+			"VisibilityTimeout":                     "30",
+		},
+		tags: make(map[string]string),
+	}
+
+	for k, v := range input.Attributes {
+		queue.attributes[k] = v
+	}
+
+	for k, v := range input.Tags {
+		queue.tags[k] = v
+	}
+
+	if _, ok := input.Attributes["KmsMasterKeyId"]; ok {
+		queue.attributes["SqsManagedSseEnabled"] = "false"
+	}
+
+	f.queues[name] = queue
+
+	return r.JSONResult(result)
+}
+
+func (f *fakeSQS) queueURL(name string) string {
+	return f.backend.Server.URL + "/" + FakeAccountID + "/" + name
+}
+
+func (f *fakeSQS) queueFromURL(queueURL string) (string, *fakeQueue) {
+	u, err := url.Parse(queueURL)
+
+	if err != nil {
+		return "", nil
+	}
+
+	name := path.Base(u.Path)
+
+	return name, f.queues[name]
+}
+
+// Error codes that the AWS SDK for Go returns for the JSON protocol errors of SQS.
+const (
+	sqsErrCodeQueueDoesNotExist = "AWS.SimpleQueueService.NonExistentQueue"
+	sqsErrCodeQueueNameExists   = "QueueAlreadyExists"
+)
+
+func fakeQueueDoesNotExist(r *FakeRequest) *FakeResponse {
+	return fakeSQSError(r, "QueueDoesNotExist", sqsErrCodeQueueDoesNotExist, "The specified queue does not exist.")
+}
+
+// fakeSQSError returns an SQS error response.
+// SQS is compatible with its former Query protocol: the AWS SDKs return the Query protocol error code from the x-amzn-query-error header.
+func fakeSQSError(r *FakeRequest, code, queryCode, message string) *FakeResponse {
+	response := r.Error(http.StatusBadRequest, "com.amazonaws.sqs#"+code, message)
+	response.Header.Set("X-Amzn-Query-Error", queryCode+";Sender")
+
+	return response
+}
+
+// fakeQueryEntries returns the Query protocol XML of a list of key-value structures, such as tags.
+func fakeQueryEntries(entries map[string]string, element, keyName, valueName string) string {
+	var result strings.Builder
+
+	for _, k := range fakeSortedKeys(entries) {
+		fmt.Fprintf(&result, "<%[1]s><%[2]s>%[4]s</%[2]s><%[3]s>%[5]s</%[3]s></%[1]s>", element, keyName, valueName, fakeXMLEscape(k), fakeXMLEscape(entries[k]))
+	}
+
+	return result.String()
+}
+
+func fakeSortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func fakeContains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
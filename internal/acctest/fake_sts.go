@@ -0,0 +1,19 @@
+package acctest
+
+import (
+	"fmt"
+)
+
+// fakeSTS is a fake of STS that returns the identity of the fake backend's account.
+type fakeSTS struct{}
+
+var _ FakeService = fakeSTS{}
+
+func (fakeSTS) Handle(r *FakeRequest) *FakeResponse {
+	switch r.Operation {
+	case "GetCallerIdentity":
+		return r.QueryResult(fmt.Sprintf("<Arn>arn:%s:iam::%[2]s:user/fake</Arn><UserId>AIDAFAKEUSER</UserId><Account>%[2]s</Account>", fakePartition, FakeAccountID))
+	}
+
+	return nil
+}
@@ -0,0 +1,358 @@
+package acctest_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestFakeBackend_sqsQueue(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	backend := acctest.NewFakeBackend(t)
+	r := backend.Resource(t, "aws_sqs_queue")
+
+	config := map[string]any{
+		"name":                       "fake-queue",
+		"visibility_timeout_seconds": 60,
+		"tags": map[string]any{
+			"Name": "fake-queue",
+		},
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	if got, want := r.ID(), backend.Server.URL+"/"+acctest.FakeAccountID+"/fake-queue"; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("visibility_timeout_seconds"), "60"; got != want {
+		t.Errorf("visibility_timeout_seconds = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("tags.Name"), "fake-queue"; got != want {
+		t.Errorf("tags.Name = %q, want %q", got, want)
+	}
+
+	config["visibility_timeout_seconds"] = 90
+	config["tags"] = map[string]any{
+		"Name": "fake-queue",
+		"Key2": "value2",
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if diags := r.Read(); diags.HasError() {
+		t.Fatalf("reading: %v", diags)
+	}
+
+	if got, want := r.Attr("visibility_timeout_seconds"), "90"; got != want {
+		t.Errorf("visibility_timeout_seconds = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("tags.%"), "2"; got != want {
+		t.Errorf("tags.%% = %q, want %q", got, want)
+	}
+	if got := backend.Calls("sqs", "SetQueueAttributes"); got == 0 {
+		t.Error("SetQueueAttributes was not called")
+	}
+
+	if diags := r.Destroy(); diags.HasError() {
+		t.Fatalf("deleting: %v", diags)
+	}
+
+	if got := backend.Calls("sqs", "DeleteQueue"); got != 1 {
+		t.Errorf("DeleteQueue calls = %d, want 1", got)
+	}
+}
+
+func TestFakeBackend_s3Bucket(t *testing.T) {
+	t.Parallel()
+
+	backend := acctest.NewFakeBackend(t)
+	r := backend.Resource(t, "aws_s3_bucket")
+
+	config := map[string]any{
+		"bucket": "fake-bucket",
+		"tags": map[string]any{
+			"Name": "fake-bucket",
+		},
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	if got, want := r.ID(), "fake-bucket"; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("arn"), "arn:aws:s3:::fake-bucket"; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("tags.Name"), "fake-bucket"; got != want {
+		t.Errorf("tags.Name = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm"), "AES256"; got != want {
+		t.Errorf("sse_algorithm = %q, want %q", got, want)
+	}
+
+	config["tags"] = map[string]any{
+		"Key2": "value2",
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if diags := r.Read(); diags.HasError() {
+		t.Fatalf("reading: %v", diags)
+	}
+
+	if got, want := r.Attr("tags.%"), "1"; got != want {
+		t.Errorf("tags.%% = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("tags.Key2"), "value2"; got != want {
+		t.Errorf("tags.Key2 = %q, want %q", got, want)
+	}
+
+	if diags := r.Destroy(); diags.HasError() {
+		t.Fatalf("deleting: %v", diags)
+	}
+
+	if got := backend.Calls("s3", "DeleteBucket"); got != 1 {
+		t.Errorf("DeleteBucket calls = %d, want 1", got)
+	}
+
+	if diags := r.Import("fake-bucket"); diags.HasError() {
+		t.Fatalf("importing: %v", diags)
+	}
+	if r.State != nil {
+		t.Errorf("unexpected state after deletion: %v", r.State)
+	}
+}
+
+func TestFakeBackend_iamRole(t *testing.T) {
+	t.Parallel()
+
+	backend := acctest.NewFakeBackend(t)
+	r := backend.Resource(t, "aws_iam_role")
+
+	config := map[string]any{
+		"name":               "fake-role",
+		"assume_role_policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		"description":        "fake role",
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	if got, want := r.ID(), "fake-role"; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("arn"), "arn:aws:iam::"+acctest.FakeAccountID+":role/fake-role"; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	config["description"] = "updated fake role"
+	config["tags"] = map[string]any{
+		"Name": "fake-role",
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if diags := r.Read(); diags.HasError() {
+		t.Fatalf("reading: %v", diags)
+	}
+
+	if got, want := r.Attr("description"), "updated fake role"; got != want {
+		t.Errorf("description = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("tags.Name"), "fake-role"; got != want {
+		t.Errorf("tags.Name = %q, want %q", got, want)
+	}
+
+	if diags := r.Destroy(); diags.HasError() {
+		t.Fatalf("deleting: %v", diags)
+	}
+
+	if got := backend.Calls("iam", "DeleteRole"); got != 1 {
+		t.Errorf("DeleteRole calls = %d, want 1", got)
+	}
+}
+
+func TestFakeBackend_dynamoDBTable(t *testing.T) {
+	t.Parallel()
+
+	backend := acctest.NewFakeBackend(t)
+	r := backend.Resource(t, "aws_dynamodb_table")
+
+	config := map[string]any{
+		"name":         "fake-table",
+		"billing_mode": "PAY_PER_REQUEST",
+		"hash_key":     "id",
+		"attribute": []any{
+			map[string]any{
+				"name": "id",
+				"type": "S",
+			},
+		},
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	if got, want := r.ID(), "fake-table"; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("arn"), "arn:aws:dynamodb:"+acctest.FakeRegion+":"+acctest.FakeAccountID+":table/fake-table"; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	config["tags"] = map[string]any{
+		"Name": "fake-table",
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if diags := r.Read(); diags.HasError() {
+		t.Fatalf("reading: %v", diags)
+	}
+
+	if got, want := r.Attr("tags.Name"), "fake-table"; got != want {
+		t.Errorf("tags.Name = %q, want %q", got, want)
+	}
+
+	if diags := r.Destroy(); diags.HasError() {
+		t.Fatalf("deleting: %v", diags)
+	}
+
+	if got := backend.Calls("dynamodb", "DeleteTable"); got != 1 {
+		t.Errorf("DeleteTable calls = %d, want 1", got)
+	}
+}
+
+func TestFakeBackend_snsTopic(t *testing.T) {
+	t.Parallel()
+
+	backend := acctest.NewFakeBackend(t)
+	r := backend.Resource(t, "aws_sns_topic")
+
+	config := map[string]any{
+		"name":         "fake-topic",
+		"display_name": "fake",
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	if got, want := r.Attr("arn"), "arn:aws:sns:"+acctest.FakeRegion+":"+acctest.FakeAccountID+":fake-topic"; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("display_name"), "fake"; got != want {
+		t.Errorf("display_name = %q, want %q", got, want)
+	}
+
+	config["display_name"] = "updated"
+	config["tags"] = map[string]any{
+		"Name": "fake-topic",
+	}
+
+	if diags := r.Apply(config); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if diags := r.Read(); diags.HasError() {
+		t.Fatalf("reading: %v", diags)
+	}
+
+	if got, want := r.Attr("display_name"), "updated"; got != want {
+		t.Errorf("display_name = %q, want %q", got, want)
+	}
+	if got, want := r.Attr("tags.Name"), "fake-topic"; got != want {
+		t.Errorf("tags.Name = %q, want %q", got, want)
+	}
+	if got := backend.Calls("sns", "SetTopicAttributes"); got == 0 {
+		t.Error("SetTopicAttributes was not called")
+	}
+
+	if diags := r.Destroy(); diags.HasError() {
+		t.Fatalf("deleting: %v", diags)
+	}
+
+	if got := backend.Calls("sns", "DeleteTopic"); got != 1 {
+		t.Errorf("DeleteTopic calls = %d, want 1", got)
+	}
+}
+
+func TestFakeBackend_On(t *testing.T) {
+	t.Parallel()
+
+	backend := acctest.NewFakeBackend(t)
+	backend.On("sns", "CreateTopic", func(r *acctest.FakeRequest) *acctest.FakeResponse {
+		if r.Params.Get("Name") != "denied" {
+			return nil
+		}
+
+		return r.Error(http.StatusForbidden, "AuthorizationError", "not authorized")
+	})
+
+	r := backend.Resource(t, "aws_sns_topic")
+
+	diags := r.Apply(map[string]any{
+		"name": "denied",
+	})
+
+	if !diags.HasError() {
+		t.Fatal("expected error")
+	}
+	if got := diags[0].Summary; !strings.Contains(got, "AuthorizationError") {
+		t.Errorf("unexpected error: %s", got)
+	}
+	if r.State != nil {
+		t.Errorf("unexpected state: %v", r.State)
+	}
+
+	if diags := r.Apply(map[string]any{
+		"name": "allowed",
+	}); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	if got, want := r.ID(), "arn:aws:sns:"+acctest.FakeRegion+":"+acctest.FakeAccountID+":allowed"; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	if got := backend.Calls("sns", "CreateTopic"); got != 2 {
+		t.Errorf("CreateTopic calls = %d, want 2", got)
+	}
+}
+
+func TestFakeBackend_notImplemented(t *testing.T) {
+	t.Parallel()
+
+	backend := acctest.NewFakeBackend(t)
+	r := backend.Resource(t, "aws_sqs_queue")
+
+	backend.RegisterService("sqs", nil)
+
+	if diags := r.Apply(map[string]any{
+		"name": "fake-queue",
+	}); !diags.HasError() {
+		t.Fatal("expected error")
+	} else if got := diags[0].Summary; !strings.Contains(got, "NotImplemented") {
+		t.Errorf("unexpected error: %s", got)
+	}
+}
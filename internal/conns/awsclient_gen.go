@@ -199,7 +199,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -325,6 +324,7 @@ import (
 type AWSClient struct {
 	AccountID               string
 	DefaultTagsConfig       *tftags.DefaultConfig
+	DeleteProtectionConfig  *DeleteProtectionConfig
 	DNSSuffix               string
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert.MediaConvert
@@ -336,6 +336,7 @@ type AWSClient struct {
 	TerraformVersion        string
 
 	httpClient *http.Client
+	regional   *regionalClients
 
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
 	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
@@ -523,7 +524,6 @@ type AWSClient struct {
 	mturkConn                        *mturk.MTurk
 	mwaaConn                         *mwaa.MWAA
 	machinelearningConn              *machinelearning.MachineLearning
-	macieConn                        *macie.Macie
 	macie2Conn                       *macie2.Macie2
 	managedblockchainConn            *managedblockchain.ManagedBlockchain
 	marketplacecatalogConn           *marketplacecatalog.MarketplaceCatalog
@@ -1383,10 +1383,6 @@ func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning
 	return client.machinelearningConn
 }
 
-func (client *AWSClient) MacieConn() *macie.Macie {
-	return client.macieConn
-}
-
 func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
 	return client.macie2Conn
 }
@@ -5,11 +5,13 @@ import (
 	"log"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -40,6 +42,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -48,8 +51,10 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CredentialProcess              string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeleteProtectionConfig         *DeleteProtectionConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -66,6 +71,7 @@ type Config struct {
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
+	SSO                            *SSOConfig
 	SkipGetEC2Platforms            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
@@ -128,11 +134,39 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	if err := c.validateCredentials(); err != nil {
+		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+	}
+
+	// Credentials from an IAM Identity Center session or a credential process are retrieved up front
+	// so that an expired session or a failing command is reported at configure time.
+	var credentialsCache *aws_sdkv2.CredentialsCache
+	if v := c.credentialsProvider(client); v != nil {
+		if c.AccessKey != "" || c.SecretKey != "" || c.Profile != "" {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: access_key, secret_key and profile cannot be used with sso or credential_process")
+		}
+
+		credentialsCache = aws_sdkv2.NewCredentialsCache(v)
+		creds, err := credentialsCache.Retrieve(ctx)
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", c.credentialsError(err))
+		}
+
+		awsbaseConfig.AccessKey = creds.AccessKeyID
+		awsbaseConfig.SecretKey = creds.SecretAccessKey
+		awsbaseConfig.Token = creds.SessionToken
+	}
+
 	ctx, cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	// Unless a role is assumed using them, refresh the credentials when they expire.
+	if credentialsCache != nil && awsbaseConfig.AssumeRole == nil && awsbaseConfig.AssumeRoleWithWebIdentity == nil {
+		cfg.Credentials = credentialsCache
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -145,6 +179,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	// Instrument once here; the Region-specific copies made for the region argument inherit it.
+	if tracing.Enabled() {
+		tracing.InstrumentAWSConfig(&cfg)
+		tracing.InstrumentSession(sess)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("retrieving AWS account details: %s", err)
@@ -174,21 +214,37 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	client.AccountID = accountID
+	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DeleteProtectionConfig = c.DeleteProtectionConfig
+	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.Partition = partition
+	client.TerraformVersion = c.TerraformVersion
+	client.regional = &regionalClients{
+		awsConfig: cfg,
+		clients:   map[string]*AWSClient{c.Region: client},
+		config:    *c,
+	}
+
+	c.configureClients(client, sess, cfg)
+
+	return client, nil
+}
+
+// configureClients initializes the Region-specific fields and the API clients of the provider Meta.
+func (c *Config) configureClients(client *AWSClient, sess *session.Session, cfg aws_sdkv2.Config) {
+	partition := client.Partition
+
 	DNSSuffix := "amazonaws.com"
 	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
 		DNSSuffix = p.DNSSuffix()
 	}
 
-	client.AccountID = accountID
-	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
-	client.IgnoreTagsConfig = c.IgnoreTagsConfig
-	client.Partition = partition
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
-	client.TerraformVersion = c.TerraformVersion
 
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
@@ -334,7 +390,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			}
 		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
 			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
+				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
 					r.Retryable = aws.Bool(true)
 				}
 				return
@@ -509,7 +565,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
 		}
@@ -525,6 +581,4 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			o.Region = endpoints.UsEast1RegionID
 		}
 	})
-
-	return client, nil
 }
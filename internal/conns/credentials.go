@@ -0,0 +1,160 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	ssotypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SSOConfig contains the details of an IAM Identity Center (successor to AWS SSO) session
+// whose role credentials are used for API operations.
+type SSOConfig struct {
+	AccessToken     string // An access token obtained outside Terraform, e.g. in CI. Takes precedence over any cached token.
+	AccountID       string
+	CachedTokenFile string // Defaults to the token cached by `aws sso login` for StartURL.
+	Region          string // The Region of the Identity Center instance.
+	RoleName        string
+	StartURL        string
+}
+
+// validateCredentials validates the configured IAM Identity Center session and credential process.
+func (c *Config) validateCredentials() error {
+	if c.SSO != nil && c.CredentialProcess != "" {
+		return errors.New("only one of sso and credential_process can be configured")
+	}
+
+	if c.CredentialProcess != "" && strings.TrimSpace(c.CredentialProcess) == "" {
+		return errors.New("credential_process: command must not be empty")
+	}
+
+	if v := c.SSO; v != nil {
+		if v.AccountID == "" || v.RoleName == "" || v.Region == "" {
+			return errors.New("sso: account_id, role_name and region must be set")
+		}
+
+		if v.AccessToken == "" && v.CachedTokenFile == "" && v.StartURL == "" {
+			return errors.New("sso: one of access_token, cached_token_file or start_url must be set")
+		}
+
+		if v.AccessToken == "" && v.CachedTokenFile != "" {
+			if _, err := os.Stat(v.CachedTokenFile); err != nil {
+				return fmt.Errorf("sso: cached_token_file: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// credentialsProvider returns a provider of the credentials of the configured IAM Identity Center session or credential process.
+// nil is returned if neither is configured.
+func (c *Config) credentialsProvider(client *AWSClient) aws_sdkv2.CredentialsProvider {
+	switch {
+	case c.SSO != nil:
+		options := sso.Options{
+			Region: c.SSO.Region,
+		}
+		if v := client.HTTPClient(); v != nil {
+			options.HTTPClient = v
+		}
+		if v := c.Endpoints[names.SSO]; v != "" {
+			options.EndpointResolver = sso.EndpointResolverFromURL(v)
+		}
+		conn := sso.New(options)
+
+		if v := c.SSO.AccessToken; v != "" {
+			return &ssoAccessTokenProvider{
+				accessToken: v,
+				accountID:   c.SSO.AccountID,
+				conn:        conn,
+				roleName:    c.SSO.RoleName,
+			}
+		}
+
+		return ssocreds.New(conn, c.SSO.AccountID, c.SSO.RoleName, c.SSO.StartURL, func(o *ssocreds.Options) {
+			o.CachedTokenFilepath = c.SSO.CachedTokenFile
+		})
+	case c.CredentialProcess != "":
+		return processcreds.NewProvider(c.CredentialProcess)
+	}
+
+	return nil
+}
+
+// credentialsError returns an error describing why the credentials of the configured
+// IAM Identity Center session or credential process could not be retrieved.
+// Expired or invalid Identity Center tokens are distinguished from misconfiguration.
+func (c *Config) credentialsError(err error) error {
+	if c.SSO == nil {
+		return fmt.Errorf("retrieving credentials from credential_process: %w", err)
+	}
+
+	const refresh = "run `aws sso login` or supply a new access_token"
+
+	if v, ok := errs.As[*ssocreds.InvalidTokenError](err); ok {
+		switch {
+		case errors.Is(v.Err, fs.ErrNotExist):
+			return fmt.Errorf("retrieving IAM Identity Center credentials: no cached access token found for start_url %q; %s: %w", c.SSO.StartURL, refresh, err)
+		case v.Err != nil:
+			return fmt.Errorf("retrieving IAM Identity Center credentials: reading cached access token: %w", err)
+		}
+
+		return fmt.Errorf("retrieving IAM Identity Center credentials: IAM Identity Center session has expired; %s: %w", refresh, err)
+	}
+
+	if errs.IsA[*ssotypes.UnauthorizedException](err) {
+		return fmt.Errorf("retrieving IAM Identity Center credentials: access token is expired or invalid; %s: %w", refresh, err)
+	}
+
+	if errs.IsA[*ssotypes.ResourceNotFoundException](err) || errs.IsA[*ssotypes.InvalidRequestException](err) {
+		return fmt.Errorf("retrieving IAM Identity Center credentials: check that role %q is assigned to you in account %s and that region is the Region of the Identity Center instance: %w", c.SSO.RoleName, c.SSO.AccountID, err)
+	}
+
+	return fmt.Errorf("retrieving IAM Identity Center credentials: %w", err)
+}
+
+// ssoAccessTokenProvider retrieves IAM Identity Center role credentials using an access token
+// obtained outside Terraform, so that no token cache on disk is needed.
+type ssoAccessTokenProvider struct {
+	accessToken string
+	accountID   string
+	conn        *sso.Client
+	roleName    string
+}
+
+func (p *ssoAccessTokenProvider) Retrieve(ctx context.Context) (aws_sdkv2.Credentials, error) {
+	output, err := p.conn.GetRoleCredentials(ctx, &sso.GetRoleCredentialsInput{
+		AccessToken: aws_sdkv2.String(p.accessToken),
+		AccountId:   aws_sdkv2.String(p.accountID),
+		RoleName:    aws_sdkv2.String(p.roleName),
+	})
+
+	if err != nil {
+		return aws_sdkv2.Credentials{}, err
+	}
+
+	if output == nil || output.RoleCredentials == nil {
+		return aws_sdkv2.Credentials{}, errors.New("empty result")
+	}
+
+	return aws_sdkv2.Credentials{
+		AccessKeyID:     aws_sdkv2.ToString(output.RoleCredentials.AccessKeyId),
+		SecretAccessKey: aws_sdkv2.ToString(output.RoleCredentials.SecretAccessKey),
+		SessionToken:    aws_sdkv2.ToString(output.RoleCredentials.SessionToken),
+		CanExpire:       true,
+		Expires:         time.UnixMilli(output.RoleCredentials.Expiration).UTC(),
+		Source:          ssocreds.ProviderName,
+	}, nil
+}
@@ -0,0 +1,297 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigValidateCredentials(t *testing.T) {
+	t.Parallel()
+
+	tokenFile := filepath.Join(t.TempDir(), "token.json")
+	if err := os.WriteFile(tokenFile, []byte(`{}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name     string
+		Config   Config
+		Expected string
+	}{
+		{
+			Name: "none",
+		},
+		{
+			Name: "credential process",
+			Config: Config{
+				CredentialProcess: "/usr/local/bin/creds",
+			},
+		},
+		{
+			Name: "blank credential process",
+			Config: Config{
+				CredentialProcess: "  ",
+			},
+			Expected: "command must not be empty",
+		},
+		{
+			Name: "both",
+			Config: Config{
+				CredentialProcess: "/usr/local/bin/creds",
+				SSO:               &SSOConfig{},
+			},
+			Expected: "only one of sso and credential_process",
+		},
+		{
+			Name: "sso missing role",
+			Config: Config{
+				SSO: &SSOConfig{
+					AccountID: "123456789012",
+					Region:    "us-east-1", //lintignore:AWSAT003
+					StartURL:  "https://example.awsapps.com/start",
+				},
+			},
+			Expected: "account_id, role_name and region must be set",
+		},
+		{
+			Name: "sso no token source",
+			Config: Config{
+				SSO: &SSOConfig{
+					AccountID: "123456789012",
+					Region:    "us-east-1", //lintignore:AWSAT003
+					RoleName:  "Admin",
+				},
+			},
+			Expected: "one of access_token, cached_token_file or start_url must be set",
+		},
+		{
+			Name: "sso missing cached token file",
+			Config: Config{
+				SSO: &SSOConfig{
+					AccountID:       "123456789012",
+					CachedTokenFile: filepath.Join(t.TempDir(), "missing.json"),
+					Region:          "us-east-1", //lintignore:AWSAT003
+					RoleName:        "Admin",
+				},
+			},
+			Expected: "cached_token_file",
+		},
+		{
+			Name: "sso cached token file",
+			Config: Config{
+				SSO: &SSOConfig{
+					AccountID:       "123456789012",
+					CachedTokenFile: tokenFile,
+					Region:          "us-east-1", //lintignore:AWSAT003
+					RoleName:        "Admin",
+				},
+			},
+		},
+		{
+			Name: "sso access token",
+			Config: Config{
+				SSO: &SSOConfig{
+					AccessToken: "token",
+					AccountID:   "123456789012",
+					Region:      "us-east-1", //lintignore:AWSAT003
+					RoleName:    "Admin",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Config.validateCredentials()
+
+			if testCase.Expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got := err.Error(); !strings.Contains(got, testCase.Expected) {
+				t.Errorf("error = %q, want to contain %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigCredentialsProvider_sso(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Header.Get("X-Amz-Sso_bearer_token") {
+		case "valid":
+		case "expired":
+			w.Header().Set("X-Amzn-ErrorType", "UnauthorizedException")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"Session token not found or invalid"}`)
+			return
+		default:
+			w.Header().Set("X-Amzn-ErrorType", "ResourceNotFoundException")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"No access"}`)
+			return
+		}
+
+		if got, want := r.URL.Query().Get("role_name"), "Admin"; got != want {
+			t.Errorf("role_name = %q, want %q", got, want)
+		}
+
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"AKID","secretAccessKey":"SECRET","sessionToken":"TOKEN","expiration":%d}}`, time.Now().Add(time.Hour).UnixMilli())
+	}))
+	t.Cleanup(server.Close)
+
+	expiredTokenFile := filepath.Join(t.TempDir(), "expired.json")
+	if err := os.WriteFile(expiredTokenFile, []byte(`{"accessToken":"valid","expiresAt":"2000-01-01T00:00:00Z"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	validTokenFile := filepath.Join(t.TempDir(), "valid.json")
+	if err := os.WriteFile(validTokenFile, []byte(fmt.Sprintf(`{"accessToken":"valid","expiresAt":%q}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name     string
+		SSO      SSOConfig
+		Expected string
+	}{
+		{
+			Name: "access token",
+			SSO: SSOConfig{
+				AccessToken: "valid",
+			},
+		},
+		{
+			Name: "cached token",
+			SSO: SSOConfig{
+				CachedTokenFile: validTokenFile,
+			},
+		},
+		{
+			Name: "expired access token",
+			SSO: SSOConfig{
+				AccessToken: "expired",
+			},
+			Expected: "access token is expired or invalid",
+		},
+		{
+			Name: "expired cached token",
+			SSO: SSOConfig{
+				CachedTokenFile: expiredTokenFile,
+			},
+			Expected: "session has expired",
+		},
+		{
+			Name: "missing cached token",
+			SSO: SSOConfig{
+				CachedTokenFile: filepath.Join(t.TempDir(), "missing.json"),
+			},
+			Expected: "no cached access token found",
+		},
+		{
+			Name: "role not assigned",
+			SSO: SSOConfig{
+				AccessToken: "other",
+			},
+			Expected: `check that role "Admin" is assigned`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ssoConfig := testCase.SSO
+			ssoConfig.AccountID = "123456789012"
+			ssoConfig.Region = "us-east-1" //lintignore:AWSAT003
+			ssoConfig.RoleName = "Admin"
+			ssoConfig.StartURL = "https://example.awsapps.com/start"
+
+			config := &Config{
+				Endpoints: map[string]string{
+					names.SSO: server.URL,
+				},
+				SSO: &ssoConfig,
+			}
+
+			creds, err := config.credentialsProvider(&AWSClient{}).Retrieve(context.Background())
+
+			if testCase.Expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got, want := creds.AccessKeyID, "AKID"; got != want {
+					t.Errorf("AccessKeyID = %q, want %q", got, want)
+				}
+				if !creds.CanExpire {
+					t.Error("expected expiring credentials")
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got := config.credentialsError(err).Error(); !strings.Contains(got, testCase.Expected) {
+				t.Errorf("error = %q, want to contain %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigCredentialsProvider_credentialProcess(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		CredentialProcess: `echo '{"Version":1,"AccessKeyId":"AKID","SecretAccessKey":"SECRET"}'`,
+	}
+
+	creds, err := config.credentialsProvider(&AWSClient{}).Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := creds.AccessKeyID, "AKID"; got != want {
+		t.Errorf("AccessKeyID = %q, want %q", got, want)
+	}
+
+	config.CredentialProcess = "exit 1"
+
+	_, err = config.credentialsProvider(&AWSClient{}).Retrieve(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := config.credentialsError(err).Error(), "retrieving credentials from credential_process"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package conns
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// EnvVarDeleteProtectionOverride is the environment variable that, when set to a true value,
+// allows the deletion of resources protected by the provider's delete_protection configuration.
+const EnvVarDeleteProtectionOverride = "TF_AWS_DELETE_PROTECTION_OVERRIDE"
+
+// DeleteProtectionConfig contains the resource types and tags that select the resources
+// whose deletion is prevented by the provider.
+type DeleteProtectionConfig struct {
+	ResourceTypes []string
+	Tags          tftags.KeyValueTags // A tag with an empty value selects resources with that tag key and any value.
+}
+
+// ProtectedBy returns a description of the selector that protects a resource of the specified type
+// with the specified tags (typically the resource's tags_all) from deletion.
+// An empty string is returned if the resource isn't protected.
+func (c *DeleteProtectionConfig) ProtectedBy(typeName string, tags tftags.KeyValueTags) string {
+	if c == nil {
+		return ""
+	}
+
+	for _, v := range c.ResourceTypes {
+		if v == typeName {
+			return fmt.Sprintf("resource type %q", typeName)
+		}
+	}
+
+	// Check keys in a fixed order so that the description is stable.
+	keys := c.Tags.Keys()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !tags.KeyExists(key) {
+			continue
+		}
+
+		want := c.Tags.KeyValue(key)
+
+		if want == nil || *want == "" {
+			return fmt.Sprintf("tag key %q", key)
+		}
+
+		if got := tags.KeyValue(key); got != nil && *got == *want {
+			return fmt.Sprintf("tag %q = %q", key, *want)
+		}
+	}
+
+	return ""
+}
+
+// DeleteProtectionOverridden returns whether the EnvVarDeleteProtectionOverride environment variable is set to a true value.
+func DeleteProtectionOverridden() bool {
+	v, err := strconv.ParseBool(os.Getenv(EnvVarDeleteProtectionOverride))
+
+	return err == nil && v
+}
+
+// DeleteProtectionError returns the summary and detail of the diagnostic reported when the deletion
+// of a protected resource is prevented.
+func DeleteProtectionError(typeName, id, protectedBy string) (string, string) {
+	summary := fmt.Sprintf("deleting %s (%s): protected by the provider's delete_protection configuration", typeName, id)
+	detail := fmt.Sprintf("The resource matches the delete_protection %s. "+
+		"To delete it, remove the matching selector from the provider configuration, "+
+		"or set the %s environment variable to \"true\" for this run.", protectedBy, EnvVarDeleteProtectionOverride)
+
+	return summary, detail
+}
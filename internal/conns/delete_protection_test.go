@@ -0,0 +1,97 @@
+package conns
+
+import (
+	"testing"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestDeleteProtectionConfigProtectedBy(t *testing.T) {
+	t.Parallel()
+
+	config := &DeleteProtectionConfig{
+		ResourceTypes: []string{"aws_db_instance", "aws_s3_bucket"},
+		Tags: tftags.New(map[string]string{
+			"Environment": "production",
+			"Protected":   "",
+		}),
+	}
+
+	testCases := []struct {
+		Name     string
+		Config   *DeleteProtectionConfig
+		TypeName string
+		Tags     map[string]string
+		Expected string
+	}{
+		{
+			Name:     "no config",
+			TypeName: "aws_db_instance",
+		},
+		{
+			Name:     "resource type",
+			Config:   config,
+			TypeName: "aws_s3_bucket",
+			Expected: `resource type "aws_s3_bucket"`,
+		},
+		{
+			Name:     "tag value match",
+			Config:   config,
+			TypeName: "aws_instance",
+			Tags:     map[string]string{"Environment": "production", "Name": "web"},
+			Expected: `tag "Environment" = "production"`,
+		},
+		{
+			Name:     "tag value mismatch",
+			Config:   config,
+			TypeName: "aws_instance",
+			Tags:     map[string]string{"Environment": "staging"},
+		},
+		{
+			Name:     "tag key match",
+			Config:   config,
+			TypeName: "aws_instance",
+			Tags:     map[string]string{"Protected": "yes"},
+			Expected: `tag key "Protected"`,
+		},
+		{
+			Name:     "no tags",
+			Config:   config,
+			TypeName: "aws_instance",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.Config.ProtectedBy(testCase.TypeName, tftags.New(testCase.Tags))
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDeleteProtectionOverridden(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Expected bool
+	}{
+		{Value: "", Expected: false},
+		{Value: "false", Expected: false},
+		{Value: "junk", Expected: false},
+		{Value: "true", Expected: true},
+		{Value: "1", Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Setenv(EnvVarDeleteProtectionOverride, testCase.Value)
+
+		if got := DeleteProtectionOverridden(); got != testCase.Expected {
+			t.Errorf("%s=%q: got %t, expected %t", EnvVarDeleteProtectionOverride, testCase.Value, got, testCase.Expected)
+		}
+	}
+}
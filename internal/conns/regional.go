@@ -0,0 +1,138 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// regionalClients creates and caches provider Metas whose API clients are scoped to a Region
+// other than the provider's.
+type regionalClients struct {
+	awsConfig aws_sdkv2.Config
+	config    Config
+
+	clients map[string]*AWSClient
+	mutex   sync.Mutex
+}
+
+// RegionalClient returns a provider Meta whose API clients are scoped to the specified Region.
+// The provider Meta itself is returned if region is empty or the provider's Region.
+// Provider Metas for other Regions are created on first use and cached.
+func (client *AWSClient) RegionalClient(ctx context.Context, region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regional == nil {
+		return nil, fmt.Errorf("API clients for Region (%s) are not available before the provider is configured", region)
+	}
+
+	return client.regional.client(ctx, client, region)
+}
+
+func (r *regionalClients) client(ctx context.Context, parent *AWSClient, region string) (*AWSClient, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if v, ok := r.clients[region]; ok {
+		return v, nil
+	}
+
+	if !r.config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	c := r.config
+	c.Region = region
+
+	cfg := r.awsConfig.Copy()
+	cfg.Region = region
+
+	client := &AWSClient{
+		AccountID:              parent.AccountID,
+		DefaultTagsConfig:      parent.DefaultTagsConfig,
+		DeleteProtectionConfig: parent.DeleteProtectionConfig,
+		IgnoreTagsConfig:       parent.IgnoreTagsConfig,
+		Partition:              parent.Partition,
+		ServicePackages:        parent.ServicePackages,
+		TerraformVersion:       parent.TerraformVersion,
+		regional:               r,
+	}
+	client.SetHTTPClient(parent.HTTPClient())
+
+	c.configureClients(client, parent.Session.Copy(&aws.Config{Region: aws.String(region)}), cfg)
+
+	for _, v := range client.ServicePackages {
+		if err := v.Configure(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
+	r.clients[region] = client
+
+	return client, nil
+}
+
+// globalResourceTypePrefixes are the resource type prefixes of services whose API clients
+// are not scoped to the provider's Region.
+var globalResourceTypePrefixes = []string{
+	"aws_account_",
+	"aws_budgets_",
+	"aws_ce_",
+	"aws_cloudfront_",
+	"aws_cur_",
+	"aws_globalaccelerator_",
+	"aws_iam_",
+	"aws_networkmanager_",
+	"aws_organizations_",
+	"aws_pricing_",
+	"aws_route53_",
+	"aws_route53domains_",
+	"aws_route53recoverycontrolconfig_",
+	"aws_route53recoveryreadiness_",
+	"aws_shield_",
+	"aws_waf_",
+}
+
+// IsGlobalResourceType returns whether the resource or data source type belongs to a global service.
+func IsGlobalResourceType(typeName string) bool {
+	// Route 53 Resolver is a regional service.
+	if strings.HasPrefix(typeName, "aws_route53_resolver_") {
+		return false
+	}
+
+	for _, prefix := range globalResourceTypePrefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ImportIDRegionSeparator separates an optional Region suffix from a resource's import ID,
+// e.g. "vpc-12345678@us-west-2".
+const ImportIDRegionSeparator = "@"
+
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// SplitImportIDRegion splits an import ID into the resource's ID and Region.
+// The Region is empty if the import ID has no Region suffix.
+func SplitImportIDRegion(id string) (string, string) {
+	i := strings.LastIndex(id, ImportIDRegionSeparator)
+
+	if i < 0 || !regionRegexp.MatchString(id[i+1:]) {
+		return id, ""
+	}
+
+	return id[:i], id[i+1:]
+}
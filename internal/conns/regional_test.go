@@ -0,0 +1,88 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "no suffix",
+			ImportID:   "vpc-12345678",
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			Name:           "Region suffix",
+			ImportID:       "vpc-12345678@us-west-2",
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-west-2",
+		},
+		{
+			Name:           "GovCloud Region suffix",
+			ImportID:       "vpc-12345678@us-gov-west-1",
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-gov-west-1",
+		},
+		{
+			Name:       "separator in ID",
+			ImportID:   "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:           "separator in ID with Region suffix",
+			ImportID:       "user@example.com@eu-central-1",
+			ExpectedID:     "user@example.com",
+			ExpectedRegion: "eu-central-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.ImportID)
+
+			if gotID != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", gotID, testCase.ExpectedID)
+			}
+
+			if gotRegion != testCase.ExpectedRegion {
+				t.Errorf("got Region %s, expected %s", gotRegion, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestIsGlobalResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TypeName string
+		Expected bool
+	}{
+		{TypeName: "aws_vpc", Expected: false},
+		{TypeName: "aws_iam_role", Expected: true},
+		{TypeName: "aws_route53_zone", Expected: true},
+		{TypeName: "aws_route53_resolver_endpoint", Expected: false},
+		{TypeName: "aws_cloudfront_distribution", Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsGlobalResourceType(testCase.TypeName); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
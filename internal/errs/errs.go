@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
 )
 
 // errorMessager is a simple interface for types with ErrorMessage().
@@ -34,6 +35,23 @@ func IsAErrorMessageContains[T interface {
 	return false
 }
 
+// APIErrorCodeEquals returns true if the error matches all these conditions:
+//   - err is of type smithy.APIError
+//   - APIError.ErrorCode() equals one of the passed codes
+//
+// It is the AWS SDK for Go v2 equivalent of tfawserr.ErrCodeEquals.
+func APIErrorCodeEquals(err error, codes ...string) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		for _, code := range codes {
+			if apiErr.ErrorCode() == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Contains returns true if the error matches all these conditions:
 //   - err as string contains needle
 func Contains(err error, needle string) bool {
@@ -1,8 +1,11 @@
 package errs_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/aws/smithy-go"
+
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
@@ -48,3 +51,25 @@ func TestIsAErrorMessageContains(t *testing.T) {
 		t.Error("unexpected false")
 	}
 }
+
+func TestAPIErrorCodeEquals(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("wrapped: %w", &smithy.GenericAPIError{Code: "ResourceNotFoundException", Message: "not found"})
+
+	if !errs.APIErrorCodeEquals(err, "ResourceNotFoundException") {
+		t.Error("unexpected false")
+	}
+
+	if !errs.APIErrorCodeEquals(err, "ValidationException", "ResourceNotFoundException") {
+		t.Error("unexpected false")
+	}
+
+	if errs.APIErrorCodeEquals(err, "ValidationException") {
+		t.Error("unexpected true")
+	}
+
+	if errs.APIErrorCodeEquals(FirstError{}, "ResourceNotFoundException") {
+		t.Error("unexpected true")
+	}
+}
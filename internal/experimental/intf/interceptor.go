@@ -0,0 +1,119 @@
+package intf
+
+import (
+	"context"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// When represents the point in a CRUD operation at which an interceptor is run.
+type When uint16
+
+const (
+	Before  When = 1 << iota // Interceptor is run before the CRUD handler.
+	After                    // Interceptor is run after the CRUD handler succeeds.
+	OnError                  // Interceptor is run after the CRUD handler fails.
+
+	Finally = After | OnError // Interceptor is run after the CRUD handler.
+)
+
+func (when When) String() string {
+	switch when {
+	case Before:
+		return "enter"
+	case After:
+		return "exit"
+	case OnError:
+		return "exit with error"
+	default:
+		return "unknown"
+	}
+}
+
+// Why represents the CRUD operation(s) for which an interceptor is run.
+type Why uint16
+
+const (
+	Create Why = 1 << iota // Interceptor is run for Create.
+	Read                   // Interceptor is run for Read.
+	Update                 // Interceptor is run for Update.
+	Delete                 // Interceptor is run for Delete.
+
+	AllOps = Create | Read | Update | Delete // Interceptor is run for all CRUD operations.
+)
+
+func (why Why) String() string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
+// SDKInterceptorOptions are passed to Terraform Plugin SDK resource and data source interceptors.
+type SDKInterceptorOptions struct {
+	D            *schema.ResourceData
+	Diags        diag.Diagnostics // The diagnostics returned by the CRUD handler or by earlier interceptors.
+	IsDataSource bool
+	Meta         any
+	TypeName     string
+	When         When
+	Why          Why
+}
+
+// SDKInterceptorFunc is run for Terraform Plugin SDK resource and data source CRUD operations.
+// It returns the (possibly modified) context and the diagnostics to pass to the next interceptor.
+type SDKInterceptorFunc func(context.Context, SDKInterceptorOptions) (context.Context, diag.Diagnostics)
+
+// SDKInterceptor is an interceptor for Terraform Plugin SDK resource and data source CRUD operations.
+type SDKInterceptor struct {
+	When        When
+	Why         Why
+	Interceptor SDKInterceptorFunc
+}
+
+// FrameworkInterceptorOptions are passed to Terraform Plugin Framework resource and data source interceptors.
+type FrameworkInterceptorOptions struct {
+	Diags        fwdiag.Diagnostics // The diagnostics returned by the CRUD handler or by earlier interceptors.
+	IsDataSource bool
+	Meta         any
+	Request      any // The CRUD request, e.g. resource.CreateRequest.
+	Response     any // A pointer to the CRUD response, e.g. *resource.CreateResponse.
+	TypeName     string
+	When         When
+	Why          Why
+}
+
+// FrameworkInterceptorFunc is run for Terraform Plugin Framework resource and data source CRUD operations.
+// It returns the (possibly modified) context and the diagnostics to pass to the next interceptor.
+type FrameworkInterceptorFunc func(context.Context, FrameworkInterceptorOptions) (context.Context, fwdiag.Diagnostics)
+
+// FrameworkInterceptor is an interceptor for Terraform Plugin Framework resource and data source CRUD operations.
+type FrameworkInterceptor struct {
+	When        When
+	Why         Why
+	Interceptor FrameworkInterceptorFunc
+}
+
+// ServicePackageWithSDKInterceptors is implemented by service packages that intercept the CRUD operations
+// of their Terraform Plugin SDK resources and data sources.
+type ServicePackageWithSDKInterceptors interface {
+	ServicePackage
+	SDKInterceptors(context.Context) []SDKInterceptor
+}
+
+// ServicePackageWithFrameworkInterceptors is implemented by service packages that intercept the CRUD operations
+// of their Terraform Plugin Framework resources and data sources.
+type ServicePackageWithFrameworkInterceptors interface {
+	ServicePackage
+	FrameworkInterceptors(context.Context) []FrameworkInterceptor
+}
@@ -23,6 +23,24 @@ func (w *withMeta) Meta() *conns.AWSClient {
 	return w.meta
 }
 
+// SetRegion scopes the API clients returned by Meta to the specified Region.
+// An empty Region scopes them to the provider's Region.
+func (w *withMeta) SetRegion(ctx context.Context, region string) error {
+	if w.meta == nil {
+		return nil
+	}
+
+	meta, err := w.meta.RegionalClient(ctx, region)
+
+	if err != nil {
+		return err
+	}
+
+	w.meta = meta
+
+	return nil
+}
+
 type withMigratedFromPluginSDK struct {
 	migrated bool
 }
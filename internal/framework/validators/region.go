@@ -0,0 +1,41 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+type regionValidator struct{}
+
+func (validator regionValidator) Description(_ context.Context) string {
+	return "value must be a valid AWS Region name"
+}
+
+func (validator regionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator regionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := request.ConfigValue.ValueString(); !regionRegexp.MatchString(value) {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			fmt.Sprintf("%q is not a valid Region name", value),
+		))
+		return
+	}
+}
+
+func Region() validator.String {
+	return regionValidator{}
+}
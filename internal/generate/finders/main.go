@@ -0,0 +1,502 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	defaultConfigFilename = "finders.hcl"
+	defaultFilename       = "find_gen.go"
+
+	sdkV1 = 1
+	sdkV2 = 2
+
+	// awsTypesAlias is the import alias of the AWS SDK for Go v2 service's types package.
+	awsTypesAlias = "awstypes"
+)
+
+var (
+	configFile = flag.String("Config", defaultConfigFilename, "name of the file declaring the finders")
+	sdkVersion = flag.Int("AWSSDKVersion", sdkV1, "Version of the AWS SDK Go to use i.e. 1 or 2")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-finder-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type finderConfig struct {
+	Finders []finderDeclaration `hcl:"finder,block"`
+}
+
+type finderDeclaration struct {
+	Name               string                `hcl:",label"`
+	Operation          string                `hcl:"operation"`
+	Arguments          []argumentDeclaration `hcl:"argument,block"`
+	OutputPath         string                `hcl:"output_path,optional"`
+	NotFoundErrorCodes []string              `hcl:"not_found_error_codes,optional"`
+	RequiredFields     []string              `hcl:"required_fields,optional"`
+}
+
+type argumentDeclaration struct {
+	Name  string `hcl:",label"`
+	Field string `hcl:"field"`
+}
+
+type TemplateData struct {
+	Parameters     string
+	ServicePackage string
+	SourcePackage  string
+	TypesPackage   string
+	AWSSDKVersion  int
+
+	AWSPkg         bool
+	ErrsPkg        bool
+	SDKResourcePkg bool
+	TfAWSErrPkg    bool
+	TypesPkg       bool
+
+	Finders []FinderDatum
+}
+
+type FinderDatum struct {
+	Name               string
+	Operation          string
+	ClientType         string
+	InputType          string
+	OutputType         string
+	Arguments          []ArgumentDatum
+	OutputPath         string
+	ResultType         string
+	ElemType           string
+	List               bool
+	Paginator          string
+	NotFoundErrorCodes string
+	RequiredFields     []string
+	SDKVersion         int
+}
+
+type ArgumentDatum struct {
+	Name  string
+	Type  string
+	Field string
+	Value string
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	if *sdkVersion != sdkV1 && *sdkVersion != sdkV2 {
+		g.Fatalf("AWS SDK Go Version %d not supported", *sdkVersion)
+	}
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+	awsPkg, err := names.AWSGoPackage(servicePackage, *sdkVersion)
+
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	clientTypeName, err := names.AWSGoClientTypeName(servicePackage, *sdkVersion)
+
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	var config finderConfig
+
+	if err := hclsimple.DecodeFile(*configFile, nil, &config); err != nil {
+		g.Fatalf("error reading %s: %s", *configFile, err)
+	}
+
+	td := TemplateData{
+		Parameters:     strings.Join(os.Args[1:], " "),
+		ServicePackage: servicePackage,
+		AWSSDKVersion:  *sdkVersion,
+	}
+
+	if *sdkVersion == sdkV1 {
+		td.SourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsPkg)
+	} else {
+		td.SourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", awsPkg)
+		td.TypesPackage = fmt.Sprintf("%s/types", td.SourcePackage)
+	}
+
+	pkg, err := loadPackage(td.SourcePackage)
+
+	if err != nil {
+		g.Fatalf("loading %s: %s", td.SourcePackage, err)
+	}
+
+	if td.TypesPackage != "" {
+		typesPkg, err := loadPackage(td.TypesPackage)
+
+		if err != nil {
+			g.Fatalf("loading %s: %s", td.TypesPackage, err)
+		}
+
+		pkg.typesPkg = typesPkg
+	}
+
+	if *sdkVersion == sdkV2 {
+		if pkg.localFuncs, err = loadLocalFuncs("."); err != nil {
+			g.Fatalf("loading %s: %s", servicePackage, err)
+		}
+	}
+
+	sort.SliceStable(config.Finders, func(i, j int) bool {
+		return config.Finders[i].Name < config.Finders[j].Name
+	})
+
+	for _, v := range config.Finders {
+		finder, err := pkg.finderDatum(v, clientTypeName, *sdkVersion, &td)
+
+		if err != nil {
+			g.Fatalf("finder %s: %s", v.Name, err)
+		}
+
+		td.Finders = append(td.Finders, finder)
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("finders", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed file.tmpl
+var tmpl string
+
+// sourcePackage is the syntax of an AWS SDK for Go service package.
+type sourcePackage struct {
+	name     string
+	types    map[string]*ast.StructType
+	funcs    map[string]*ast.FuncDecl
+	methods  map[string]*ast.FuncDecl
+	typesPkg *sourcePackage // The AWS SDK for Go v2 service's types package.
+
+	localFuncs map[string]string // The service package's functions, keyed by lower-cased name.
+}
+
+func loadPackage(path string) (*sourcePackage, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%d packages found", len(pkgs))
+	}
+
+	pkg := &sourcePackage{
+		name:    pkgs[0].Name,
+		types:   make(map[string]*ast.StructType),
+		funcs:   make(map[string]*ast.FuncDecl),
+		methods: make(map[string]*ast.FuncDecl),
+	}
+
+	for _, file := range pkgs[0].Syntax {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					pkg.funcs[decl.Name.Name] = decl
+				} else {
+					pkg.methods[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				if decl.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if v, ok := spec.Type.(*ast.StructType); ok {
+							pkg.types[spec.Name.Name] = v
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+// loadLocalFuncs returns the names of the functions declared in the service package in the specified directory,
+// e.g. the paginator constructors generated by listpages, keyed by lower-cased name.
+func loadLocalFuncs(dir string) (map[string]string, error) {
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, filter, parser.SkipObjectResolution)
+
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := make(map[string]string)
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+					funcs[strings.ToLower(decl.Name.Name)] = decl.Name.Name
+				}
+			}
+		}
+	}
+
+	return funcs, nil
+}
+
+func (p *sourcePackage) field(typeName, fieldName string) (ast.Expr, error) {
+	v, ok := p.types[typeName]
+
+	if !ok {
+		return nil, fmt.Errorf("type %s not found", typeName)
+	}
+
+	for _, field := range v.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return field.Type, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("field %s.%s not found", typeName, fieldName)
+}
+
+func (p *sourcePackage) finderDatum(v finderDeclaration, clientTypeName string, sdkVersion int, td *TemplateData) (FinderDatum, error) {
+	inputTypeName := fmt.Sprintf("%sInput", v.Operation)
+	outputTypeName := fmt.Sprintf("%sOutput", v.Operation)
+
+	if _, ok := p.methods[v.Operation]; !ok {
+		return FinderDatum{}, fmt.Errorf("operation %s not found", v.Operation)
+	}
+
+	finder := FinderDatum{
+		Name:       v.Name,
+		Operation:  v.Operation,
+		InputType:  fmt.Sprintf("%s.%s", p.name, inputTypeName),
+		OutputType: fmt.Sprintf("%s.%s", p.name, outputTypeName),
+		ClientType: fmt.Sprintf("*%s.%s", p.name, clientTypeName),
+		OutputPath: v.OutputPath,
+		SDKVersion: sdkVersion,
+	}
+
+	for _, arg := range v.Arguments {
+		expr, err := p.field(inputTypeName, arg.Field)
+
+		if err != nil {
+			return FinderDatum{}, err
+		}
+
+		argument := ArgumentDatum{
+			Name:  arg.Name,
+			Field: arg.Field,
+		}
+
+		if fn, typ, ok := awsValueFunc(expr, sdkVersion); ok {
+			td.AWSPkg = true
+			argument.Type = typ
+			argument.Value = fmt.Sprintf("aws.%s(%s)", fn, arg.Name)
+		} else if typ, err := p.qualifiedType(expr, td); err != nil {
+			return FinderDatum{}, err
+		} else {
+			argument.Type = typ
+			argument.Value = arg.Name
+		}
+
+		finder.Arguments = append(finder.Arguments, argument)
+	}
+
+	if len(v.NotFoundErrorCodes) > 0 {
+		codes := make([]string, len(v.NotFoundErrorCodes))
+		for i, code := range v.NotFoundErrorCodes {
+			codes[i] = fmt.Sprintf("%q", code)
+		}
+		finder.NotFoundErrorCodes = strings.Join(codes, ", ")
+
+		td.SDKResourcePkg = true
+		if sdkVersion == sdkV1 {
+			td.TfAWSErrPkg = true
+		} else {
+			td.ErrsPkg = true
+		}
+	}
+
+	if v.OutputPath == "" {
+		finder.ResultType = fmt.Sprintf("*%s", finder.OutputType)
+
+		for _, field := range v.RequiredFields {
+			if _, err := p.field(outputTypeName, field); err != nil {
+				return FinderDatum{}, err
+			}
+
+			finder.RequiredFields = append(finder.RequiredFields, fmt.Sprintf("output.%s", field))
+		}
+
+		return finder, nil
+	}
+
+	expr, err := p.field(outputTypeName, v.OutputPath)
+
+	if err != nil {
+		return FinderDatum{}, err
+	}
+
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		if finder.ResultType, err = p.qualifiedType(expr, td); err != nil {
+			return FinderDatum{}, err
+		}
+
+		for _, field := range v.RequiredFields {
+			if err := p.structField(expr.X, field); err != nil {
+				return FinderDatum{}, err
+			}
+
+			finder.RequiredFields = append(finder.RequiredFields, fmt.Sprintf("output.%s.%s", v.OutputPath, field))
+		}
+	case *ast.ArrayType:
+		if len(v.RequiredFields) > 0 {
+			return FinderDatum{}, fmt.Errorf("required_fields are not supported for list output path %s", v.OutputPath)
+		}
+
+		finder.List = true
+
+		if finder.ElemType, err = p.qualifiedType(expr.Elt, td); err != nil {
+			return FinderDatum{}, err
+		}
+
+		if sdkVersion == sdkV1 {
+			if _, ok := expr.Elt.(*ast.StarExpr); !ok {
+				return FinderDatum{}, fmt.Errorf("output path %s must be a list of structures", v.OutputPath)
+			}
+			finder.ResultType = finder.ElemType
+		} else {
+			finder.ResultType = fmt.Sprintf("*%s", finder.ElemType)
+		}
+
+		switch {
+		case sdkVersion == sdkV1 && p.methods[fmt.Sprintf("%sPagesWithContext", v.Operation)] != nil:
+			finder.Paginator = fmt.Sprintf("%sPagesWithContext", v.Operation)
+		case sdkVersion == sdkV2 && p.funcs[fmt.Sprintf("New%sPaginator", v.Operation)] != nil:
+			finder.Paginator = fmt.Sprintf("%s.New%sPaginator", p.name, v.Operation)
+		case sdkVersion == sdkV2 && p.localFuncs[strings.ToLower(fmt.Sprintf("new%sPaginator", v.Operation))] != "":
+			finder.Paginator = p.localFuncs[strings.ToLower(fmt.Sprintf("new%sPaginator", v.Operation))]
+		}
+	default:
+		return FinderDatum{}, fmt.Errorf("output path %s must be a pointer or a list", v.OutputPath)
+	}
+
+	return finder, nil
+}
+
+// structField checks that the structure type named by expr, from the service package
+// or the AWS SDK for Go v2 service's types package, has the specified field.
+func (p *sourcePackage) structField(expr ast.Expr, fieldName string) error {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		_, err := p.field(expr.Name, fieldName)
+		return err
+	case *ast.SelectorExpr:
+		if ident, ok := expr.X.(*ast.Ident); ok && ident.Name == "types" && p.typesPkg != nil {
+			_, err := p.typesPkg.field(expr.Sel.Name, fieldName)
+			return err
+		}
+	}
+
+	return fmt.Errorf("unsupported type expression: (%[1]T) %[1]v", expr)
+}
+
+// awsValueFunc returns the name of the aws package function that converts a value of the returned type
+// to the specified field type, e.g. aws.String for a *string field.
+func awsValueFunc(expr ast.Expr, sdkVersion int) (string, string, bool) {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		if ident, ok := expr.X.(*ast.Ident); ok {
+			switch ident.Name {
+			case "bool", "float64", "int32", "int64", "string":
+				return strings.Title(ident.Name), ident.Name, true //nolint:staticcheck // ASCII identifiers
+			}
+		}
+	case *ast.ArrayType:
+		if sdkVersion != sdkV1 {
+			return "", "", false
+		}
+
+		if star, ok := expr.Elt.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok {
+				switch ident.Name {
+				case "int64", "string":
+					return fmt.Sprintf("%sSlice", strings.Title(ident.Name)), fmt.Sprintf("[]%s", ident.Name), true //nolint:staticcheck // ASCII identifiers
+				}
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// qualifiedType returns the Go source of a type expression from the AWS SDK service package
+// as it is written in the generated file.
+func (p *sourcePackage) qualifiedType(expr ast.Expr, td *TemplateData) (string, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(expr.Name) {
+			return fmt.Sprintf("%s.%s", p.name, expr.Name), nil
+		}
+		return expr.Name, nil
+	case *ast.StarExpr:
+		typ, err := p.qualifiedType(expr.X, td)
+		return "*" + typ, err
+	case *ast.ArrayType:
+		typ, err := p.qualifiedType(expr.Elt, td)
+		return "[]" + typ, err
+	case *ast.SelectorExpr:
+		if ident, ok := expr.X.(*ast.Ident); ok && ident.Name == "types" {
+			td.TypesPkg = true
+			return fmt.Sprintf("%s.%s", awsTypesAlias, expr.Sel.Name), nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported type expression: (%[1]T) %[1]v", expr)
+}
@@ -0,0 +1,207 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+const (
+	defaultFilename = "policy_catalog.json"
+	defaultURL      = "https://servicereference.us-east-1.amazonaws.com/"
+)
+
+var (
+	serviceReferenceURL = flag.String("URL", defaultURL, "URL of the Service Authorization Reference's list of services")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-catalog-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// referenceServiceListEntry is an entry of the Service Authorization Reference's list of services.
+type referenceServiceListEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+// referenceService is a service's actions, resource types and condition keys in the Service Authorization Reference.
+type referenceService struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+	Resources []struct {
+		Name       string   `json:"Name"`
+		ARNFormats []string `json:"ARNFormats"`
+	} `json:"Resources"`
+}
+
+// catalog and catalogService mirror the catalog types in internal/service/iam/policy_catalog.go.
+type catalog struct {
+	GlobalConditionKeys []string                   `json:"global_condition_keys"`
+	Services            map[string]*catalogService `json:"services"`
+}
+
+type catalogService struct {
+	Actions       []string            `json:"actions,omitempty"`
+	ARNNamespaces []string            `json:"arn_namespaces,omitempty"`
+	ConditionKeys []string            `json:"condition_keys,omitempty"`
+	ResourceTypes map[string][]string `json:"resource_types,omitempty"`
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	g.Infof("Generating %s", filename)
+
+	// Global condition keys are not part of the Service Authorization Reference
+	// and are kept from the existing catalog.
+	var existing catalog
+
+	if body, err := os.ReadFile(filename); err == nil {
+		if err := json.Unmarshal(body, &existing); err != nil {
+			g.Fatalf("reading %s: %s", filename, err)
+		}
+	} else if !os.IsNotExist(err) {
+		g.Fatalf("reading %s: %s", filename, err)
+	}
+
+	client := &http.Client{Timeout: 1 * time.Minute}
+
+	var entries []referenceServiceListEntry
+
+	if err := getJSON(client, *serviceReferenceURL, &entries); err != nil {
+		g.Fatalf("listing services: %s", err)
+	}
+
+	c := catalog{
+		GlobalConditionKeys: existing.GlobalConditionKeys,
+		Services:            make(map[string]*catalogService, len(entries)),
+	}
+
+	for _, entry := range entries {
+		var service referenceService
+
+		if err := getJSON(client, entry.URL, &service); err != nil {
+			g.Fatalf("reading service %s: %s", entry.Service, err)
+		}
+
+		c.Services[entry.Service] = catalogServiceFromReference(entry.Service, &service)
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(c); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.WriteBytes(body.Bytes()); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func getJSON(client *http.Client, url string, v any) error {
+	resp, err := client.Get(url)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func catalogServiceFromReference(prefix string, service *referenceService) *catalogService {
+	s := &catalogService{}
+
+	for _, action := range service.Actions {
+		s.Actions = append(s.Actions, action.Name)
+	}
+	sort.Strings(s.Actions)
+
+	// Global condition keys, e.g. "aws:RequestTag/${TagKey}", are validated against the global list.
+	for _, key := range service.ConditionKeys {
+		if !strings.HasPrefix(strings.ToLower(key.Name), "aws:") {
+			s.ConditionKeys = append(s.ConditionKeys, key.Name)
+		}
+	}
+	sort.Strings(s.ConditionKeys)
+
+	arnNamespaces := make(map[string]struct{})
+	for _, resource := range service.Resources {
+		if len(resource.ARNFormats) == 0 {
+			continue
+		}
+
+		if s.ResourceTypes == nil {
+			s.ResourceTypes = make(map[string][]string)
+		}
+		s.ResourceTypes[strings.ToLower(resource.Name)] = resource.ARNFormats
+
+		for _, format := range resource.ARNFormats {
+			// arn:${Partition}:<namespace>:...
+			if parts := strings.SplitN(format, ":", 4); len(parts) == 4 {
+				arnNamespaces[parts[2]] = struct{}{}
+			}
+		}
+	}
+
+	// The ARN namespace defaults to the service prefix.
+	if namespaces := sortedKeys(arnNamespaces); len(namespaces) > 1 || (len(namespaces) == 1 && namespaces[0] != prefix) {
+		s.ARNNamespaces = namespaces
+	}
+
+	return s
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -23,6 +23,9 @@ import (
 
 const (
 	defaultFilename = "list_pages_gen.go"
+
+	sdkV1 = 1
+	sdkV2 = 2
 )
 
 var (
@@ -32,6 +35,7 @@ var (
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
 	contextOnly     = flag.Bool("ContextOnly", false, "whether to only generate Context-aware functions")
+	sdkVersion      = flag.Int("AWSSDKVersion", sdkV1, "Version of the AWS SDK Go to use i.e. 1 or 2")
 )
 
 func usage() {
@@ -51,6 +55,14 @@ func main() {
 		log.Fatal("both InputPaginator and OutputPaginator must be specified if one is")
 	}
 
+	if *sdkVersion != sdkV1 && *sdkVersion != sdkV2 {
+		log.Fatalf("AWS SDK Go Version %d not supported", *sdkVersion)
+	}
+
+	if *sdkVersion == sdkV2 && *contextOnly {
+		log.Fatal("ContextOnly is not supported with AWS SDK Go Version 2")
+	}
+
 	if *inputPaginator == "" {
 		*inputPaginator = *paginator
 	}
@@ -72,7 +84,7 @@ func main() {
 	servicePackage := filepath.Base(wd)
 	log.SetPrefix(fmt.Sprintf("generate/listpage: %s: ", servicePackage))
 
-	awsService, err := names.AWSGoV1Package(servicePackage)
+	awsService, err := names.AWSGoPackage(servicePackage, *sdkVersion)
 
 	if err != nil {
 		log.Fatalf("encountered: %s", err)
@@ -82,29 +94,42 @@ func main() {
 	sort.Strings(functions)
 
 	g := Generator{
-		tmpl:            template.Must(template.New("function").Parse(functionTemplate)),
 		inputPaginator:  *inputPaginator,
 		outputPaginator: *outputPaginator,
 		contextOnly:     *contextOnly,
 	}
 
-	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+	var sourcePackage string
+	if *sdkVersion == sdkV1 {
+		g.tmpl = template.Must(template.New("function").Parse(functionTemplate))
+		sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+	} else {
+		g.tmpl = template.Must(template.New("paginator").Parse(paginatorTemplate))
+		sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", awsService)
+	}
 	g.parsePackage(sourcePackage)
 
 	g.printHeader(HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: servicePackage,
 		SourcePackage:      sourcePackage,
+		AWSSDKVersion:      *sdkVersion,
 	})
 
-	awsUpper, err := names.AWSGoV1ClientTypeName(servicePackage)
+	if *sdkVersion == sdkV1 {
+		awsUpper, err := names.AWSGoV1ClientTypeName(servicePackage)
 
-	if err != nil {
-		log.Fatalf("encountered: %s", err)
-	}
+		if err != nil {
+			log.Fatalf("encountered: %s", err)
+		}
 
-	for _, functionName := range functions {
-		g.generateFunction(functionName, awsUpper, *export)
+		for _, functionName := range functions {
+			g.generateFunction(functionName, awsUpper, *export)
+		}
+	} else {
+		for _, functionName := range functions {
+			g.generatePaginator(functionName, *export)
+		}
 	}
 
 	src := g.format()
@@ -119,6 +144,7 @@ type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	AWSSDKVersion      int
 }
 
 type Generator struct {
@@ -153,7 +179,7 @@ func (g *Generator) printHeader(headerInfo HeaderInfo) {
 
 func (g *Generator) parsePackage(sourcePackage string) {
 	cfg := &packages.Config{
-		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax,
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
 	}
 	pkgs, err := packages.Load(cfg, sourcePackage)
 	if err != nil {
@@ -235,6 +261,85 @@ func (g *Generator) generateFunction(functionName, awsService string, export boo
 	}
 }
 
+type PaginatorSpec struct {
+	Name            string
+	Constructor     string
+	AWSName         string
+	ClientType      string
+	OptionsType     string
+	ParamType       string
+	ResultType      string
+	InputPaginator  string
+	OutputPaginator string
+}
+
+// generatePaginator generates a paginator for an AWS SDK for Go v2 operation that is used
+// in the same way as the paginators that the SDK defines for other operations.
+func (g *Generator) generatePaginator(operationName string, export bool) {
+	var method *ast.FuncDecl
+
+	for _, file := range g.pkg.files {
+		if file.file == nil {
+			continue
+		}
+
+		for _, decl := range file.file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok {
+				continue
+			}
+
+			switch name := funcDecl.Name.Name; {
+			case funcDecl.Recv == nil && name == fmt.Sprintf("New%sPaginator", operationName):
+				log.Fatalf("operation \"%s\" has an AWS SDK paginator, %s.%s", operationName, g.pkg.name, name)
+			case funcDecl.Recv != nil && name == operationName:
+				method = funcDecl
+			}
+		}
+	}
+
+	if method == nil {
+		log.Fatalf("operation \"%s\" not found", operationName)
+	}
+
+	// func (c *Client) <Operation>(ctx context.Context, params *<Operation>Input, optFns ...func(*Options)) (*<Operation>Output, error)
+	if method.Type.Params.NumFields() != 3 {
+		log.Fatalf("operation \"%s\": unexpected parameters", operationName)
+	}
+
+	params, ok := method.Type.Params.List[1].Type.(*ast.StarExpr)
+
+	if !ok {
+		log.Fatalf("operation \"%s\": unexpected input type", operationName)
+	}
+
+	name := operationName
+	constructor := fmt.Sprintf("New%s", name)
+
+	if !export {
+		name = fmt.Sprintf("%s%s", strings.ToLower(name[0:1]), name[1:])
+		constructor = fmt.Sprintf("new%s", operationName)
+	}
+
+	paginatorSpec := PaginatorSpec{
+		Name:            fmt.Sprintf("%sPaginator", fixSomeInitialisms(name)),
+		Constructor:     fmt.Sprintf("%sPaginator", fixSomeInitialisms(constructor)),
+		AWSName:         operationName,
+		ClientType:      g.expandTypeField(method.Recv),
+		OptionsType:     fmt.Sprintf("%s.Options", g.pkg.name),
+		ParamType:       g.expandTypeExpr(params.X),
+		ResultType:      g.expandTypeField(method.Type.Results),
+		InputPaginator:  g.inputPaginator,
+		OutputPaginator: g.outputPaginator,
+	}
+
+	err := g.tmpl.Execute(&g.buf, paginatorSpec)
+	if err != nil {
+		log.Fatalf("error writing paginator \"%s\": %s", operationName, err)
+	}
+}
+
 func (g *Generator) expandTypeField(field *ast.FieldList) string {
 	typeValue := field.List[0].Type
 	if star, ok := typeValue.(*ast.StarExpr); ok {
@@ -264,6 +369,9 @@ var headerTemplate string
 //go:embed function.tmpl
 var functionTemplate string
 
+//go:embed paginator.tmpl
+var paginatorTemplate string
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
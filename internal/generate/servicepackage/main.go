@@ -6,9 +6,14 @@ package main
 import (
 	_ "embed"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -60,6 +65,11 @@ func main() {
 		s := ServiceDatum{
 			ProviderPackage: p,
 		}
+
+		if err := s.scanAnnotations(dir); err != nil {
+			g.Fatalf("error scanning %s for annotations: %s", p, err)
+		}
+
 		d := g.NewGoFileDestination(fmt.Sprintf("../../service/%s/%s", p, spFile))
 
 		if err := d.WriteTemplate("servicepackagedata", spdTmpl, s); err != nil {
@@ -90,8 +100,92 @@ func main() {
 	}
 }
 
+type ResourceDatum struct {
+	FactoryName string
+	TypeName    string
+}
+
 type ServiceDatum struct {
-	ProviderPackage string
+	ProviderPackage      string
+	FrameworkDataSources []ResourceDatum
+	FrameworkResources   []ResourceDatum
+	SDKDataSources       []ResourceDatum
+	SDKResources         []ResourceDatum
+}
+
+// annotationRegexp matches annotations such as
+//
+//	// @SDKResource("aws_vpc")
+//	// @FrameworkResource
+var annotationRegexp = regexp.MustCompile(`^//\s*@(FrameworkDataSource|FrameworkResource|SDKDataSource|SDKResource)(?:\("([a-z0-9_]+)"\))?\s*$`)
+
+// scanAnnotations adds the resources and data sources annotated in the service package's source files.
+func (s *ServiceDatum) scanAnnotations(dir string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+
+				if !ok || funcDecl.Recv != nil || funcDecl.Doc == nil {
+					continue
+				}
+
+				for _, comment := range funcDecl.Doc.List {
+					m := annotationRegexp.FindStringSubmatch(comment.Text)
+
+					if m == nil {
+						continue
+					}
+
+					annotation, typeName := m[1], m[2]
+					v := ResourceDatum{
+						FactoryName: funcDecl.Name.Name,
+						TypeName:    typeName,
+					}
+
+					switch annotation {
+					case "FrameworkDataSource":
+						s.FrameworkDataSources = append(s.FrameworkDataSources, v)
+					case "FrameworkResource":
+						s.FrameworkResources = append(s.FrameworkResources, v)
+					case "SDKDataSource", "SDKResource":
+						if typeName == "" {
+							return fmt.Errorf("%s: @%s annotation on %s must specify a type name", fset.Position(comment.Pos()), annotation, funcDecl.Name.Name)
+						}
+
+						if annotation == "SDKDataSource" {
+							s.SDKDataSources = append(s.SDKDataSources, v)
+						} else {
+							s.SDKResources = append(s.SDKResources, v)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, v := range [][]ResourceDatum{s.FrameworkDataSources, s.FrameworkResources} {
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].FactoryName < v[j].FactoryName
+		})
+	}
+
+	for _, v := range [][]ResourceDatum{s.SDKDataSources, s.SDKResources} {
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].TypeName < v[j].TypeName
+		})
+	}
+
+	return nil
 }
 
 type TemplateData struct {
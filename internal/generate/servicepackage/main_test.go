@@ -0,0 +1,202 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScanAnnotations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName  string
+		Files     map[string]string
+		Expected  ServiceDatum
+		ExpectErr bool
+	}{
+		{
+			TestName: "no annotations",
+			Files: map[string]string{
+				"a.go": `package a
+
+func ResourceA() {}
+`,
+			},
+			Expected: ServiceDatum{},
+		},
+		{
+			TestName: "all annotations sorted",
+			Files: map[string]string{
+				"b.go": `package a
+
+// @SDKResource("aws_b")
+func ResourceB() {}
+
+// @SDKResource("aws_a")
+func ResourceA() {}
+
+// @SDKDataSource("aws_a")
+func DataSourceA() {}
+`,
+				"c.go": `package a
+
+// @FrameworkResource
+func newResourceZ() {}
+
+// @FrameworkResource
+func newResourceY() {}
+
+// @FrameworkDataSource
+func newDataSourceX() {}
+`,
+			},
+			Expected: ServiceDatum{
+				FrameworkDataSources: []ResourceDatum{{FactoryName: "newDataSourceX"}},
+				FrameworkResources:   []ResourceDatum{{FactoryName: "newResourceY"}, {FactoryName: "newResourceZ"}},
+				SDKDataSources:       []ResourceDatum{{FactoryName: "DataSourceA", TypeName: "aws_a"}},
+				SDKResources:         []ResourceDatum{{FactoryName: "ResourceA", TypeName: "aws_a"}, {FactoryName: "ResourceB", TypeName: "aws_b"}},
+			},
+		},
+		{
+			TestName: "annotation among other comments",
+			Files: map[string]string{
+				"a.go": `package a
+
+// ResourceA manages an A.
+//
+// @SDKResource("aws_a")
+func ResourceA() {}
+`,
+			},
+			Expected: ServiceDatum{
+				SDKResources: []ResourceDatum{{FactoryName: "ResourceA", TypeName: "aws_a"}},
+			},
+		},
+		{
+			TestName: "ignores methods, test files and unknown annotations",
+			Files: map[string]string{
+				"a.go": `package a
+
+type t struct{}
+
+// @SDKResource("aws_a")
+func (t) ResourceA() {}
+
+// @Tags
+func ResourceB() {}
+`,
+				"a_test.go": `package a
+
+// @SDKResource("aws_test")
+func ResourceTest() {}
+`,
+			},
+			Expected: ServiceDatum{},
+		},
+		{
+			TestName: "SDK annotation without type name",
+			Files: map[string]string{
+				"a.go": `package a
+
+// @SDKResource
+func ResourceA() {}
+`,
+			},
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			for name, content := range testCase.Files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var got ServiceDatum
+			err := got.scanAnnotations(dir)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServicePackageDataTemplate(t *testing.T) {
+	t.Parallel()
+
+	s := ServiceDatum{
+		ProviderPackage:      "example",
+		FrameworkDataSources: []ResourceDatum{{FactoryName: "newDataSourceX"}},
+		FrameworkResources:   []ResourceDatum{{FactoryName: "newResourceY"}},
+		SDKDataSources:       []ResourceDatum{{FactoryName: "DataSourceA", TypeName: "aws_example_a"}},
+		SDKResources:         []ResourceDatum{{FactoryName: "ResourceA", TypeName: "aws_example_a"}, {FactoryName: "ResourceB", TypeName: "aws_example_b"}},
+	}
+
+	tmpl, err := template.New("servicepackagedata").Parse(spdTmpl)
+
+	if err != nil {
+		t.Fatalf("parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, s); err != nil {
+		t.Fatalf("executing template: %s", err)
+	}
+
+	body, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		t.Fatalf("formatting generated source: %s\n%s", err, buffer.String())
+	}
+
+	got := string(body)
+
+	for _, want := range []string{
+		"package example\n",
+		"\t\tnewDataSourceX,\n",
+		"\t\tnewResourceY,\n",
+		"\t\t\tTypeName: \"aws_example_a\",\n\t\t\tFactory:  DataSourceA,\n",
+		"\t\t\tTypeName: \"aws_example_a\",\n\t\t\tFactory:  ResourceA,\n",
+		"\t\t\tTypeName: \"aws_example_b\",\n\t\t\tFactory:  ResourceB,\n",
+		"return \"example\"\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated source does not contain %q:\n%s", want, got)
+		}
+	}
+
+	// Resources must be registered in order.
+	if strings.Index(got, "ResourceA,") > strings.Index(got, "ResourceB,") {
+		t.Errorf("resources are not in type name order:\n%s", got)
+	}
+}
@@ -0,0 +1,162 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+)
+
+// providerFrameworkInterceptors are run for all Terraform Plugin Framework resources and data sources,
+// before any service package interceptors.
+var providerFrameworkInterceptors = []intf.FrameworkInterceptor{
+	{
+		When:        intf.Before | intf.Finally,
+		Why:         intf.AllOps,
+		Interceptor: logFrameworkInterceptor,
+	},
+	{
+		When:        intf.Before,
+		Why:         intf.Delete,
+		Interceptor: deleteProtectionFrameworkInterceptor,
+	},
+}
+
+// frameworkInterceptors returns the interceptors for the service package's Terraform Plugin Framework resources and data sources.
+func frameworkInterceptors(ctx context.Context, sp intf.ServicePackage) []intf.FrameworkInterceptor {
+	interceptors := providerFrameworkInterceptors
+
+	if v, ok := sp.(intf.ServicePackageWithFrameworkInterceptors); ok {
+		interceptors = append(interceptors[:len(interceptors):len(interceptors)], v.FrameworkInterceptors(ctx)...)
+	}
+
+	return interceptors
+}
+
+// interceptedCall runs f surrounded by the interceptors for the operation.
+// Before interceptors are run in order and the first error from any of them prevents the remaining Before interceptors and f from running.
+// After and OnError interceptors are run in reverse order; OnError interceptors are also run when a Before interceptor fails.
+func interceptedCall(ctx context.Context, interceptors []intf.FrameworkInterceptor, opts intf.FrameworkInterceptorOptions, diags *diag.Diagnostics, f func(context.Context)) {
+	why := opts.Why
+
+	// The span encloses all interceptors so that it is always ended.
+	ctx, span := tracing.StartOperation(ctx, opts.TypeName, why.String(), opts.IsDataSource)
+	defer func() {
+		tracing.End(span, fwdiag.DiagnosticsError(*diags))
+	}()
+
+	opts.When = intf.Before
+	for _, v := range interceptors {
+		if v.When&opts.When != 0 && v.Why&why != 0 {
+			opts.Diags = *diags
+			ctx, *diags = v.Interceptor(ctx, opts)
+
+			if diags.HasError() {
+				break
+			}
+		}
+	}
+
+	if !diags.HasError() {
+		f(ctx)
+	}
+
+	opts.When = intf.After
+	if diags.HasError() {
+		opts.When = intf.OnError
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		if v := interceptors[i]; v.When&opts.When != 0 && v.Why&why != 0 {
+			opts.Diags = *diags
+			ctx, *diags = v.Interceptor(ctx, opts)
+		}
+	}
+}
+
+// innerMeta returns the provider Meta used by the wrapped resource or data source's API clients.
+func innerMeta(inner any, meta *conns.AWSClient) *conns.AWSClient {
+	if v, ok := inner.(interface{ Meta() *conns.AWSClient }); ok && v.Meta() != nil {
+		return v.Meta()
+	}
+
+	return meta
+}
+
+func (w *wrappedDataSource) intercept(ctx context.Context, why intf.Why, request, response any, diags *diag.Diagnostics, f func(context.Context)) {
+	opts := intf.FrameworkInterceptorOptions{
+		IsDataSource: true,
+		Meta:         innerMeta(w.inner, w.meta),
+		Request:      request,
+		Response:     response,
+		TypeName:     w.typeName,
+		Why:          why,
+	}
+
+	interceptedCall(ctx, w.interceptors, opts, diags, f)
+}
+
+func (w *wrappedResource) intercept(ctx context.Context, why intf.Why, request, response any, diags *diag.Diagnostics, f func(context.Context)) {
+	opts := intf.FrameworkInterceptorOptions{
+		Meta:     innerMeta(w.inner, w.meta),
+		Request:  request,
+		Response: response,
+		TypeName: w.typeName,
+		Why:      why,
+	}
+
+	interceptedCall(ctx, w.interceptors, opts, diags, f)
+}
+
+// logFrameworkInterceptor logs the start and end of each CRUD operation.
+func logFrameworkInterceptor(ctx context.Context, opts intf.FrameworkInterceptorOptions) (context.Context, diag.Diagnostics) {
+	tflog.Debug(ctx, fmt.Sprintf("%s.%s %s", opts.TypeName, opts.Why, opts.When))
+
+	return ctx, opts.Diags
+}
+
+// deleteProtectionFrameworkInterceptor prevents the deletion of resources selected by the provider's delete_protection configuration.
+func deleteProtectionFrameworkInterceptor(ctx context.Context, opts intf.FrameworkInterceptorOptions) (context.Context, diag.Diagnostics) {
+	diags := opts.Diags
+
+	request, ok := opts.Request.(resource.DeleteRequest)
+	if !ok {
+		return ctx, diags
+	}
+
+	// The guardrail can't be applied without a configured provider.
+	meta, ok := opts.Meta.(*conns.AWSClient)
+	if !ok || meta == nil {
+		return ctx, diags
+	}
+
+	// Resources without tags_all or id attributes leave the values null.
+	var tagsAll types.Map
+	request.State.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)
+	var id types.String
+	request.State.GetAttribute(ctx, path.Root("id"), &id)
+
+	protectedBy := meta.DeleteProtectionConfig.ProtectedBy(opts.TypeName, tftags.New(tagsAll))
+
+	if protectedBy == "" {
+		return ctx, diags
+	}
+
+	if conns.DeleteProtectionOverridden() {
+		tflog.Warn(ctx, fmt.Sprintf("deleting %s (%s) protected by %s: %s is set", opts.TypeName, id.ValueString(), protectedBy, conns.EnvVarDeleteProtectionOverride))
+
+		return ctx, diags
+	}
+
+	diags.AddError(conns.DeleteProtectionError(opts.TypeName, id.ValueString(), protectedBy))
+
+	return ctx, diags
+}
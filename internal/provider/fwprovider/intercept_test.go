@@ -0,0 +1,174 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+)
+
+func TestInterceptedCall(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Why         intf.Why
+		BeforeError bool
+		CallError   bool
+		Expected    []string
+	}{
+		{
+			Name:     "success",
+			Why:      intf.Create,
+			Expected: []string{"first Before", "second Before", "call", "second After", "first After"},
+		},
+		{
+			Name:      "call error",
+			Why:       intf.Update,
+			CallError: true,
+			Expected:  []string{"first Before", "second Before", "call", "second OnError", "first OnError"},
+		},
+		{
+			Name:        "Before error",
+			Why:         intf.Create,
+			BeforeError: true,
+			Expected:    []string{"first Before", "second OnError", "first OnError"},
+		},
+		{
+			Name:     "not intercepted",
+			Why:      intf.Read,
+			Expected: []string{"call"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			interceptor := func(name string) intf.FrameworkInterceptorFunc {
+				return func(ctx context.Context, opts intf.FrameworkInterceptorOptions) (context.Context, diag.Diagnostics) {
+					when := map[intf.When]string{intf.Before: "Before", intf.After: "After", intf.OnError: "OnError"}[opts.When]
+					got = append(got, name+" "+when)
+
+					diags := opts.Diags
+					if testCase.BeforeError && opts.When == intf.Before {
+						diags.AddError("before", "")
+					}
+
+					return ctx, diags
+				}
+			}
+			interceptors := []intf.FrameworkInterceptor{
+				{When: intf.Before | intf.Finally, Why: intf.Create | intf.Update, Interceptor: interceptor("first")},
+				{When: intf.Before | intf.Finally, Why: intf.Create | intf.Update, Interceptor: interceptor("second")},
+			}
+			opts := intf.FrameworkInterceptorOptions{
+				TypeName: "aws_test",
+				Why:      testCase.Why,
+			}
+
+			var diags diag.Diagnostics
+			interceptedCall(context.Background(), interceptors, opts, &diags, func(context.Context) {
+				got = append(got, "call")
+
+				if testCase.CallError {
+					diags.AddError("call", "")
+				}
+			})
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, expected := diags.HasError(), testCase.BeforeError || testCase.CallError; got != expected {
+				t.Errorf("got error %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
+func TestDeleteProtectionFrameworkInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	meta := &conns.AWSClient{
+		DeleteProtectionConfig: &conns.DeleteProtectionConfig{
+			ResourceTypes: []string{"aws_protected"},
+		},
+	}
+	deleteRequest := resource.DeleteRequest{
+		State: tfsdk.State{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"id": tftypes.String,
+			}}, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "id-1"),
+			}),
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		TypeName string
+		Meta     any
+		Request  any
+		Expected bool
+	}{
+		{
+			Name:     "unprotected",
+			TypeName: "aws_test",
+			Meta:     meta,
+			Request:  deleteRequest,
+		},
+		{
+			Name:     "protected type",
+			TypeName: "aws_protected",
+			Meta:     meta,
+			Request:  deleteRequest,
+			Expected: true,
+		},
+		{
+			Name:     "not a delete",
+			TypeName: "aws_protected",
+			Meta:     meta,
+			Request:  resource.ReadRequest{},
+		},
+		{
+			Name:     "no client",
+			TypeName: "aws_protected",
+			Request:  deleteRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(conns.EnvVarDeleteProtectionOverride, "")
+
+			_, diags := deleteProtectionFrameworkInterceptor(ctx, intf.FrameworkInterceptorOptions{
+				Meta:     testCase.Meta,
+				Request:  testCase.Request,
+				TypeName: testCase.TypeName,
+				When:     intf.Before,
+				Why:      intf.Delete,
+			})
+
+			if got, expected := diags.HasError(), testCase.Expected; got != expected {
+				t.Errorf("got error %t, expected %t: %v", got, expected, diags)
+			}
+		})
+	}
+}
@@ -2,19 +2,21 @@ package fwprovider
 
 import (
 	"context"
-	"fmt"
-	"reflect"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -48,6 +50,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"credential_process": schema.StringAttribute{
+				Optional:    true,
+				Description: "A command that is run to retrieve credentials for API operations. The command must write credentials to stdout in the format used by `credential_process` in the shared config file.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -257,6 +263,26 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"delete_protection": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to prevent the deletion of selected resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_db_instance`, whose deletion is prevented.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags whose presence in a resource's `tags_all` prevents its deletion. An empty value matches any value.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -278,6 +304,40 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"sso": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_token": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "An IAM Identity Center access token, e.g. obtained in CI. Takes precedence over any cached token.",
+						},
+						"account_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The AWS account ID of the role.",
+						},
+						"cached_token_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "The path to the access token cached by `aws sso login`. If not set, defaults to the cached token for start_url.",
+						},
+						"region": schema.StringAttribute{
+							Required:    true,
+							Description: "The region of the IAM Identity Center instance.",
+						},
+						"role_name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the permission set role.",
+						},
+						"start_url": schema.StringAttribute{
+							Optional:    true,
+							Description: "The IAM Identity Center user portal URL.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -301,8 +361,11 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	var dataSources []func() datasource.DataSource
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
-		for _, v := range sp.FrameworkDataSources(ctx) {
-			v, err := v(ctx)
+		interceptors := frameworkInterceptors(ctx, sp)
+
+		for _, factory := range sp.FrameworkDataSources(ctx) {
+			factory := factory
+			v, err := factory(ctx)
 
 			if err != nil {
 				tflog.Warn(ctx, "creating data source", map[string]interface{}{
@@ -313,8 +376,24 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				continue
 			}
 
+			var metadata datasource.MetadataResponse
+			v.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+			innerSchema, outerSchema, regional := dataSourceRegionalSchema(ctx, v)
+			template := wrappedDataSource{
+				innerSchema:  innerSchema,
+				interceptors: interceptors,
+				outerSchema:  outerSchema,
+				regional:     regional,
+				typeName:     metadata.TypeName,
+			}
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(v)
+				// Each instance has its own inner data source as its API clients may be scoped to a Region.
+				// The factory has already succeeded so cannot fail.
+				w := template
+				w.inner, _ = factory(ctx)
+
+				return &w
 			})
 		}
 	}
@@ -331,8 +410,11 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	var resources []func() resource.Resource
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
-		for _, v := range sp.FrameworkResources(ctx) {
-			v, err := v(ctx)
+		interceptors := frameworkInterceptors(ctx, sp)
+
+		for _, factory := range sp.FrameworkResources(ctx) {
+			factory := factory
+			v, err := factory(ctx)
 
 			if err != nil {
 				tflog.Warn(ctx, "creating resource", map[string]interface{}{
@@ -343,8 +425,24 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				continue
 			}
 
+			var metadata resource.MetadataResponse
+			v.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+			innerSchema, outerSchema, regional := resourceRegionalSchema(ctx, v)
+			template := wrappedResource{
+				innerSchema:  innerSchema,
+				interceptors: interceptors,
+				outerSchema:  outerSchema,
+				regional:     regional,
+				typeName:     metadata.TypeName,
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(v)
+				// Each instance has its own inner resource as its API clients may be scoped to a Region.
+				// The factory has already succeeded so cannot fail.
+				w := template
+				w.inner, _ = factory(ctx)
+
+				return &w
 			})
 		}
 	}
@@ -371,12 +469,13 @@ func endpointsBlock() schema.SetNestedBlock {
 
 // wrappedDataSource wraps a data source, adding common functionality.
 type wrappedDataSource struct {
-	inner    datasource.DataSourceWithConfigure
-	typeName string
-}
-
-func newWrappedDataSource(inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
-	return &wrappedDataSource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+	inner        datasource.DataSourceWithConfigure
+	innerSchema  dsschema.Schema
+	interceptors []intf.FrameworkInterceptor
+	meta         *conns.AWSClient
+	outerSchema  dsschema.Schema
+	regional     *regionalSchema
+	typeName     string
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -384,30 +483,54 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 }
 
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
-	w.inner.Schema(ctx, request, response)
+	response.Schema = w.outerSchema
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
-	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
+	if w.regional == nil {
+		w.intercept(ctx, intf.Read, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Read(ctx, request, response)
+		})
+	} else {
+		config, region := w.regional.splitValue(request.Config.Raw, &response.Diagnostics)
+		state, _ := w.regional.splitValue(response.State.Raw, &response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if setRegion(ctx, w.inner, regionString(region), &response.Diagnostics); response.Diagnostics.HasError() {
+			return
+		}
 
-	w.inner.Read(ctx, request, response)
+		request.Config = tfsdk.Config{Raw: config, Schema: w.innerSchema}
+		response.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Read exit", w.typeName))
+		w.intercept(ctx, intf.Read, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Read(ctx, request, response)
+		})
+
+		response.State = tfsdk.State{Raw: w.regional.joinValue(response.State.Raw, region, &response.Diagnostics), Schema: w.outerSchema}
+	}
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
 	w.inner.Configure(ctx, request, response)
 }
 
 // wrappedResource wraps a resource, adding common functionality.
 type wrappedResource struct {
-	inner    resource.ResourceWithConfigure
-	meta     *conns.AWSClient
-	typeName string
-}
-
-func newWrappedResource(inner resource.ResourceWithConfigure) resource.ResourceWithConfigure {
-	return &wrappedResource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+	inner        resource.ResourceWithConfigure
+	innerSchema  rschema.Schema
+	interceptors []intf.FrameworkInterceptor
+	meta         *conns.AWSClient
+	outerSchema  rschema.Schema
+	regional     *regionalSchema
+	typeName     string
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -415,7 +538,7 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 }
 
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
-	w.inner.Schema(ctx, request, response)
+	response.Schema = w.outerSchema
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -423,11 +546,33 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		ctx = w.meta.InitContext(ctx)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Create enter", w.typeName))
+	if w.regional == nil {
+		w.intercept(ctx, intf.Create, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Create(ctx, request, response)
+		})
+	} else {
+		config, _ := w.regional.splitValue(request.Config.Raw, &response.Diagnostics)
+		plan, region := w.regional.splitValue(request.Plan.Raw, &response.Diagnostics)
+		state, _ := w.regional.splitValue(response.State.Raw, &response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
 
-	w.inner.Create(ctx, request, response)
+		if setRegion(ctx, w.inner, regionString(region), &response.Diagnostics); response.Diagnostics.HasError() {
+			return
+		}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Create exit", w.typeName))
+		request.Config = tfsdk.Config{Raw: config, Schema: w.innerSchema}
+		request.Plan = tfsdk.Plan{Raw: plan, Schema: w.innerSchema}
+		response.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
+
+		w.intercept(ctx, intf.Create, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Create(ctx, request, response)
+		})
+
+		response.State = tfsdk.State{Raw: w.regional.joinValue(response.State.Raw, region, &response.Diagnostics), Schema: w.outerSchema}
+	}
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -435,11 +580,31 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		ctx = w.meta.InitContext(ctx)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
+	if w.regional == nil {
+		w.intercept(ctx, intf.Read, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Read(ctx, request, response)
+		})
+	} else {
+		state, region := w.regional.splitValue(request.State.Raw, &response.Diagnostics)
+		newState, _ := w.regional.splitValue(response.State.Raw, &response.Diagnostics)
 
-	w.inner.Read(ctx, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if setRegion(ctx, w.inner, regionString(region), &response.Diagnostics); response.Diagnostics.HasError() {
+			return
+		}
+
+		request.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
+		response.State = tfsdk.State{Raw: newState, Schema: w.innerSchema}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Read exit", w.typeName))
+		w.intercept(ctx, intf.Read, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Read(ctx, request, response)
+		})
+
+		response.State = tfsdk.State{Raw: w.regional.joinValue(response.State.Raw, region, &response.Diagnostics), Schema: w.outerSchema}
+	}
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -447,11 +612,35 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		ctx = w.meta.InitContext(ctx)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Update enter", w.typeName))
+	if w.regional == nil {
+		w.intercept(ctx, intf.Update, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Update(ctx, request, response)
+		})
+	} else {
+		config, _ := w.regional.splitValue(request.Config.Raw, &response.Diagnostics)
+		plan, region := w.regional.splitValue(request.Plan.Raw, &response.Diagnostics)
+		state, _ := w.regional.splitValue(request.State.Raw, &response.Diagnostics)
+		newState, _ := w.regional.splitValue(response.State.Raw, &response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
 
-	w.inner.Update(ctx, request, response)
+		if setRegion(ctx, w.inner, regionString(region), &response.Diagnostics); response.Diagnostics.HasError() {
+			return
+		}
+
+		request.Config = tfsdk.Config{Raw: config, Schema: w.innerSchema}
+		request.Plan = tfsdk.Plan{Raw: plan, Schema: w.innerSchema}
+		request.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
+		response.State = tfsdk.State{Raw: newState, Schema: w.innerSchema}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Update exit", w.typeName))
+		w.intercept(ctx, intf.Update, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Update(ctx, request, response)
+		})
+
+		response.State = tfsdk.State{Raw: w.regional.joinValue(response.State.Raw, region, &response.Diagnostics), Schema: w.outerSchema}
+	}
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -459,11 +648,31 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		ctx = w.meta.InitContext(ctx)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Delete enter", w.typeName))
+	if w.regional == nil {
+		w.intercept(ctx, intf.Delete, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Delete(ctx, request, response)
+		})
+	} else {
+		state, region := w.regional.splitValue(request.State.Raw, &response.Diagnostics)
+		newState, _ := w.regional.splitValue(response.State.Raw, &response.Diagnostics)
 
-	w.inner.Delete(ctx, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if setRegion(ctx, w.inner, regionString(region), &response.Diagnostics); response.Diagnostics.HasError() {
+			return
+		}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s.Delete exit", w.typeName))
+		request.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
+		response.State = tfsdk.State{Raw: newState, Schema: w.innerSchema}
+
+		w.intercept(ctx, intf.Delete, request, response, &response.Diagnostics, func(ctx context.Context) {
+			w.inner.Delete(ctx, request, response)
+		})
+
+		response.State = tfsdk.State{Raw: w.regional.joinValue(response.State.Raw, region, &response.Diagnostics), Schema: w.outerSchema}
+	}
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -480,8 +689,36 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 			ctx = w.meta.InitContext(ctx)
 		}
 
+		if w.regional == nil {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// The import ID may have a Region suffix.
+		id, region := conns.SplitImportIDRegion(request.ID)
+		state, _ := w.regional.splitValue(response.State.Raw, &response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if setRegion(ctx, w.inner, region, &response.Diagnostics); response.Diagnostics.HasError() {
+			return
+		}
+
+		request.ID = id
+		response.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
+
 		v.ImportState(ctx, request, response)
 
+		regionValue := tftypes.NewValue(tftypes.String, nil)
+		if region != "" {
+			regionValue = tftypes.NewValue(tftypes.String, region)
+		}
+
+		response.State = tfsdk.State{Raw: w.regional.joinValue(response.State.Raw, regionValue, &response.Diagnostics), Schema: w.outerSchema}
+
 		return
 	}
 
@@ -497,9 +734,39 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 			ctx = w.meta.InitContext(ctx)
 		}
 
+		if w.regional == nil {
+			v.ModifyPlan(ctx, request, response)
+
+			return
+		}
+
+		config, _ := w.regional.splitValue(request.Config.Raw, &response.Diagnostics)
+		plan, region := w.regional.splitValue(request.Plan.Raw, &response.Diagnostics)
+		state, stateRegion := w.regional.splitValue(request.State.Raw, &response.Diagnostics)
+		newPlan, _ := w.regional.splitValue(response.Plan.Raw, &response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// The planned Region is null when the resource is being destroyed.
+		clientRegion := regionString(region)
+		if request.Plan.Raw.IsNull() {
+			clientRegion = regionString(stateRegion)
+		}
+
+		if setRegion(ctx, w.inner, clientRegion, &response.Diagnostics); response.Diagnostics.HasError() {
+			return
+		}
+
+		request.Config = tfsdk.Config{Raw: config, Schema: w.innerSchema}
+		request.Plan = tfsdk.Plan{Raw: plan, Schema: w.innerSchema}
+		request.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
+		response.Plan = tfsdk.Plan{Raw: newPlan, Schema: w.innerSchema}
+
 		v.ModifyPlan(ctx, request, response)
 
-		return
+		response.Plan = tfsdk.Plan{Raw: w.regional.joinValue(response.Plan.Raw, region, &response.Diagnostics), Schema: w.outerSchema}
 	}
 }
 
@@ -517,6 +784,16 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 			ctx = w.meta.InitContext(ctx)
 		}
 
+		if w.regional != nil {
+			config, _ := w.regional.splitValue(request.Config.Raw, &response.Diagnostics)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			request.Config = tfsdk.Config{Raw: config, Schema: w.innerSchema}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -0,0 +1,232 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const regionAttributeDescription = "The Region in which to manage the resource. Defaults to the Region set in the provider configuration."
+
+// withRegion is implemented by resources and data sources whose API clients can be scoped to a Region.
+type withRegion interface {
+	SetRegion(context.Context, string) error
+}
+
+// regionalSchema converts between values of a resource or data source's own schema and
+// values of its schema with the "region" argument added by the provider.
+type regionalSchema struct {
+	innerType tftypes.Type
+	outerType tftypes.Type
+}
+
+// split returns the value without its "region" attribute, and the "region" attribute's value.
+func (r *regionalSchema) split(v tftypes.Value) (tftypes.Value, tftypes.Value, error) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	if v.IsNull() {
+		return tftypes.NewValue(r.innerType, nil), region, nil
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(r.innerType, tftypes.UnknownValue), region, nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, region, err
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		region = v
+		delete(attributes, names.AttrRegion)
+	}
+
+	return tftypes.NewValue(r.innerType, attributes), region, nil
+}
+
+// join returns the value with the "region" attribute added.
+func (r *regionalSchema) join(v, region tftypes.Value) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(r.outerType, nil), nil
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(r.outerType, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(r.outerType, attributes), nil
+}
+
+// splitValue is split, reporting any error as a diagnostic.
+func (r *regionalSchema) splitValue(v tftypes.Value, diags *diag.Diagnostics) (tftypes.Value, tftypes.Value) {
+	inner, region, err := r.split(v)
+
+	if err != nil {
+		diags.AddError("removing region attribute value", err.Error())
+	}
+
+	return inner, region
+}
+
+// joinValue is join, reporting any error as a diagnostic.
+func (r *regionalSchema) joinValue(v, region tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	outer, err := r.join(v, region)
+
+	if err != nil {
+		diags.AddError("adding region attribute value", err.Error())
+	}
+
+	return outer
+}
+
+// regionString returns the Region in a "region" attribute value, or "" if it is null or unknown.
+func regionString(v tftypes.Value) string {
+	var region string
+
+	if !v.IsKnown() || v.IsNull() {
+		return region
+	}
+
+	if err := v.As(&region); err != nil {
+		return ""
+	}
+
+	return region
+}
+
+func setRegion(ctx context.Context, inner any, region string, diags *diag.Diagnostics) {
+	if v, ok := inner.(withRegion); ok {
+		if err := v.SetRegion(ctx, region); err != nil {
+			diags.AddError(fmt.Sprintf("configuring API clients for Region (%s)", region), err.Error())
+		}
+	}
+}
+
+func regionValidators(typeName string) []validator.String {
+	if conns.IsGlobalResourceType(typeName) {
+		return []validator.String{globalServiceValidator{typeName: typeName}}
+	}
+
+	return []validator.String{fwvalidators.Region()}
+}
+
+// resourceRegionAttribute returns the "region" argument added to resource schemas.
+func resourceRegionAttribute(typeName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: regionAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: regionValidators(typeName),
+	}
+}
+
+// dataSourceRegionAttribute returns the "region" argument added to data source schemas.
+func dataSourceRegionAttribute(typeName string) dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Description: regionAttributeDescription,
+		Validators:  regionValidators(typeName),
+	}
+}
+
+// resourceRegionalSchema returns the resource's own schema, its schema with the "region" argument and
+// the converter between values of the two. The converter is nil if the resource already has a "region" attribute.
+func resourceRegionalSchema(ctx context.Context, inner resource.Resource) (schema.Schema, schema.Schema, *regionalSchema) {
+	var response resource.SchemaResponse
+	inner.Schema(ctx, resource.SchemaRequest{}, &response)
+	innerSchema := response.Schema
+
+	if _, ok := innerSchema.Attributes[names.AttrRegion]; ok {
+		return innerSchema, innerSchema, nil
+	}
+
+	var metadata resource.MetadataResponse
+	inner.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+
+	outerSchema := innerSchema
+	outerSchema.Attributes = make(map[string]schema.Attribute, len(innerSchema.Attributes)+1)
+	for k, v := range innerSchema.Attributes {
+		outerSchema.Attributes[k] = v
+	}
+	outerSchema.Attributes[names.AttrRegion] = resourceRegionAttribute(metadata.TypeName)
+
+	return innerSchema, outerSchema, &regionalSchema{
+		innerType: innerSchema.Type().TerraformType(ctx),
+		outerType: outerSchema.Type().TerraformType(ctx),
+	}
+}
+
+// dataSourceRegionalSchema is the data source equivalent of resourceRegionalSchema.
+func dataSourceRegionalSchema(ctx context.Context, inner datasource.DataSource) (dsschema.Schema, dsschema.Schema, *regionalSchema) {
+	var response datasource.SchemaResponse
+	inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+	innerSchema := response.Schema
+
+	if _, ok := innerSchema.Attributes[names.AttrRegion]; ok {
+		return innerSchema, innerSchema, nil
+	}
+
+	var metadata datasource.MetadataResponse
+	inner.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+
+	outerSchema := innerSchema
+	outerSchema.Attributes = make(map[string]dsschema.Attribute, len(innerSchema.Attributes)+1)
+	for k, v := range innerSchema.Attributes {
+		outerSchema.Attributes[k] = v
+	}
+	outerSchema.Attributes[names.AttrRegion] = dataSourceRegionAttribute(metadata.TypeName)
+
+	return innerSchema, outerSchema, &regionalSchema{
+		innerType: innerSchema.Type().TerraformType(ctx),
+		outerType: outerSchema.Type().TerraformType(ctx),
+	}
+}
+
+// globalServiceValidator rejects the "region" argument of global service resources and data sources.
+type globalServiceValidator struct {
+	typeName string
+}
+
+func (validator globalServiceValidator) Description(_ context.Context) string {
+	return "value must not be set for resources of global services"
+}
+
+func (validator globalServiceValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator globalServiceValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() {
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		validator.Description(ctx),
+		fmt.Sprintf("%s belongs to a global service", validator.typeName),
+	)
+}
@@ -0,0 +1,127 @@
+package fwprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRegionalSchemaSplitJoin(t *testing.T) {
+	t.Parallel()
+
+	innerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id": tftypes.String,
+	}}
+	outerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":             tftypes.String,
+		names.AttrRegion: tftypes.String,
+	}}
+	r := &regionalSchema{
+		innerType: innerType,
+		outerType: outerType,
+	}
+
+	testCases := []struct {
+		Name           string
+		Outer          tftypes.Value
+		ExpectedInner  tftypes.Value
+		ExpectedRegion tftypes.Value
+	}{
+		{
+			Name:           "null",
+			Outer:          tftypes.NewValue(outerType, nil),
+			ExpectedInner:  tftypes.NewValue(innerType, nil),
+			ExpectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			Name:           "unknown",
+			Outer:          tftypes.NewValue(outerType, tftypes.UnknownValue),
+			ExpectedInner:  tftypes.NewValue(innerType, tftypes.UnknownValue),
+			ExpectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			Name: "Region set",
+			Outer: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, "example"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, "us-west-2"),
+			}),
+			ExpectedInner: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "example"),
+			}),
+			ExpectedRegion: tftypes.NewValue(tftypes.String, "us-west-2"),
+		},
+		{
+			Name: "Region null",
+			Outer: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, "example"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, nil),
+			}),
+			ExpectedInner: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "example"),
+			}),
+			ExpectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			Name: "Region unknown",
+			Outer: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			ExpectedInner: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			ExpectedRegion: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			inner, region, err := r.split(testCase.Outer)
+
+			if err != nil {
+				t.Fatalf("split: unexpected error: %s", err)
+			}
+
+			if !inner.Equal(testCase.ExpectedInner) {
+				t.Errorf("split: got %s, expected %s", inner, testCase.ExpectedInner)
+			}
+
+			if !region.Equal(testCase.ExpectedRegion) {
+				t.Errorf("split: got Region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+
+			outer, err := r.join(inner, region)
+
+			if err != nil {
+				t.Fatalf("join: unexpected error: %s", err)
+			}
+
+			if !outer.Equal(testCase.Outer) {
+				t.Errorf("join: got %s, expected %s", outer, testCase.Outer)
+			}
+		})
+	}
+}
+
+func TestRegionString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Value    tftypes.Value
+		Expected string
+	}{
+		{Value: tftypes.NewValue(tftypes.String, "us-west-2"), Expected: "us-west-2"},
+		{Value: tftypes.NewValue(tftypes.String, nil)},
+		{Value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+	}
+
+	for _, testCase := range testCases {
+		if got := regionString(testCase.Value); got != testCase.Expected {
+			t.Errorf("regionString(%s) = %q, expected %q", testCase.Value, got, testCase.Expected)
+		}
+	}
+}
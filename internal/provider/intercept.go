@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+)
+
+// wrapper holds the state used when wrapping a Terraform Plugin SDK resource or data source's handlers.
+type wrapper struct {
+	interceptors []intf.SDKInterceptor
+	isDataSource bool
+	regional     bool
+	typeName     string
+}
+
+// providerSDKInterceptors are run for all Terraform Plugin SDK resources and data sources,
+// before any service package interceptors.
+var providerSDKInterceptors = []intf.SDKInterceptor{
+	{
+		When:        intf.Before | intf.Finally,
+		Why:         intf.AllOps,
+		Interceptor: logSDKInterceptor,
+	},
+	{
+		When:        intf.Before,
+		Why:         intf.Delete,
+		Interceptor: deleteProtectionSDKInterceptor,
+	},
+}
+
+// sdkInterceptors returns the interceptors for the service package's Terraform Plugin SDK resources and data sources.
+func sdkInterceptors(ctx context.Context, sp intf.ServicePackage) []intf.SDKInterceptor {
+	interceptors := providerSDKInterceptors
+
+	if v, ok := sp.(intf.ServicePackageWithSDKInterceptors); ok {
+		interceptors = append(interceptors[:len(interceptors):len(interceptors)], v.SDKInterceptors(ctx)...)
+	}
+
+	return interceptors
+}
+
+// interceptedCRUDFunc returns a CRUD handler that runs f surrounded by the interceptors for the operation.
+// Before interceptors are run in order and the first error from any of them prevents the remaining Before interceptors and f from running.
+// After and OnError interceptors are run in reverse order; OnError interceptors are also run when a Before interceptor fails.
+func (w wrapper) interceptedCRUDFunc(why intf.Why, f func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
+		meta, err := regionalMeta(ctx, d, meta, w.regional)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+
+		// The span encloses all interceptors so that it is always ended.
+		ctx, span := tracing.StartOperation(ctx, w.typeName, why.String(), w.isDataSource)
+		defer func() {
+			tracing.End(span, sdkdiag.DiagnosticsError(diags))
+		}()
+
+		opts := intf.SDKInterceptorOptions{
+			D:            d,
+			IsDataSource: w.isDataSource,
+			Meta:         meta,
+			TypeName:     w.typeName,
+			Why:          why,
+		}
+
+		opts.When = intf.Before
+		for _, v := range w.interceptors {
+			if v.When&opts.When != 0 && v.Why&why != 0 {
+				opts.Diags = diags
+				ctx, diags = v.Interceptor(ctx, opts)
+
+				if diags.HasError() {
+					break
+				}
+			}
+		}
+
+		if !diags.HasError() {
+			diags = append(diags, f(ctx, d, meta)...)
+		}
+
+		opts.When = intf.After
+		if diags.HasError() {
+			opts.When = intf.OnError
+		}
+		for i := len(w.interceptors) - 1; i >= 0; i-- {
+			if v := w.interceptors[i]; v.When&opts.When != 0 && v.Why&why != 0 {
+				opts.Diags = diags
+				ctx, diags = v.Interceptor(ctx, opts)
+			}
+		}
+
+		return diags
+	}
+}
+
+// logSDKInterceptor logs the start and end of each CRUD operation.
+func logSDKInterceptor(ctx context.Context, opts intf.SDKInterceptorOptions) (context.Context, diag.Diagnostics) {
+	tflog.Debug(ctx, fmt.Sprintf("%s.%s %s", opts.TypeName, opts.Why, opts.When))
+
+	return ctx, opts.Diags
+}
+
+// deleteProtectionSDKInterceptor prevents the deletion of resources selected by the provider's delete_protection configuration.
+func deleteProtectionSDKInterceptor(ctx context.Context, opts intf.SDKInterceptorOptions) (context.Context, diag.Diagnostics) {
+	diags := opts.Diags
+
+	// The guardrail can't be applied without a configured provider.
+	meta, ok := opts.Meta.(*conns.AWSClient)
+	if !ok || meta == nil {
+		return ctx, diags
+	}
+
+	var tags tftags.KeyValueTags
+	if v, ok := opts.D.GetOk("tags_all"); ok {
+		tags = tftags.New(v)
+	}
+
+	protectedBy := meta.DeleteProtectionConfig.ProtectedBy(opts.TypeName, tags)
+
+	if protectedBy == "" {
+		return ctx, diags
+	}
+
+	if conns.DeleteProtectionOverridden() {
+		tflog.Warn(ctx, fmt.Sprintf("deleting %s (%s) protected by %s: %s is set", opts.TypeName, opts.D.Id(), protectedBy, conns.EnvVarDeleteProtectionOverride))
+
+		return ctx, diags
+	}
+
+	summary, detail := conns.DeleteProtectionError(opts.TypeName, opts.D.Id(), protectedBy)
+
+	return ctx, append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestInterceptedCRUDFunc(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Why         intf.Why
+		BeforeError bool
+		CRUDError   bool
+		Expected    []string
+	}{
+		{
+			Name:     "success",
+			Why:      intf.Create,
+			Expected: []string{"first Before", "second Before", "crud", "second After", "first After"},
+		},
+		{
+			Name:      "CRUD error",
+			Why:       intf.Update,
+			CRUDError: true,
+			Expected:  []string{"first Before", "second Before", "crud", "second OnError", "first OnError"},
+		},
+		{
+			Name:        "Before error",
+			Why:         intf.Create,
+			BeforeError: true,
+			Expected:    []string{"first Before", "second OnError", "first OnError"},
+		},
+		{
+			Name:        "Before error single interceptor",
+			Why:         intf.Delete,
+			BeforeError: true,
+			Expected:    []string{"first Before", "first OnError"},
+		},
+		{
+			Name:     "not intercepted",
+			Why:      intf.Read,
+			Expected: []string{"crud"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			interceptor := func(name string) intf.SDKInterceptorFunc {
+				return func(ctx context.Context, opts intf.SDKInterceptorOptions) (context.Context, diag.Diagnostics) {
+					when := map[intf.When]string{intf.Before: "Before", intf.After: "After", intf.OnError: "OnError"}[opts.When]
+					got = append(got, name+" "+when)
+
+					if testCase.BeforeError && opts.When == intf.Before {
+						return ctx, append(opts.Diags, diag.FromErr(errors.New("before"))...)
+					}
+
+					return ctx, opts.Diags
+				}
+			}
+			w := wrapper{
+				interceptors: []intf.SDKInterceptor{
+					{When: intf.Before | intf.Finally, Why: intf.Create | intf.Update | intf.Delete, Interceptor: interceptor("first")},
+					{When: intf.Before | intf.Finally, Why: intf.Create | intf.Update, Interceptor: interceptor("second")},
+				},
+				typeName: "aws_test",
+			}
+			f := w.interceptedCRUDFunc(testCase.Why, func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				got = append(got, "crud")
+
+				if testCase.CRUDError {
+					return diag.FromErr(errors.New("crud"))
+				}
+
+				return nil
+			})
+
+			diags := f(context.Background(), nil, &conns.AWSClient{})
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, expected := diags.HasError(), testCase.BeforeError || testCase.CRUDError; got != expected {
+				t.Errorf("got error %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
+func TestDeleteProtectionSDKInterceptor(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"tags_all": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	meta := &conns.AWSClient{
+		DeleteProtectionConfig: &conns.DeleteProtectionConfig{
+			ResourceTypes: []string{"aws_protected"},
+			Tags:          tftags.New(map[string]string{"Environment": "production"}),
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		TypeName string
+		TagsAll  map[string]any
+		Override string
+		NoClient bool
+		Expected bool
+	}{
+		{
+			Name:     "unprotected",
+			TypeName: "aws_test",
+			TagsAll:  map[string]any{"Environment": "staging"},
+		},
+		{
+			Name:     "protected type",
+			TypeName: "aws_protected",
+			Expected: true,
+		},
+		{
+			Name:     "protected tag",
+			TypeName: "aws_test",
+			TagsAll:  map[string]any{"Environment": "production"},
+			Expected: true,
+		},
+		{
+			Name:     "overridden",
+			TypeName: "aws_protected",
+			Override: "true",
+		},
+		{
+			Name:     "no client",
+			TypeName: "aws_protected",
+			NoClient: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(conns.EnvVarDeleteProtectionOverride, testCase.Override)
+
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{"tags_all": testCase.TagsAll})
+			d.SetId("id-1")
+
+			var m any = meta
+			if testCase.NoClient {
+				m = nil
+			}
+
+			_, diags := deleteProtectionSDKInterceptor(context.Background(), intf.SDKInterceptorOptions{
+				D:        d,
+				Meta:     m,
+				TypeName: testCase.TypeName,
+				When:     intf.Before,
+				Why:      intf.Delete,
+			})
+
+			if got, expected := diags.HasError(), testCase.Expected; got != expected {
+				t.Errorf("got error %t, expected %t", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// addRegionAttribute adds the "region" argument, which overrides the provider's Region, to the
+// resource or data source schema. It returns false if the schema already has a "region" attribute.
+func addRegionAttribute(typeName string, r *schema.Resource, isResource bool) bool {
+	if r.Schema == nil {
+		r.Schema = make(map[string]*schema.Schema)
+	}
+
+	if _, ok := r.Schema[names.AttrRegion]; ok {
+		return false
+	}
+
+	v := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The Region in which to manage the resource. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+
+	if isResource {
+		v.ForceNew = true
+	}
+
+	if conns.IsGlobalResourceType(typeName) {
+		v.ValidateFunc = func(_ any, k string) ([]string, []error) {
+			return nil, []error{fmt.Errorf("%q cannot be set: %s belongs to a global service", k, typeName)}
+		}
+	}
+
+	r.Schema[names.AttrRegion] = v
+
+	return true
+}
+
+// regionalMeta returns the provider Meta for the Region in the "region" argument.
+func regionalMeta(ctx context.Context, d interface{ Get(string) any }, meta any, regional bool) (any, error) {
+	if !regional {
+		return meta, nil
+	}
+
+	region, _ := d.Get(names.AttrRegion).(string)
+
+	return meta.(*conns.AWSClient).RegionalClient(ctx, region)
+}
+
+// rawStateGetter reads attributes from raw state.
+type rawStateGetter map[string]any
+
+func (g rawStateGetter) Get(k string) any {
+	return g[k]
+}
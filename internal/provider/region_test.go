@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAddRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		TypeName         string
+		Resource         *schema.Resource
+		IsResource       bool
+		ExpectedAdded    bool
+		ExpectedForceNew bool
+		ExpectedInvalid  bool
+	}{
+		{
+			Name:             "resource",
+			TypeName:         "aws_vpc",
+			Resource:         &schema.Resource{Schema: map[string]*schema.Schema{}},
+			IsResource:       true,
+			ExpectedAdded:    true,
+			ExpectedForceNew: true,
+		},
+		{
+			Name:          "data source",
+			TypeName:      "aws_vpc",
+			Resource:      &schema.Resource{Schema: map[string]*schema.Schema{}},
+			ExpectedAdded: true,
+		},
+		{
+			Name:             "nil schema",
+			TypeName:         "aws_vpc",
+			Resource:         &schema.Resource{},
+			IsResource:       true,
+			ExpectedAdded:    true,
+			ExpectedForceNew: true,
+		},
+		{
+			Name:     "existing region attribute",
+			TypeName: "aws_vpc",
+			Resource: &schema.Resource{Schema: map[string]*schema.Schema{
+				names.AttrRegion: {Type: schema.TypeString, Computed: true},
+			}},
+			IsResource: true,
+		},
+		{
+			Name:             "global service",
+			TypeName:         "aws_iam_role",
+			Resource:         &schema.Resource{Schema: map[string]*schema.Schema{}},
+			IsResource:       true,
+			ExpectedAdded:    true,
+			ExpectedForceNew: true,
+			ExpectedInvalid:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			added := addRegionAttribute(testCase.TypeName, testCase.Resource, testCase.IsResource)
+
+			if added != testCase.ExpectedAdded {
+				t.Fatalf("got added %t, expected %t", added, testCase.ExpectedAdded)
+			}
+
+			v := testCase.Resource.Schema[names.AttrRegion]
+
+			if !added {
+				if !v.Computed {
+					t.Errorf("existing region attribute was replaced")
+				}
+				return
+			}
+
+			if v.ForceNew != testCase.ExpectedForceNew {
+				t.Errorf("got ForceNew %t, expected %t", v.ForceNew, testCase.ExpectedForceNew)
+			}
+
+			_, errs := v.ValidateFunc("us-west-2", names.AttrRegion)
+
+			if got := len(errs) > 0; got != testCase.ExpectedInvalid {
+				t.Errorf("got invalid %t, expected %t: %v", got, testCase.ExpectedInvalid, errs)
+			}
+		})
+	}
+}
+
+func TestWrappedStateContextFunc_regionSuffix(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		Regional       bool
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "no suffix",
+			ImportID:   "vpc-12345678",
+			Regional:   true,
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			Name:           "Region suffix",
+			ImportID:       "vpc-12345678@us-west-2",
+			Regional:       true,
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-west-2",
+		},
+		{
+			Name:       "not regional",
+			ImportID:   "vpc-12345678@us-west-2",
+			ExpectedID: "vpc-12345678@us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{Schema: map[string]*schema.Schema{}}
+			addRegionAttribute("aws_vpc", r, true)
+
+			d := r.Data(nil)
+			d.SetId(testCase.ImportID)
+
+			meta := &conns.AWSClient{Region: "us-west-2"}
+
+			var gotMeta any
+			f := wrappedStateContextFunc(func(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				gotMeta = meta
+				return []*schema.ResourceData{d}, nil
+			}, wrapper{regional: testCase.Regional, typeName: "aws_vpc"})
+
+			ds, err := f(context.Background(), d, meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := ds[0].Id(); got != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", got, testCase.ExpectedID)
+			}
+
+			if got := ds[0].Get(names.AttrRegion).(string); got != testCase.ExpectedRegion {
+				t.Errorf("got Region %s, expected %s", got, testCase.ExpectedRegion)
+			}
+
+			if gotMeta != meta {
+				t.Errorf("expected the provider Meta for its own Region")
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -24,7 +25,7 @@ func waitAlternateContactCreated(ctx context.Context, conn *account.Account, acc
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*account.AlternateContact); ok {
 		return output, err
@@ -43,7 +44,7 @@ func waitAlternateContactUpdated(ctx context.Context, conn *account.Account, acc
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -56,7 +57,7 @@ func waitAlternateContactDeleted(ctx context.Context, conn *account.Account, acc
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
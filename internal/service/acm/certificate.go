@@ -44,6 +44,7 @@ const (
 	certificateValidationMethodNone = "NONE"
 )
 
+// @SDKResource("aws_acm_certificate")
 func ResourceCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCertificateCreate,
@@ -900,7 +901,7 @@ func waitCertificateDomainValidationsAvailable(ctx context.Context, conn *acm.AC
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*acm.CertificateDetail); ok {
 		return output, err
@@ -940,7 +941,7 @@ func WaitCertificateRenewed(ctx context.Context, conn *acm.ACM, arn string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*acm.CertificateDetail); ok {
 		return output, err
@@ -11,72 +11,72 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_acmpca_certificate",
+			Factory:  DataSourceCertificate,
+		},
+		{
+			TypeName: "aws_acmpca_certificate_authority",
+			Factory:  DataSourceCertificateAuthority,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "acmpca"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_acmpca_certificate",
+			Factory:  ResourceCertificate,
+		},
+		{
+			TypeName: "aws_acmpca_certificate_authority",
+			Factory:  ResourceCertificateAuthority,
+		},
+		{
+			TypeName: "aws_acmpca_certificate_authority_certificate",
+			Factory:  ResourceCertificateAuthorityCertificate,
+		},
+		{
+			TypeName: "aws_acmpca_permission",
+			Factory:  ResourcePermission,
+		},
+		{
+			TypeName: "aws_acmpca_policy",
+			Factory:  ResourcePolicy,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "acmpca"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // waitCertificateAuthorityCreated waits for a CertificateAuthority to return Active or PendingCertificate
@@ -17,7 +18,7 @@ func waitCertificateAuthorityCreated(ctx context.Context, conn *acmpca.ACMPCA, a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*acmpca.CertificateAuthority); ok {
 		return v, err
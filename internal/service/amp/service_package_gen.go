@@ -11,72 +11,60 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_prometheus_workspace",
+			Factory:  DataSourceWorkspace,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "amp"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_prometheus_alert_manager_definition",
+			Factory:  ResourceAlertManagerDefinition,
+		},
+		{
+			TypeName: "aws_prometheus_rule_group_namespace",
+			Factory:  ResourceRuleGroupNamespace,
+		},
+		{
+			TypeName: "aws_prometheus_workspace",
+			Factory:  ResourceWorkspace,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "amp"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
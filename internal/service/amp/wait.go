@@ -24,7 +24,7 @@ func waitAlertManagerDefinitionCreated(ctx context.Context, conn *prometheusserv
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
 		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.AlertManagerDefinitionStatusCodeCreationFailed {
@@ -45,7 +45,7 @@ func waitAlertManagerDefinitionUpdated(ctx context.Context, conn *prometheusserv
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
 		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.AlertManagerDefinitionStatusCodeUpdateFailed {
@@ -66,7 +66,7 @@ func waitAlertManagerDefinitionDeleted(ctx context.Context, conn *prometheusserv
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
 		return output, err
@@ -83,7 +83,7 @@ func waitRuleGroupNamespaceDeleted(ctx context.Context, conn *prometheusservice.
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -100,7 +100,7 @@ func waitRuleGroupNamespaceCreated(ctx context.Context, conn *prometheusservice.
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -117,7 +117,7 @@ func waitRuleGroupNamespaceUpdated(ctx context.Context, conn *prometheusservice.
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -134,7 +134,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *prometheusservice.Prometheu
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.WorkspaceDescription); ok {
 		return output, err
@@ -151,7 +151,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *prometheusservice.Prometheu
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.WorkspaceDescription); ok {
 		return output, err
@@ -168,7 +168,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *prometheusservice.Prometheu
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.WorkspaceDescription); ok {
 		return output, err
@@ -185,7 +185,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *prometheusservic
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.LoggingConfigurationMetadata); ok {
 		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.LoggingConfigurationStatusCodeCreationFailed {
@@ -206,7 +206,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *prometheusservic
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.LoggingConfigurationMetadata); ok {
 		return output, err
@@ -223,7 +223,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *prometheusservic
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.LoggingConfigurationMetadata); ok {
 		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.LoggingConfigurationStatusCodeUpdateFailed {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_api_gateway_method_settings")
 func ResourceMethodSettings() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMethodSettingsUpdate,
@@ -11,72 +11,168 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_api_gateway_api_key",
+			Factory:  DataSourceAPIKey,
+		},
+		{
+			TypeName: "aws_api_gateway_domain_name",
+			Factory:  DataSourceDomainName,
+		},
+		{
+			TypeName: "aws_api_gateway_export",
+			Factory:  DataSourceExport,
+		},
+		{
+			TypeName: "aws_api_gateway_resource",
+			Factory:  DataSourceResource,
+		},
+		{
+			TypeName: "aws_api_gateway_rest_api",
+			Factory:  DataSourceRestAPI,
+		},
+		{
+			TypeName: "aws_api_gateway_sdk",
+			Factory:  DataSourceSdk,
+		},
+		{
+			TypeName: "aws_api_gateway_vpc_link",
+			Factory:  DataSourceVPCLink,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "apigateway"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_api_gateway_account",
+			Factory:  ResourceAccount,
+		},
+		{
+			TypeName: "aws_api_gateway_api_key",
+			Factory:  ResourceAPIKey,
+		},
+		{
+			TypeName: "aws_api_gateway_authorizer",
+			Factory:  ResourceAuthorizer,
+		},
+		{
+			TypeName: "aws_api_gateway_base_path_mapping",
+			Factory:  ResourceBasePathMapping,
+		},
+		{
+			TypeName: "aws_api_gateway_client_certificate",
+			Factory:  ResourceClientCertificate,
+		},
+		{
+			TypeName: "aws_api_gateway_deployment",
+			Factory:  ResourceDeployment,
+		},
+		{
+			TypeName: "aws_api_gateway_documentation_part",
+			Factory:  ResourceDocumentationPart,
+		},
+		{
+			TypeName: "aws_api_gateway_documentation_version",
+			Factory:  ResourceDocumentationVersion,
+		},
+		{
+			TypeName: "aws_api_gateway_domain_name",
+			Factory:  ResourceDomainName,
+		},
+		{
+			TypeName: "aws_api_gateway_gateway_response",
+			Factory:  ResourceGatewayResponse,
+		},
+		{
+			TypeName: "aws_api_gateway_integration",
+			Factory:  ResourceIntegration,
+		},
+		{
+			TypeName: "aws_api_gateway_integration_response",
+			Factory:  ResourceIntegrationResponse,
+		},
+		{
+			TypeName: "aws_api_gateway_method",
+			Factory:  ResourceMethod,
+		},
+		{
+			TypeName: "aws_api_gateway_method_response",
+			Factory:  ResourceMethodResponse,
+		},
+		{
+			TypeName: "aws_api_gateway_method_settings",
+			Factory:  ResourceMethodSettings,
+		},
+		{
+			TypeName: "aws_api_gateway_model",
+			Factory:  ResourceModel,
+		},
+		{
+			TypeName: "aws_api_gateway_request_validator",
+			Factory:  ResourceRequestValidator,
+		},
+		{
+			TypeName: "aws_api_gateway_resource",
+			Factory:  ResourceResource,
+		},
+		{
+			TypeName: "aws_api_gateway_rest_api",
+			Factory:  ResourceRestAPI,
+		},
+		{
+			TypeName: "aws_api_gateway_rest_api_policy",
+			Factory:  ResourceRestAPIPolicy,
+		},
+		{
+			TypeName: "aws_api_gateway_stage",
+			Factory:  ResourceStage,
+		},
+		{
+			TypeName: "aws_api_gateway_usage_plan",
+			Factory:  ResourceUsagePlan,
+		},
+		{
+			TypeName: "aws_api_gateway_usage_plan_key",
+			Factory:  ResourceUsagePlanKey,
+		},
+		{
+			TypeName: "aws_api_gateway_vpc_link",
+			Factory:  ResourceVPCLink,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "apigateway"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -31,7 +32,7 @@ func waitVPCLinkAvailable(ctx context.Context, conn *apigateway.APIGateway, vpcL
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -49,7 +50,7 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigateway.APIGateway, vpcLin
 		Refresh:    vpcLinkStatus(ctx, conn, vpcLinkId),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	return err
 }
@@ -66,7 +67,7 @@ func waitStageCacheAvailable(ctx context.Context, conn *apigateway.APIGateway, r
 		Timeout: stageCacheAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigateway.Stage); ok {
 		return output, err
@@ -91,7 +92,7 @@ func waitStageCacheUpdated(ctx context.Context, conn *apigateway.APIGateway, res
 		Timeout: stageCacheUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigateway.Stage); ok {
 		return output, err
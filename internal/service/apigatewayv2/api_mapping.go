@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_apigatewayv2_api_mapping")
 func ResourceAPIMapping() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAPIMappingCreate,
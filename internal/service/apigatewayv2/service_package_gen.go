@@ -11,72 +11,104 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_apigatewayv2_api",
+			Factory:  DataSourceAPI,
+		},
+		{
+			TypeName: "aws_apigatewayv2_apis",
+			Factory:  DataSourceAPIs,
+		},
+		{
+			TypeName: "aws_apigatewayv2_export",
+			Factory:  DataSourceExport,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "apigatewayv2"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_apigatewayv2_api",
+			Factory:  ResourceAPI,
+		},
+		{
+			TypeName: "aws_apigatewayv2_api_mapping",
+			Factory:  ResourceAPIMapping,
+		},
+		{
+			TypeName: "aws_apigatewayv2_authorizer",
+			Factory:  ResourceAuthorizer,
+		},
+		{
+			TypeName: "aws_apigatewayv2_deployment",
+			Factory:  ResourceDeployment,
+		},
+		{
+			TypeName: "aws_apigatewayv2_domain_name",
+			Factory:  ResourceDomainName,
+		},
+		{
+			TypeName: "aws_apigatewayv2_integration",
+			Factory:  ResourceIntegration,
+		},
+		{
+			TypeName: "aws_apigatewayv2_integration_response",
+			Factory:  ResourceIntegrationResponse,
+		},
+		{
+			TypeName: "aws_apigatewayv2_model",
+			Factory:  ResourceModel,
+		},
+		{
+			TypeName: "aws_apigatewayv2_route",
+			Factory:  ResourceRoute,
+		},
+		{
+			TypeName: "aws_apigatewayv2_route_response",
+			Factory:  ResourceRouteResponse,
+		},
+		{
+			TypeName: "aws_apigatewayv2_stage",
+			Factory:  ResourceStage,
+		},
+		{
+			TypeName: "aws_apigatewayv2_vpc_link",
+			Factory:  ResourceVPCLink,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "apigatewayv2"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
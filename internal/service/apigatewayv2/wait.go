@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -28,7 +29,7 @@ func WaitDeploymentDeployed(ctx context.Context, conn *apigatewayv2.ApiGatewayV2
 		Timeout: DeploymentDeployedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetDeploymentOutput); ok {
 		return v, err
@@ -45,7 +46,7 @@ func WaitDomainNameAvailable(ctx context.Context, conn *apigatewayv2.ApiGatewayV
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetDomainNameOutput); ok {
 		return v, err
@@ -63,7 +64,7 @@ func WaitVPCLinkAvailable(ctx context.Context, conn *apigatewayv2.ApiGatewayV2,
 		Timeout: VPCLinkAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		return v, err
@@ -81,7 +82,7 @@ func WaitVPCLinkDeleted(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, vp
 		Timeout: VPCLinkDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		return v, err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/appflow"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -20,7 +21,7 @@ func FlowDeleted(ctx context.Context, conn *appflow.Appflow, id string) error {
 		Timeout: FlowDeletionTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
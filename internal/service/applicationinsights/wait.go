@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/applicationinsights"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,7 +23,7 @@ func waitApplicationCreated(ctx context.Context, conn *applicationinsights.Appli
 		Timeout: ApplicationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*applicationinsights.ApplicationInfo); ok {
 		return output, err
 	}
@@ -38,7 +39,7 @@ func waitApplicationTerminated(ctx context.Context, conn *applicationinsights.Ap
 		Timeout: ApplicationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*applicationinsights.ApplicationInfo); ok {
 		return output, err
 	}
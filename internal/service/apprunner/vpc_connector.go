@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_apprunner_vpc_connector")
 func ResourceVPCConnector() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCConnectorCreate,
@@ -251,7 +252,7 @@ func waitVPCConnectorCreated(ctx context.Context, conn *apprunner.AppRunner, arn
 		Timeout: vpcConnectorCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -264,7 +265,7 @@ func waitVPCConnectorDeleted(ctx context.Context, conn *apprunner.AppRunner, arn
 		Timeout: vpcConnectorDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
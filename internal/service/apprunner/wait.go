@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -36,7 +37,7 @@ func WaitAutoScalingConfigurationActive(ctx context.Context, conn *apprunner.App
 		Timeout: AutoScalingConfigurationCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -49,7 +50,7 @@ func WaitAutoScalingConfigurationInactive(ctx context.Context, conn *apprunner.A
 		Timeout: AutoScalingConfigurationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -62,7 +63,7 @@ func WaitConnectionDeleted(ctx context.Context, conn *apprunner.AppRunner, name
 		Timeout: ConnectionDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -75,7 +76,7 @@ func WaitCustomDomainAssociationCreated(ctx context.Context, conn *apprunner.App
 		Timeout: CustomDomainAssociationCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -88,7 +89,7 @@ func WaitCustomDomainAssociationDeleted(ctx context.Context, conn *apprunner.App
 		Timeout: CustomDomainAssociationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -101,7 +102,7 @@ func WaitObservabilityConfigurationActive(ctx context.Context, conn *apprunner.A
 		Timeout: ObservabilityConfigurationCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -114,7 +115,7 @@ func WaitObservabilityConfigurationInactive(ctx context.Context, conn *apprunner
 		Timeout: ObservabilityConfigurationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -127,7 +128,7 @@ func WaitVPCIngressConnectionActive(ctx context.Context, conn *apprunner.AppRunn
 		Timeout: VPCIngressConnectionCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -140,7 +141,7 @@ func WaitVPCIngressConnectionDeleted(ctx context.Context, conn *apprunner.AppRun
 		Timeout: VPCIngressConnectionDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -153,7 +154,7 @@ func WaitServiceCreated(ctx context.Context, conn *apprunner.AppRunner, serviceA
 		Timeout: ServiceCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -166,7 +167,7 @@ func WaitServiceUpdated(ctx context.Context, conn *apprunner.AppRunner, serviceA
 		Timeout: ServiceUpdateTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -179,7 +180,7 @@ func WaitServiceDeleted(ctx context.Context, conn *apprunner.AppRunner, serviceA
 		Timeout: ServiceDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -38,7 +38,7 @@ func waitStackStateDeleted(ctx context.Context, conn *appstream.AppStream, name
 		Timeout: stackOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appstream.Stack); ok {
 		if errors := output.StackErrors; len(errors) > 0 {
@@ -66,7 +66,7 @@ func waitFleetStateRunning(ctx context.Context, conn *appstream.AppStream, name
 		Timeout: fleetStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appstream.Fleet); ok {
 		if errors := output.FleetErrors; len(errors) > 0 {
@@ -94,7 +94,7 @@ func waitFleetStateStopped(ctx context.Context, conn *appstream.AppStream, name
 		Timeout: fleetStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appstream.Fleet); ok {
 		if errors := output.FleetErrors; len(errors) > 0 {
@@ -121,7 +121,7 @@ func waitImageBuilderStateRunning(ctx context.Context, conn *appstream.AppStream
 		Timeout: imageBuilderStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
 		if state, errors := aws.StringValue(output.State), output.ImageBuilderErrors; state == appstream.ImageBuilderStateFailed && len(errors) > 0 {
@@ -148,7 +148,7 @@ func waitImageBuilderStateDeleted(ctx context.Context, conn *appstream.AppStream
 		Timeout: imageBuilderStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
 		if state, errors := aws.StringValue(output.State), output.ImageBuilderErrors; state == appstream.ImageBuilderStateFailed && len(errors) > 0 {
@@ -175,7 +175,7 @@ func waitUserAvailable(ctx context.Context, conn *appstream.AppStream, username,
 		Timeout: userOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appstream.User); ok {
 		return output, err
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -23,6 +24,7 @@ var validateAuthorizerResultTTLInSeconds = validation.IntBetween(0, 3600)
 
 const DefaultAuthorizerResultTTLInSeconds = 300
 
+// @SDKResource("aws_appsync_graphql_api")
 func ResourceGraphQLAPI() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGraphQLAPICreate,
@@ -734,7 +736,7 @@ func resourceSchemaPut(ctx context.Context, d *schema.ResourceData, meta interfa
 			Timeout: d.Timeout(schema.TimeoutCreate),
 		}
 
-		if _, err := activeSchemaConfig.WaitForStateContext(ctx); err != nil {
+		if _, err := tfresource.WaitForStateContext(ctx, activeSchemaConfig); err != nil {
 			return fmt.Errorf("waiting for completion: %s", err)
 		}
 	}
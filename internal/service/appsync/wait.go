@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -23,7 +24,7 @@ func waitAPICacheAvailable(ctx context.Context, conn *appsync.AppSync, id string
 		Timeout: apiCacheAvailableTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -36,7 +37,7 @@ func waitAPICacheDeleted(ctx context.Context, conn *appsync.AppSync, id string)
 		Timeout: apiCacheDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -49,7 +50,7 @@ func waitDomainNameAPIAssociation(ctx context.Context, conn *appsync.AppSync, id
 		Timeout: domainNameAPIAssociationTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -62,7 +63,7 @@ func waitDomainNameAPIDisassociation(ctx context.Context, conn *appsync.AppSync,
 		Timeout: domainNameAPIDisassociationTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -0,0 +1,164 @@
+package athena
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_athena_capacity_assignment_configuration")
+func ResourceCapacityAssignmentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityAssignmentConfigurationPut,
+		ReadWithoutTimeout:   resourceCapacityAssignmentConfigurationRead,
+		UpdateWithoutTimeout: resourceCapacityAssignmentConfigurationPut,
+		DeleteWithoutTimeout: resourceCapacityAssignmentConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_assignment": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"workgroup_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"capacity_reservation_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCapacityAssignmentConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	name := d.Get("capacity_reservation_name").(string)
+	input := &athena.PutCapacityAssignmentConfigurationInput{
+		CapacityAssignments:     expandCapacityAssignments(d.Get("capacity_assignment").([]interface{})),
+		CapacityReservationName: aws.String(name),
+	}
+
+	_, err := conn.PutCapacityAssignmentConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Athena Capacity Assignment Configuration (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceCapacityAssignmentConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityAssignmentConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	configuration, err := FindCapacityAssignmentConfigurationByReservationName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Capacity Assignment Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Capacity Assignment Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("capacity_assignment", flattenCapacityAssignments(configuration.CapacityAssignments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting capacity_assignment: %s", err)
+	}
+	d.Set("capacity_reservation_name", configuration.CapacityReservationName)
+
+	return diags
+}
+
+func resourceCapacityAssignmentConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	// There is no delete operation; removing all assignments releases the workgroups.
+	log.Printf("[DEBUG] Deleting Athena Capacity Assignment Configuration: %s", d.Id())
+	_, err := conn.PutCapacityAssignmentConfigurationWithContext(ctx, &athena.PutCapacityAssignmentConfigurationInput{
+		CapacityAssignments:     []*athena.CapacityAssignment{},
+		CapacityReservationName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Athena Capacity Assignment Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandCapacityAssignments(tfList []interface{}) []*athena.CapacityAssignment {
+	apiObjects := []*athena.CapacityAssignment{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &athena.CapacityAssignment{}
+
+		if v, ok := tfMap["workgroup_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.WorkGroupNames = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCapacityAssignments(apiObjects []*athena.CapacityAssignment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"workgroup_names": aws.StringValueSlice(apiObject.WorkGroupNames),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,159 @@
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAthenaCapacityAssignmentConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuration athena.CapacityAssignmentConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_assignment_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityAssignmentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityAssignmentConfigurationConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityAssignmentConfigurationExists(ctx, resourceName, &configuration),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.0.workgroup_names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "capacity_reservation_name", "aws_athena_capacity_reservation.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityAssignmentConfigurationConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityAssignmentConfigurationExists(ctx, resourceName, &configuration),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.0.workgroup_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityAssignmentConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuration athena.CapacityAssignmentConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_assignment_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityAssignmentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityAssignmentConfigurationConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityAssignmentConfigurationExists(ctx, resourceName, &configuration),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfathena.ResourceCapacityAssignmentConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityAssignmentConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_capacity_assignment_configuration" {
+				continue
+			}
+
+			_, err := tfathena.FindCapacityAssignmentConfigurationByReservationName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Athena Capacity Assignment Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityAssignmentConfigurationExists(ctx context.Context, n string, v *athena.CapacityAssignmentConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Capacity Assignment Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+		output, err := tfathena.FindCapacityAssignmentConfigurationByReservationName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCapacityAssignmentConfigurationConfig_basic(rName string, workGroupCount int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+}
+
+resource "aws_athena_workgroup" "test" {
+  count = %[2]d
+
+  name          = "%[1]s-${count.index}"
+  force_destroy = true
+
+  configuration {
+    engine_version {
+      selected_engine_version = "Athena engine version 3"
+    }
+  }
+}
+
+resource "aws_athena_capacity_assignment_configuration" "test" {
+  capacity_reservation_name = aws_athena_capacity_reservation.test.name
+
+  capacity_assignment {
+    workgroup_names = aws_athena_workgroup.test[*].name
+  }
+}
+`, rName, workGroupCount)
+}
@@ -0,0 +1,240 @@
+package athena
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_athena_capacity_reservation")
+func ResourceCapacityReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityReservationCreate,
+		ReadWithoutTimeout:   resourceCapacityReservationRead,
+		UpdateWithoutTimeout: resourceCapacityReservationUpdate,
+		DeleteWithoutTimeout: resourceCapacityReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_dpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_dpus": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(24),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCapacityReservationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &athena.CreateCapacityReservationInput{
+		Name:       aws.String(name),
+		TargetDpus: aws.Int64(int64(d.Get("target_dpus").(int))),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateCapacityReservationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Athena Capacity Reservation (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCapacityReservationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	reservation, err := FindCapacityReservationByName(ctx, conn, d.Id())
+
+	if err == nil && aws.StringValue(reservation.Status) == athena.CapacityReservationStatusCancelled {
+		err = &resource.NotFoundError{
+			Message: aws.StringValue(reservation.Status),
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Capacity Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "athena",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("capacity-reservation/%s", d.Id()),
+	}.String()
+	d.Set("allocated_dpus", reservation.AllocatedDpus)
+	d.Set("arn", arn)
+	d.Set("name", reservation.Name)
+	d.Set("status", reservation.Status)
+	d.Set("target_dpus", reservation.TargetDpus)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceCapacityReservationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	if d.HasChange("target_dpus") {
+		input := &athena.UpdateCapacityReservationInput{
+			Name:       aws.String(d.Id()),
+			TargetDpus: aws.Int64(int64(d.Get("target_dpus").(int))),
+		}
+
+		_, err := conn.UpdateCapacityReservationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Athena Capacity Reservation (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Athena Capacity Reservation (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCapacityReservationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	reservation, err := FindCapacityReservationByName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	// A capacity reservation must be cancelled before it can be deleted.
+	if status := aws.StringValue(reservation.Status); status != athena.CapacityReservationStatusCancelled && status != athena.CapacityReservationStatusFailed {
+		if status != athena.CapacityReservationStatusCancelling {
+			log.Printf("[DEBUG] Cancelling Athena Capacity Reservation: %s", d.Id())
+			_, err := conn.CancelCapacityReservationWithContext(ctx, &athena.CancelCapacityReservationInput{
+				Name: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "cancelling Athena Capacity Reservation (%s): %s", d.Id(), err)
+			}
+		}
+
+		if _, err := waitCapacityReservationCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) cancel: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Capacity Reservation: %s", d.Id())
+	_, err = conn.DeleteCapacityReservationWithContext(ctx, &athena.DeleteCapacityReservationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -18,8 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_athena_database")
 func ResourceDatabase() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDatabaseCreate,
@@ -276,7 +278,7 @@ func QueryExecutionResult(ctx context.Context, conn *athena.Athena, qeid string)
 		Delay:      3 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err := executionStateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, executionStateConf)
 	if err != nil {
 		return nil, err
 	}
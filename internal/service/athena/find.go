@@ -0,0 +1,87 @@
+package athena
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCapacityAssignmentConfigurationByReservationName(ctx context.Context, conn *athena.Athena, name string) (*athena.CapacityAssignmentConfiguration, error) {
+	input := &athena.GetCapacityAssignmentConfigurationInput{
+		CapacityReservationName: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityAssignmentConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityAssignmentConfiguration == nil || len(output.CapacityAssignmentConfiguration.CapacityAssignments) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityAssignmentConfiguration, nil
+}
+
+func FindCapacityReservationByName(ctx context.Context, conn *athena.Athena, name string) (*athena.CapacityReservation, error) {
+	input := &athena.GetCapacityReservationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityReservationWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityReservation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityReservation, nil
+}
+
+func FindPreparedStatementByTwoPartKey(ctx context.Context, conn *athena.Athena, workGroupName, statementName string) (*athena.PreparedStatement, error) {
+	input := &athena.GetPreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	}
+
+	output, err := conn.GetPreparedStatementWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PreparedStatement == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PreparedStatement, nil
+}
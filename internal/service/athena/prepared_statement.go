@@ -0,0 +1,188 @@
+package athena
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_athena_prepared_statement")
+func ResourcePreparedStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePreparedStatementCreate,
+		ReadWithoutTimeout:   resourcePreparedStatementRead,
+		UpdateWithoutTimeout: resourcePreparedStatementUpdate,
+		DeleteWithoutTimeout: resourcePreparedStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePreparedStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName := d.Get("workgroup").(string), d.Get("name").(string)
+	id := PreparedStatementCreateResourceID(workGroupName, statementName)
+	input := &athena.CreatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreatePreparedStatementWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Athena Prepared Statement (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourcePreparedStatementRead(ctx, d, meta)...)
+}
+
+func resourcePreparedStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	preparedStatement, err := FindPreparedStatementByTwoPartKey(ctx, conn, workGroupName, statementName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Prepared Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", preparedStatement.Description)
+	d.Set("name", preparedStatement.StatementName)
+	d.Set("query_statement", preparedStatement.QueryStatement)
+	d.Set("workgroup", preparedStatement.WorkGroupName)
+
+	return diags
+}
+
+func resourcePreparedStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &athena.UpdatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.UpdatePreparedStatementWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePreparedStatementRead(ctx, d, meta)...)
+}
+
+func resourcePreparedStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Prepared Statement: %s", d.Id())
+	_, err = conn.DeletePreparedStatementWithContext(ctx, &athena.DeletePreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const preparedStatementResourceIDSeparator = "/"
+
+func PreparedStatementCreateResourceID(workGroupName, statementName string) string {
+	parts := []string{workGroupName, statementName}
+	id := strings.Join(parts, preparedStatementResourceIDSeparator)
+
+	return id
+}
+
+func PreparedStatementParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, preparedStatementResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKGROUP%[2]sSTATEMENT_NAME", id, preparedStatementResourceIDSeparator)
+}
@@ -0,0 +1,158 @@
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAthenaPreparedStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preparedStatement athena.PreparedStatement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreparedStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, "description 1", "SELECT * FROM test WHERE x = ?"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName, &preparedStatement),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT * FROM test WHERE x = ?"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", "aws_athena_workgroup.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, "description 2", "SELECT * FROM test WHERE y = ?"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName, &preparedStatement),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT * FROM test WHERE y = ?"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaPreparedStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preparedStatement athena.PreparedStatement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreparedStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, "description 1", "SELECT * FROM test WHERE x = ?"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName, &preparedStatement),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfathena.ResourcePreparedStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPreparedStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_prepared_statement" {
+				continue
+			}
+
+			workGroupName, statementName, err := tfathena.PreparedStatementParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfathena.FindPreparedStatementByTwoPartKey(ctx, conn, workGroupName, statementName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Athena Prepared Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPreparedStatementExists(ctx context.Context, n string, v *athena.PreparedStatement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Prepared Statement ID is set")
+		}
+
+		workGroupName, statementName, err := tfathena.PreparedStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+		output, err := tfathena.FindPreparedStatementByTwoPartKey(ctx, conn, workGroupName, statementName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreparedStatementConfig_basic(rName, description, queryStatement string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_prepared_statement" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  query_statement = %[3]q
+  workgroup       = aws_athena_workgroup.test.name
+}
+`, rName, description, queryStatement)
+}
@@ -0,0 +1,54 @@
+package athena
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitCapacityReservationActive(ctx context.Context, conn *athena.Athena, name string, timeout time.Duration) (*athena.CapacityReservation, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{athena.CapacityReservationStatusPending, athena.CapacityReservationStatusUpdatePending},
+		Target:  []string{athena.CapacityReservationStatusActive},
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*athena.CapacityReservation); ok {
+		if v := output.LastAllocation; v != nil && aws.StringValue(v.Status) == athena.CapacityAllocationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationCancelled(ctx context.Context, conn *athena.Athena, name string, timeout time.Duration) (*athena.CapacityReservation, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{athena.CapacityReservationStatusPending, athena.CapacityReservationStatusActive, athena.CapacityReservationStatusUpdatePending, athena.CapacityReservationStatusCancelling},
+		Target:  []string{athena.CapacityReservationStatusCancelled},
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*athena.CapacityReservation); ok {
+		if v := output.LastAllocation; v != nil && aws.StringValue(v.Status) == athena.CapacityAllocationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
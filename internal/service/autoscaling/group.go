@@ -32,6 +32,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_autoscaling_group")
 func ResourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -2350,7 +2351,7 @@ func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.AutoScali
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(struct{ err error }); ok {
 		tfresource.SetLastError(err, output.err)
@@ -2366,7 +2367,7 @@ func waitGroupDrained(ctx context.Context, conn *autoscaling.AutoScaling, name s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.Group); ok {
 		return output, err
@@ -2382,7 +2383,7 @@ func waitLoadBalancersAdded(ctx context.Context, conn *autoscaling.AutoScaling,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerState); ok {
 		return output, err
@@ -2398,7 +2399,7 @@ func waitLoadBalancersRemoved(ctx context.Context, conn *autoscaling.AutoScaling
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerState); ok {
 		return output, err
@@ -2414,7 +2415,7 @@ func waitLoadBalancerTargetGroupsAdded(ctx context.Context, conn *autoscaling.Au
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2430,7 +2431,7 @@ func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*autoscaling.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2465,7 +2466,7 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoSca
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
 		return output, err
@@ -2482,7 +2483,7 @@ func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.AutoScaling, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.WarmPoolConfiguration); ok {
 		return output, err
@@ -2498,7 +2499,7 @@ func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.AutoScaling, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.DescribeWarmPoolOutput); ok {
 		return output, err
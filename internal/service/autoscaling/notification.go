@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
+// @SDKResource("aws_autoscaling_notification")
 func ResourceNotification() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNotificationCreate,
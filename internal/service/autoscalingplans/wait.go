@@ -26,7 +26,7 @@ func waitScalingPlanCreated(ctx context.Context, conn *autoscalingplans.AutoScal
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeCreationFailed {
@@ -48,7 +48,7 @@ func waitScalingPlanDeleted(ctx context.Context, conn *autoscalingplans.AutoScal
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeDeletionFailed {
@@ -70,7 +70,7 @@ func waitScalingPlanUpdated(ctx context.Context, conn *autoscalingplans.AutoScal
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeUpdateFailed {
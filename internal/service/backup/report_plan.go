@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_backup_report_plan")
 func ResourceReportPlan() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReportPlanCreate,
@@ -401,7 +402,7 @@ func waitReportPlanCreated(ctx context.Context, conn *backup.Backup, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.ReportPlan); ok {
 		return output, err
@@ -418,7 +419,7 @@ func waitReportPlanDeleted(ctx context.Context, conn *backup.Backup, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.ReportPlan); ok {
 		return output, err
@@ -435,7 +436,7 @@ func waitReportPlanUpdated(ctx context.Context, conn *backup.Backup, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.ReportPlan); ok {
 		return output, err
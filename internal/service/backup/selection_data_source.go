@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKDataSource("aws_backup_selection")
 func DataSourceSelection() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSelectionRead,
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_batch_job_queue")
 func ResourceJobQueue() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceJobQueueCreate,
@@ -106,7 +108,7 @@ func resourceJobQueueCreate(ctx context.Context, d *schema.ResourceData, meta in
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "Error waiting for JobQueue state to be \"VALID\": %s", err)
 	}
@@ -210,7 +212,7 @@ func resourceJobQueueUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = tfresource.WaitForStateContext(ctx, stateConf)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Batch Job Queue (%s): waiting for completion: %s", d.Get("name").(string), err)
 		}
@@ -274,7 +276,7 @@ func DeleteJobQueue(ctx context.Context, jobQueue string, conn *batch.Batch) err
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateChangeConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateChangeConf)
 	return err
 }
 
@@ -295,7 +297,7 @@ func DisableJobQueue(ctx context.Context, jobQueue string, conn *batch.Batch) er
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err = stateChangeConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateChangeConf)
 	return err
 }
 
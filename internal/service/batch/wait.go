@@ -19,7 +19,7 @@ func waitComputeEnvironmentCreated(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -40,7 +40,7 @@ func waitComputeEnvironmentDeleted(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -61,7 +61,7 @@ func waitComputeEnvironmentDisabled(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -82,7 +82,7 @@ func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		return v, err
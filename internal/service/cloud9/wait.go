@@ -24,7 +24,7 @@ func waitEnvironmentReady(ctx context.Context, conn *cloud9.Cloud9, id string) (
 		Timeout: EnvironmentReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloud9.Environment); ok {
 		if lifecycle := output.Lifecycle; aws.StringValue(lifecycle.Status) == cloud9.EnvironmentLifecycleStatusCreateFailed {
@@ -45,7 +45,7 @@ func waitEnvironmentDeleted(ctx context.Context, conn *cloud9.Cloud9, id string)
 		Timeout: EnvironmentDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloud9.Environment); ok {
 		if lifecycle := output.Lifecycle; aws.StringValue(lifecycle.Status) == cloud9.EnvironmentLifecycleStatusDeleteFailed {
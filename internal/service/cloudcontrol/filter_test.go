@@ -0,0 +1,147 @@
+package cloudcontrol
+
+import (
+	"testing"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+func TestFilterMatches(t *testing.T) {
+	t.Parallel()
+
+	properties := `{"LogGroupName":"example","RetentionInDays":7,"Tags":[{"Key":"Environment","Value":"production"}],"KmsKeyId":""}`
+
+	testCases := []struct {
+		TestName   string
+		Filter     string
+		Properties string
+		Expected   bool
+		ExpectErr  bool
+	}{
+		{
+			TestName:   "equal",
+			Filter:     "LogGroupName == 'example'",
+			Properties: properties,
+			Expected:   true,
+		},
+		{
+			TestName:   "not equal",
+			Filter:     "LogGroupName == 'other'",
+			Properties: properties,
+			Expected:   false,
+		},
+		{
+			TestName:   "number comparison",
+			Filter:     "RetentionInDays > `5`",
+			Properties: properties,
+			Expected:   true,
+		},
+		{
+			TestName:   "projection match",
+			Filter:     "Tags[?Key == 'Environment' && Value == 'production']",
+			Properties: properties,
+			Expected:   true,
+		},
+		{
+			TestName:   "projection no match",
+			Filter:     "Tags[?Key == 'Owner']",
+			Properties: properties,
+			Expected:   false,
+		},
+		{
+			TestName:   "missing property",
+			Filter:     "Missing",
+			Properties: properties,
+			Expected:   false,
+		},
+		{
+			TestName:   "empty string",
+			Filter:     "KmsKeyId",
+			Properties: properties,
+			Expected:   false,
+		},
+		{
+			TestName:   "number",
+			Filter:     "RetentionInDays",
+			Properties: properties,
+			Expected:   true,
+		},
+		{
+			TestName:   "invalid properties",
+			Filter:     "LogGroupName",
+			Properties: "{",
+			ExpectErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			filter := jmespath.MustCompile(testCase.Filter)
+			got, err := filterMatches(filter, testCase.Properties)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestValidJMESPath(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"LogGroupName", "Tags[?Key == 'Name'].Value | [0]"} {
+		if _, errors := validJMESPath(v, "filter"); len(errors) != 0 {
+			t.Errorf("%q should be a valid JMESPath expression: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"Tags[?", "=="} {
+		if _, errors := validJMESPath(v, "filter"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid JMESPath expression", v)
+		}
+	}
+}
+
+func TestResourcesDataSourceID(t *testing.T) {
+	t.Parallel()
+
+	const typeName = "AWS::Logs::LogGroup"
+
+	if got, expected := resourcesDataSourceID(typeName, "", ""), typeName; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	ids := map[string]bool{}
+	for _, v := range [][2]string{
+		{"", ""},
+		{"RetentionInDays > `30`", ""},
+		{"RetentionInDays > `60`", ""},
+		{"", `{"ClusterName":"example"}`},
+		{"RetentionInDays > `30`", `{"ClusterName":"example"}`},
+	} {
+		id := resourcesDataSourceID(typeName, v[0], v[1])
+
+		if ids[id] {
+			t.Errorf("duplicate ID %s for filter %q and resource model %q", id, v[0], v[1])
+		}
+		ids[id] = true
+
+		if got, expected := resourcesDataSourceID(typeName, v[0], v[1]), id; got != expected {
+			t.Errorf("got %s, expected %s", got, expected)
+		}
+	}
+}
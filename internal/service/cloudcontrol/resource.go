@@ -25,6 +25,7 @@ import (
 	"github.com/mattbaird/jsonpatch"
 )
 
+// @SDKResource("aws_cloudcontrolapi_resource")
 func ResourceResource() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceCreate,
@@ -401,7 +402,7 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ProgressEvent); ok {
 		if output.OperationStatus == types.OperationStatusFailed {
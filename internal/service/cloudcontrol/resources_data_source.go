@@ -0,0 +1,217 @@
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/jmespath/go-jmespath"
+)
+
+// @SDKDataSource("aws_cloudcontrolapi_resources")
+func DataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validJMESPath,
+			},
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudControlClient()
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudcontrol.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("resource_model"); ok {
+		input.ResourceModel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_version_id"); ok {
+		input.TypeVersionId = aws.String(v.(string))
+	}
+
+	resourceDescriptions, err := findResources(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Cloud Control API (%s) Resources: %s", typeName, err)
+	}
+
+	var filter *jmespath.JMESPath
+
+	if v, ok := d.GetOk("filter"); ok {
+		filter, err = jmespath.Compile(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "compiling filter (%s): %s", v.(string), err)
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, v := range resourceDescriptions {
+		properties, err := structure.NormalizeJsonString(aws.ToString(v.Properties))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing Cloud Control API (%s) Resource (%s) properties: %s", typeName, aws.ToString(v.Identifier), err)
+		}
+
+		if filter != nil {
+			match, err := filterMatches(filter, properties)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "filtering Cloud Control API (%s) Resource (%s): %s", typeName, aws.ToString(v.Identifier), err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"identifier": aws.ToString(v.Identifier),
+			"properties": properties,
+		})
+	}
+
+	d.SetId(resourcesDataSourceID(typeName, d.Get("filter").(string), d.Get("resource_model").(string)))
+
+	if err := d.Set("resources", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+	}
+
+	return diags
+}
+
+// resourcesDataSourceID returns the data source's ID, the type name followed by a hash of any filter and resource model,
+// so that data sources listing the same type with different selections have different IDs.
+func resourcesDataSourceID(typeName, filter, resourceModel string) string {
+	if filter == "" && resourceModel == "" {
+		return typeName
+	}
+
+	return typeName + "," + strconv.Itoa(create.StringHashcode(filter+"\n"+resourceModel))
+}
+
+func findResources(ctx context.Context, conn *cloudcontrol.Client, input *cloudcontrol.ListResourcesInput) ([]types.ResourceDescription, error) {
+	var output []types.ResourceDescription
+
+	pages := cloudcontrol.NewListResourcesPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceDescriptions...)
+	}
+
+	return output, nil
+}
+
+// filterMatches returns whether the JMESPath expression evaluated against the JSON properties is truthy.
+// As in JMESPath, false, null and empty strings, arrays and objects are falsy.
+func filterMatches(filter *jmespath.JMESPath, properties string) (bool, error) {
+	var data interface{}
+
+	if err := json.Unmarshal([]byte(properties), &data); err != nil {
+		return false, fmt.Errorf("parsing properties: %w", err)
+	}
+
+	result, err := filter.Search(data)
+
+	if err != nil {
+		return false, err
+	}
+
+	switch v := result.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case string:
+		return v != "", nil
+	case []interface{}:
+		return len(v) > 0, nil
+	case map[string]interface{}:
+		return len(v) > 0, nil
+	default:
+		return true, nil
+	}
+}
+
+func validJMESPath(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := jmespath.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JMESPath expression: %w", k, err))
+	}
+
+	return
+}
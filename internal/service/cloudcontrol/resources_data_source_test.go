@@ -0,0 +1,52 @@
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudControlResourcesDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.identifier", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.properties"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_filter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+  filter    = "LogGroupName == '${aws_cloudcontrolapi_resource.test.id}'"
+}
+`, rName)
+}
@@ -25,7 +25,7 @@ func WaitChangeSetCreated(ctx context.Context, conn *cloudformation.CloudFormati
 		Refresh: StatusChangeSet(ctx, conn, stackID, changeSetName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeChangeSetOutput); ok {
 		if status := aws.StringValue(output.Status); status == cloudformation.ChangeSetStatusFailed {
@@ -65,7 +65,7 @@ func WaitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 		Delay:   stackSetOperationDelay,
 	}
 
-	outputRaw, waitErr := stateConf.WaitForStateContext(ctx)
+	outputRaw, waitErr := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.StackSetOperation); ok {
 		if status := aws.StringValue(output.Status); status == cloudformation.StackSetOperationStatusFailed {
@@ -137,7 +137,7 @@ func WaitStackCreated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    StatusStack(ctx, conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +198,7 @@ func WaitStackUpdated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    StatusStack(ctx, conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +237,7 @@ func WaitStackDeleted(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    StatusStack(ctx, conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +272,7 @@ func WaitTypeRegistrationProgressStatusComplete(ctx context.Context, conn *cloud
 		Timeout: TypeRegistrationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeTypeRegistrationOutput); ok {
 		return output, err
@@ -11,72 +11,132 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_cloudfront_cache_policy",
+			Factory:  DataSourceCachePolicy,
+		},
+		{
+			TypeName: "aws_cloudfront_distribution",
+			Factory:  DataSourceDistribution,
+		},
+		{
+			TypeName: "aws_cloudfront_function",
+			Factory:  DataSourceFunction,
+		},
+		{
+			TypeName: "aws_cloudfront_log_delivery_canonical_user_id",
+			Factory:  DataSourceLogDeliveryCanonicalUserID,
+		},
+		{
+			TypeName: "aws_cloudfront_origin_access_identities",
+			Factory:  DataSourceOriginAccessIdentities,
+		},
+		{
+			TypeName: "aws_cloudfront_origin_access_identity",
+			Factory:  DataSourceOriginAccessIdentity,
+		},
+		{
+			TypeName: "aws_cloudfront_origin_request_policy",
+			Factory:  DataSourceOriginRequestPolicy,
+		},
+		{
+			TypeName: "aws_cloudfront_realtime_log_config",
+			Factory:  DataSourceRealtimeLogConfig,
+		},
+		{
+			TypeName: "aws_cloudfront_response_headers_policy",
+			Factory:  DataSourceResponseHeadersPolicy,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "cloudfront"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_cloudfront_cache_policy",
+			Factory:  ResourceCachePolicy,
+		},
+		{
+			TypeName: "aws_cloudfront_distribution",
+			Factory:  ResourceDistribution,
+		},
+		{
+			TypeName: "aws_cloudfront_field_level_encryption_config",
+			Factory:  ResourceFieldLevelEncryptionConfig,
+		},
+		{
+			TypeName: "aws_cloudfront_field_level_encryption_profile",
+			Factory:  ResourceFieldLevelEncryptionProfile,
+		},
+		{
+			TypeName: "aws_cloudfront_function",
+			Factory:  ResourceFunction,
+		},
+		{
+			TypeName: "aws_cloudfront_key_group",
+			Factory:  ResourceKeyGroup,
+		},
+		{
+			TypeName: "aws_cloudfront_monitoring_subscription",
+			Factory:  ResourceMonitoringSubscription,
+		},
+		{
+			TypeName: "aws_cloudfront_origin_access_control",
+			Factory:  ResourceOriginAccessControl,
+		},
+		{
+			TypeName: "aws_cloudfront_origin_access_identity",
+			Factory:  ResourceOriginAccessIdentity,
+		},
+		{
+			TypeName: "aws_cloudfront_origin_request_policy",
+			Factory:  ResourceOriginRequestPolicy,
+		},
+		{
+			TypeName: "aws_cloudfront_public_key",
+			Factory:  ResourcePublicKey,
+		},
+		{
+			TypeName: "aws_cloudfront_realtime_log_config",
+			Factory:  ResourceRealtimeLogConfig,
+		},
+		{
+			TypeName: "aws_cloudfront_response_headers_policy",
+			Factory:  ResourceResponseHeadersPolicy,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "cloudfront"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_cloudhsm_v2_hsm")
 func ResourceHSM() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHSMCreate,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitClusterActive(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
@@ -21,7 +22,7 @@ func waitClusterActive(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id stri
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
 		return v, err
@@ -40,7 +41,7 @@ func waitClusterDeleted(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id str
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
 		return v, err
@@ -62,7 +63,7 @@ func waitClusterUninitialized(ctx context.Context, conn *cloudhsmv2.CloudHSMV2,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
 		return v, err
@@ -81,7 +82,7 @@ func waitHSMActive(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Hsm); ok {
 		return v, err
@@ -100,7 +101,7 @@ func waitHSMDeleted(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Hsm); ok {
 		return v, err
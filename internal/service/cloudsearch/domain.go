@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_cloudsearch_domain")
 func ResourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
@@ -714,7 +715,7 @@ func waitDomainActive(ctx context.Context, conn *cloudsearch.CloudSearch, name s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudsearch.DomainStatus); ok {
 		return output, err
@@ -731,7 +732,7 @@ func waitDomainDeleted(ctx context.Context, conn *cloudsearch.CloudSearch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudsearch.DomainStatus); ok {
 		return output, err
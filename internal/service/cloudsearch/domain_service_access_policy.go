@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_cloudsearch_domain_service_access_policy")
 func ResourceDomainServiceAccessPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainServiceAccessPolicyPut,
@@ -212,7 +213,7 @@ func waitAccessPolicyActive(ctx context.Context, conn *cloudsearch.CloudSearch,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudsearch.AccessPoliciesStatus); ok {
 		return output, err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,7 +23,7 @@ func waitReportGroupDeleted(ctx context.Context, conn *codebuild.CodeBuild, arn
 		Timeout: reportGroupDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*codebuild.ReportGroup); ok {
 		return output, err
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_codestarconnections_host")
 func ResourceHost() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostCreate,
@@ -262,7 +263,7 @@ func waitHostPendingOrAvailable(ctx context.Context, conn *codestarconnections.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*codestarconnections.Host); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
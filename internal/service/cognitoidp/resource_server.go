@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_cognito_resource_server")
 func ResourceResourceServer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceServerCreate,
@@ -11,72 +11,100 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_cognito_user_pool_client",
+			Factory:  DataSourceUserPoolClient,
+		},
+		{
+			TypeName: "aws_cognito_user_pool_clients",
+			Factory:  DataSourceUserPoolClients,
+		},
+		{
+			TypeName: "aws_cognito_user_pool_signing_certificate",
+			Factory:  DataSourceUserPoolSigningCertificate,
+		},
+		{
+			TypeName: "aws_cognito_user_pools",
+			Factory:  DataSourceUserPools,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "cognitoidp"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_cognito_identity_provider",
+			Factory:  ResourceIdentityProvider,
+		},
+		{
+			TypeName: "aws_cognito_resource_server",
+			Factory:  ResourceResourceServer,
+		},
+		{
+			TypeName: "aws_cognito_risk_configuration",
+			Factory:  ResourceRiskConfiguration,
+		},
+		{
+			TypeName: "aws_cognito_user",
+			Factory:  ResourceUser,
+		},
+		{
+			TypeName: "aws_cognito_user_group",
+			Factory:  ResourceUserGroup,
+		},
+		{
+			TypeName: "aws_cognito_user_in_group",
+			Factory:  ResourceUserInGroup,
+		},
+		{
+			TypeName: "aws_cognito_user_pool",
+			Factory:  ResourceUserPool,
+		},
+		{
+			TypeName: "aws_cognito_user_pool_client",
+			Factory:  ResourceUserPoolClient,
+		},
+		{
+			TypeName: "aws_cognito_user_pool_domain",
+			Factory:  ResourceUserPoolDomain,
+		},
+		{
+			TypeName: "aws_cognito_user_pool_ui_customization",
+			Factory:  ResourceUserPoolUICustomization,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "cognitoidp"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
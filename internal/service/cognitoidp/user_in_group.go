@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_cognito_user_in_group")
 func ResourceUserInGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserInGroupCreate,
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_cognito_user_pool_domain")
 func ResourceUserPoolDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPoolDomainCreate,
@@ -37,6 +37,7 @@ const (
 	documentClassifierTagKey = "tf-aws_comprehend_document_classifier"
 )
 
+// @SDKResource("aws_comprehend_document_classifier")
 func ResourceDocumentClassifier() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDocumentClassifierCreate,
@@ -670,7 +671,7 @@ func waitDocumentClassifierCreated(ctx context.Context, conn *comprehend.Client,
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		if output.Status == types.ModelStatusInError {
 			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
@@ -691,7 +692,7 @@ func waitDocumentClassifierStopped(ctx context.Context, conn *comprehend.Client,
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		return out, err
 	}
@@ -710,7 +711,7 @@ func waitDocumentClassifierDeleted(ctx context.Context, conn *comprehend.Client,
 		Timeout:        timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		return out, err
 	}
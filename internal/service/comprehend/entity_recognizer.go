@@ -35,6 +35,7 @@ const (
 	entityRecognizerTagKey = "tf-aws_comprehend_entity_recognizer"
 )
 
+// @SDKResource("aws_comprehend_entity_recognizer")
 func ResourceEntityRecognizer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEntityRecognizerCreate,
@@ -698,7 +699,7 @@ func waitEntityRecognizerCreated(ctx context.Context, conn *comprehend.Client, i
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*types.EntityRecognizerProperties); ok {
 		if output.Status == types.ModelStatusInError {
 			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
@@ -719,7 +720,7 @@ func waitEntityRecognizerStopped(ctx context.Context, conn *comprehend.Client, i
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.EntityRecognizerProperties); ok {
 		return out, err
 	}
@@ -738,7 +739,7 @@ func waitEntityRecognizerDeleted(ctx context.Context, conn *comprehend.Client, i
 		Timeout:        timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.EntityRecognizerProperties); ok {
 		return out, err
 	}
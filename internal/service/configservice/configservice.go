@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -387,7 +388,7 @@ func waitForConformancePackStateCreateComplete(ctx context.Context, conn *config
 		Refresh: refreshConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
@@ -404,7 +405,7 @@ func waitForConformancePackStateDeleteComplete(ctx context.Context, conn *config
 		Refresh: refreshConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
@@ -423,7 +424,7 @@ func waitForOrganizationConformancePackStatusCreateSuccessful(ctx context.Contex
 		Delay: 30 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	return err
 }
@@ -436,7 +437,7 @@ func waitForOrganizationConformancePackStatusUpdateSuccessful(ctx context.Contex
 		Refresh: refreshOrganizationConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	return err
 }
@@ -449,7 +450,7 @@ func waitForOrganizationConformancePackStatusDeleteSuccessful(ctx context.Contex
 		Refresh: refreshOrganizationConformancePackStatus(ctx, conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &stateChangeConf)
 
 	return err
 }
@@ -463,7 +464,7 @@ func waitForOrganizationRuleStatusCreateSuccessful(ctx context.Context, conn *co
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	return err
 }
@@ -477,7 +478,7 @@ func waitForOrganizationRuleStatusDeleteSuccessful(ctx context.Context, conn *co
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException) {
 		return nil
@@ -495,7 +496,7 @@ func waitForOrganizationRuleStatusUpdateSuccessful(ctx context.Context, conn *co
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	return err
 }
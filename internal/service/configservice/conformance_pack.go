@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_config_conformance_pack")
 func ResourceConformancePack() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConformancePackPut,
@@ -11,72 +11,87 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "configservice"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_config_aggregate_authorization",
+			Factory:  ResourceAggregateAuthorization,
+		},
+		{
+			TypeName: "aws_config_config_rule",
+			Factory:  ResourceConfigRule,
+		},
+		{
+			TypeName: "aws_config_configuration_aggregator",
+			Factory:  ResourceConfigurationAggregator,
+		},
+		{
+			TypeName: "aws_config_configuration_recorder",
+			Factory:  ResourceConfigurationRecorder,
+		},
+		{
+			TypeName: "aws_config_configuration_recorder_status",
+			Factory:  ResourceConfigurationRecorderStatus,
+		},
+		{
+			TypeName: "aws_config_conformance_pack",
+			Factory:  ResourceConformancePack,
+		},
+		{
+			TypeName: "aws_config_delivery_channel",
+			Factory:  ResourceDeliveryChannel,
+		},
+		{
+			TypeName: "aws_config_organization_conformance_pack",
+			Factory:  ResourceOrganizationConformancePack,
+		},
+		{
+			TypeName: "aws_config_organization_custom_rule",
+			Factory:  ResourceOrganizationCustomRule,
+		},
+		{
+			TypeName: "aws_config_organization_managed_rule",
+			Factory:  ResourceOrganizationManagedRule,
+		},
+		{
+			TypeName: "aws_config_remediation_configuration",
+			Factory:  ResourceRemediationConfiguration,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "configservice"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
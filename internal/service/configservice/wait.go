@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -25,7 +26,7 @@ func waitRuleDeleted(ctx context.Context, conn *configservice.ConfigService, nam
 		Timeout: ruleDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*configservice.ConfigRule); ok {
 		return v, err
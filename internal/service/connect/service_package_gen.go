@@ -11,72 +11,164 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_connect_bot_association",
+			Factory:  DataSourceBotAssociation,
+		},
+		{
+			TypeName: "aws_connect_contact_flow",
+			Factory:  DataSourceContactFlow,
+		},
+		{
+			TypeName: "aws_connect_contact_flow_module",
+			Factory:  DataSourceContactFlowModule,
+		},
+		{
+			TypeName: "aws_connect_hours_of_operation",
+			Factory:  DataSourceHoursOfOperation,
+		},
+		{
+			TypeName: "aws_connect_instance",
+			Factory:  DataSourceInstance,
+		},
+		{
+			TypeName: "aws_connect_instance_storage_config",
+			Factory:  DataSourceInstanceStorageConfig,
+		},
+		{
+			TypeName: "aws_connect_lambda_function_association",
+			Factory:  DataSourceLambdaFunctionAssociation,
+		},
+		{
+			TypeName: "aws_connect_prompt",
+			Factory:  DataSourcePrompt,
+		},
+		{
+			TypeName: "aws_connect_queue",
+			Factory:  DataSourceQueue,
+		},
+		{
+			TypeName: "aws_connect_quick_connect",
+			Factory:  DataSourceQuickConnect,
+		},
+		{
+			TypeName: "aws_connect_routing_profile",
+			Factory:  DataSourceRoutingProfile,
+		},
+		{
+			TypeName: "aws_connect_security_profile",
+			Factory:  DataSourceSecurityProfile,
+		},
+		{
+			TypeName: "aws_connect_user_hierarchy_group",
+			Factory:  DataSourceUserHierarchyGroup,
+		},
+		{
+			TypeName: "aws_connect_user_hierarchy_structure",
+			Factory:  DataSourceUserHierarchyStructure,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "connect"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_connect_bot_association",
+			Factory:  ResourceBotAssociation,
+		},
+		{
+			TypeName: "aws_connect_contact_flow",
+			Factory:  ResourceContactFlow,
+		},
+		{
+			TypeName: "aws_connect_contact_flow_module",
+			Factory:  ResourceContactFlowModule,
+		},
+		{
+			TypeName: "aws_connect_hours_of_operation",
+			Factory:  ResourceHoursOfOperation,
+		},
+		{
+			TypeName: "aws_connect_instance",
+			Factory:  ResourceInstance,
+		},
+		{
+			TypeName: "aws_connect_instance_storage_config",
+			Factory:  ResourceInstanceStorageConfig,
+		},
+		{
+			TypeName: "aws_connect_lambda_function_association",
+			Factory:  ResourceLambdaFunctionAssociation,
+		},
+		{
+			TypeName: "aws_connect_phone_number",
+			Factory:  ResourcePhoneNumber,
+		},
+		{
+			TypeName: "aws_connect_queue",
+			Factory:  ResourceQueue,
+		},
+		{
+			TypeName: "aws_connect_quick_connect",
+			Factory:  ResourceQuickConnect,
+		},
+		{
+			TypeName: "aws_connect_routing_profile",
+			Factory:  ResourceRoutingProfile,
+		},
+		{
+			TypeName: "aws_connect_security_profile",
+			Factory:  ResourceSecurityProfile,
+		},
+		{
+			TypeName: "aws_connect_user",
+			Factory:  ResourceUser,
+		},
+		{
+			TypeName: "aws_connect_user_hierarchy_group",
+			Factory:  ResourceUserHierarchyGroup,
+		},
+		{
+			TypeName: "aws_connect_user_hierarchy_structure",
+			Factory:  ResourceUserHierarchyStructure,
+		},
+		{
+			TypeName: "aws_connect_vocabulary",
+			Factory:  ResourceVocabulary,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "connect"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_controltower_control")
 func ResourceControl() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceControlCreate,
@@ -242,7 +243,7 @@ func waitOperationSucceeded(ctx context.Context, conn *controltower.ControlTower
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*controltower.ControlOperation); ok {
 		if status := aws.StringValue(output.Status); status == controltower.ControlOperationStatusFailed {
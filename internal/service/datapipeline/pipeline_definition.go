@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_datapipeline_pipeline_definition")
 func ResourcePipelineDefinition() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePipelineDefinitionPut,
@@ -11,72 +11,87 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "datasync"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_datasync_agent",
+			Factory:  ResourceAgent,
+		},
+		{
+			TypeName: "aws_datasync_location_efs",
+			Factory:  ResourceLocationEFS,
+		},
+		{
+			TypeName: "aws_datasync_location_fsx_lustre_file_system",
+			Factory:  ResourceLocationFSxLustreFileSystem,
+		},
+		{
+			TypeName: "aws_datasync_location_fsx_openzfs_file_system",
+			Factory:  ResourceLocationFSxOpenZFSFileSystem,
+		},
+		{
+			TypeName: "aws_datasync_location_fsx_windows_file_system",
+			Factory:  ResourceLocationFSxWindowsFileSystem,
+		},
+		{
+			TypeName: "aws_datasync_location_hdfs",
+			Factory:  ResourceLocationHDFS,
+		},
+		{
+			TypeName: "aws_datasync_location_nfs",
+			Factory:  ResourceLocationNFS,
+		},
+		{
+			TypeName: "aws_datasync_location_object_storage",
+			Factory:  ResourceLocationObjectStorage,
+		},
+		{
+			TypeName: "aws_datasync_location_s3",
+			Factory:  ResourceLocationS3,
+		},
+		{
+			TypeName: "aws_datasync_location_smb",
+			Factory:  ResourceLocationSMB,
+		},
+		{
+			TypeName: "aws_datasync_task",
+			Factory:  ResourceTask,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "datasync"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
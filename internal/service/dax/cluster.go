@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_dax_cluster")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -291,7 +292,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Waiting for state to become available: %v", d.Id())
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "Error waiting for DAX cluster (%s) to be created: %s", d.Id(), sterr)
 	}
@@ -483,7 +484,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Delay:      30 * time.Second,
 		}
 
-		_, sterr := stateConf.WaitForStateContext(ctx)
+		_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 		if sterr != nil {
 			return sdkdiag.AppendErrorf(diags, "Error waiting for DAX (%s) to update: %s", d.Id(), sterr)
 		}
@@ -557,7 +558,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Delay:      30 * time.Second,
 	}
 
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "Error waiting for DAX (%s) to delete: %s", d.Id(), sterr)
 	}
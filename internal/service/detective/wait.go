@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -24,7 +25,7 @@ func MemberStatusUpdated(ctx context.Context, conn *detective.Detective, graphAR
 		Timeout: MemberStatusPropagationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*detective.MemberDetail); ok {
 		return output, err
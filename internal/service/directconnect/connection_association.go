@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_dx_connection_association")
 func ResourceConnectionAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConnectionAssociationCreate,
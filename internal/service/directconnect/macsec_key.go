@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_dx_macsec_key_association")
 func ResourceMacSecKeyAssociation() *schema.Resource {
 	return &schema.Resource{
 		// MacSecKey resource only supports create (Associate), read (Describe) and delete (Disassociate)
@@ -11,72 +11,140 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_dx_connection",
+			Factory:  DataSourceConnection,
+		},
+		{
+			TypeName: "aws_dx_gateway",
+			Factory:  DataSourceGateway,
+		},
+		{
+			TypeName: "aws_dx_location",
+			Factory:  DataSourceLocation,
+		},
+		{
+			TypeName: "aws_dx_locations",
+			Factory:  DataSourceLocations,
+		},
+		{
+			TypeName: "aws_dx_router_configuration",
+			Factory:  DataSourceRouterConfiguration,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "directconnect"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_dx_bgp_peer",
+			Factory:  ResourceBGPPeer,
+		},
+		{
+			TypeName: "aws_dx_connection",
+			Factory:  ResourceConnection,
+		},
+		{
+			TypeName: "aws_dx_connection_association",
+			Factory:  ResourceConnectionAssociation,
+		},
+		{
+			TypeName: "aws_dx_connection_confirmation",
+			Factory:  ResourceConnectionConfirmation,
+		},
+		{
+			TypeName: "aws_dx_gateway",
+			Factory:  ResourceGateway,
+		},
+		{
+			TypeName: "aws_dx_gateway_association",
+			Factory:  ResourceGatewayAssociation,
+		},
+		{
+			TypeName: "aws_dx_gateway_association_proposal",
+			Factory:  ResourceGatewayAssociationProposal,
+		},
+		{
+			TypeName: "aws_dx_hosted_connection",
+			Factory:  ResourceHostedConnection,
+		},
+		{
+			TypeName: "aws_dx_hosted_private_virtual_interface",
+			Factory:  ResourceHostedPrivateVirtualInterface,
+		},
+		{
+			TypeName: "aws_dx_hosted_private_virtual_interface_accepter",
+			Factory:  ResourceHostedPrivateVirtualInterfaceAccepter,
+		},
+		{
+			TypeName: "aws_dx_hosted_public_virtual_interface",
+			Factory:  ResourceHostedPublicVirtualInterface,
+		},
+		{
+			TypeName: "aws_dx_hosted_public_virtual_interface_accepter",
+			Factory:  ResourceHostedPublicVirtualInterfaceAccepter,
+		},
+		{
+			TypeName: "aws_dx_hosted_transit_virtual_interface",
+			Factory:  ResourceHostedTransitVirtualInterface,
+		},
+		{
+			TypeName: "aws_dx_hosted_transit_virtual_interface_accepter",
+			Factory:  ResourceHostedTransitVirtualInterfaceAccepter,
+		},
+		{
+			TypeName: "aws_dx_lag",
+			Factory:  ResourceLag,
+		},
+		{
+			TypeName: "aws_dx_macsec_key_association",
+			Factory:  ResourceMacSecKeyAssociation,
+		},
+		{
+			TypeName: "aws_dx_private_virtual_interface",
+			Factory:  ResourcePrivateVirtualInterface,
+		},
+		{
+			TypeName: "aws_dx_public_virtual_interface",
+			Factory:  ResourcePublicVirtualInterface,
+		},
+		{
+			TypeName: "aws_dx_transit_virtual_interface",
+			Factory:  ResourceTransitVirtualInterface,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "directconnect"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func virtualInterfaceRead(ctx context.Context, id string, conn *directconnect.DirectConnect) (*directconnect.VirtualInterface, error) {
@@ -100,7 +101,7 @@ func virtualInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta in
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	_, err = deleteStateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, deleteStateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect virtual interface (%s) to be deleted: %s", d.Id(), err)
 	}
@@ -141,7 +142,7 @@ func virtualInterfaceWaitUntilAvailable(ctx context.Context, conn *directconnect
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 		return fmt.Errorf("waiting for Direct Connect virtual interface (%s) to become available: %s", vifId, err)
 	}
 
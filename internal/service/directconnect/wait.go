@@ -27,7 +27,7 @@ func waitConnectionConfirmed(ctx context.Context, conn *directconnect.DirectConn
 		Timeout: connectionConfirmedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -44,7 +44,7 @@ func waitConnectionDeleted(ctx context.Context, conn *directconnect.DirectConnec
 		Timeout: connectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -61,7 +61,7 @@ func waitGatewayCreated(ctx context.Context, conn *directconnect.DirectConnect,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Gateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -80,7 +80,7 @@ func waitGatewayDeleted(ctx context.Context, conn *directconnect.DirectConnect,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Gateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -99,7 +99,7 @@ func waitGatewayAssociationCreated(ctx context.Context, conn *directconnect.Dire
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -118,7 +118,7 @@ func waitGatewayAssociationUpdated(ctx context.Context, conn *directconnect.Dire
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -137,7 +137,7 @@ func waitGatewayAssociationDeleted(ctx context.Context, conn *directconnect.Dire
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -156,7 +156,7 @@ func waitHostedConnectionDeleted(ctx context.Context, conn *directconnect.Direct
 		Timeout: hostedConnectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -173,7 +173,7 @@ func waitLagDeleted(ctx context.Context, conn *directconnect.DirectConnect, id s
 		Timeout: lagDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Lag); ok {
 		return output, err
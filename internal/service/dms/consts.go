@@ -14,6 +14,32 @@ const (
 	replicationTaskStatusStarting  = "starting"
 )
 
+const (
+	replicationStatusCreated              = "created"
+	replicationStatusReady                = "ready"
+	replicationStatusRunning              = "running"
+	replicationStatusStopping             = "stopping"
+	replicationStatusStopped              = "stopped"
+	replicationStatusFailed               = "failed"
+	replicationStatusInitialising         = "initializing"
+	replicationStatusMetadataResources    = "preparing_metadata_resources"
+	replicationStatusTestingConnection    = "testing_connection"
+	replicationStatusFetchingMetadata     = "fetching_metadata"
+	replicationStatusCalculatingCapacity  = "calculating_capacity"
+	replicationStatusProvisioningCapacity = "provisioning_capacity"
+	replicationStatusReplicationStarting  = "replication_starting"
+)
+
+const (
+	replicationProvisionStateDeprovisioning = "deprovisioning"
+	replicationProvisionStateDeprovisioned  = "deprovisioned"
+)
+
+const (
+	replicationTypeValueStartReplication = "start-replication"
+	replicationTypeValueResumeProcessing = "resume-processing"
+)
+
 const (
 	engineNameAurora                     = "aurora"
 	engineNameAuroraPostgresql           = "aurora-postgresql"
@@ -18,9 +18,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_dms_event_subscription")
 func ResourceEventSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEventSubscriptionCreate,
@@ -128,7 +130,7 @@ func resourceEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData
 		Delay:      10 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DMS Event Subscription (%s) creation: %s", d.Id(), err)
 	}
@@ -167,7 +169,7 @@ func resourceEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData
 			Delay:      10 * time.Second,
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = tfresource.WaitForStateContext(ctx, stateConf)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DMS Event Subscription (%s) modification: %s", d.Id(), err)
 		}
@@ -277,7 +279,7 @@ func resourceEventSubscriptionDelete(ctx context.Context, d *schema.ResourceData
 		Delay:      10 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DMS Event Subscription (%s) deletion: %s", d.Id(), err)
 	}
@@ -92,3 +92,101 @@ func FindReplicationTaskByID(ctx context.Context, conn *dms.DatabaseMigrationSer
 
 	return results[0], nil
 }
+
+func FindReplicationConfigByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.ReplicationConfig, error) {
+	input := &dms.DescribeReplicationConfigsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-config-arn"),
+				Values: aws.StringSlice([]string{arn}),
+			},
+		},
+	}
+
+	var results []*dms.ReplicationConfig
+
+	err := conn.DescribeReplicationConfigsPagesWithContext(ctx, input, func(page *dms.DescribeReplicationConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplicationConfigs {
+			if v == nil {
+				continue
+			}
+			results = append(results, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
+
+func FindReplicationByReplicationConfigARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.Replication, error) {
+	input := &dms.DescribeReplicationsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-config-arn"),
+				Values: aws.StringSlice([]string{arn}),
+			},
+		},
+	}
+
+	var results []*dms.Replication
+
+	err := conn.DescribeReplicationsPagesWithContext(ctx, input, func(page *dms.DescribeReplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Replications {
+			if v == nil {
+				continue
+			}
+			results = append(results, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
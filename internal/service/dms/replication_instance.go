@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_dms_replication_instance")
 func ResourceReplicationInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicationInstanceCreate,
@@ -198,7 +200,7 @@ func resourceReplicationInstanceCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Instance (%s) creation: %s", d.Id(), err)
 	}
@@ -374,7 +376,7 @@ func resourceReplicationInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = tfresource.WaitForStateContext(ctx, stateConf)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Instance (%s) modification: %s", d.Id(), err)
 		}
@@ -413,7 +415,7 @@ func resourceReplicationInstanceDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Instance (%s) deletion: %s", d.Id(), err)
 	}
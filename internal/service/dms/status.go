@@ -40,3 +40,56 @@ func statusReplicationTask(ctx context.Context, conn *dms.DatabaseMigrationServi
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusReplicationConfig(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicationConfigByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, "exists", nil
+	}
+}
+
+func statusReplication(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicationByReplicationConfigARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusReplicationProvision(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicationByReplicationConfigARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// No provisioning data means no capacity is provisioned.
+		if output.ProvisionData == nil {
+			return output, replicationProvisionStateDeprovisioned, nil
+		}
+
+		return output, aws.StringValue(output.ProvisionData.ProvisionState), nil
+	}
+}
@@ -16,6 +16,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_dms_replication_config", &resource.Sweeper{
+		Name: "aws_dms_replication_config",
+		F:    sweepReplicationConfigs,
+	})
+
 	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
@@ -76,6 +81,50 @@ func sweepReplicationInstances(region string) error {
 	return errs.ErrorOrNil()
 }
 
+func sweepReplicationConfigs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).DMSConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeReplicationConfigsPagesWithContext(ctx, &dms.DescribeReplicationConfigsInput{}, func(page *dms.DescribeReplicationConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplicationConfigs {
+			r := ResourceReplicationConfig()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ReplicationConfigArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing DMS Replication Configs: %w", err))
+	}
+
+	if err = sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DMS Replication Configs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DMS Replication Config sweep for %s: %s", region, err)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepReplicationTasks(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
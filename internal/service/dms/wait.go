@@ -2,10 +2,14 @@ package dms
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +25,7 @@ func waitEndpointDeleted(ctx context.Context, conn *dms.DatabaseMigrationService
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -37,7 +41,7 @@ func waitReplicationTaskDeleted(ctx context.Context, conn *dms.DatabaseMigration
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -53,7 +57,7 @@ func waitReplicationTaskModified(ctx context.Context, conn *dms.DatabaseMigratio
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -69,7 +73,7 @@ func waitReplicationTaskReady(ctx context.Context, conn *dms.DatabaseMigrationSe
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -85,7 +89,7 @@ func waitReplicationTaskRunning(ctx context.Context, conn *dms.DatabaseMigration
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -101,7 +105,96 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.DatabaseMigration
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
+
+func waitReplicationConfigDeleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationConfig, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"exists"},
+		Target:     []string{},
+		Refresh:    statusReplicationConfig(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*dms.ReplicationConfig); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationRunning(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.Replication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			replicationStatusReady,
+			replicationStatusCreated,
+			replicationStatusInitialising,
+			replicationStatusMetadataResources,
+			replicationStatusTestingConnection,
+			replicationStatusFetchingMetadata,
+			replicationStatusCalculatingCapacity,
+			replicationStatusProvisioningCapacity,
+			replicationStatusReplicationStarting,
+		},
+		Target:     []string{replicationStatusRunning, replicationStatusStopped},
+		Refresh:    statusReplication(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*dms.Replication); ok {
+		if aws.StringValue(output.Status) == replicationStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureMessages), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationStopped(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.Replication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{replicationStatusRunning, replicationStatusStopping},
+		Target:     []string{replicationStatusStopped},
+		Refresh:    statusReplication(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      60 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*dms.Replication); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationDeprovisioned(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.Replication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{replicationProvisionStateDeprovisioning},
+		Target:     []string{replicationProvisionStateDeprovisioned},
+		Refresh:    statusReplicationProvision(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*dms.Replication); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_docdb_cluster_instance")
 func ResourceClusterInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterInstanceCreate,
@@ -246,7 +247,7 @@ func resourceClusterInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DocDB Instance (%s) to become available: %s", d.Id(), err)
 	}
@@ -423,7 +424,7 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = tfresource.WaitForStateContext(ctx, stateConf)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DocDB Instance (%s) update: %s", d.Id(), err)
 		}
@@ -461,7 +462,7 @@ func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DocDB Instance (%s) deletion: %s", d.Id(), err)
 	}
 
@@ -52,7 +52,7 @@ func waitForGlobalClusterCreation(ctx context.Context, conn *docdb.DocDB, global
 	}
 
 	log.Printf("[DEBUG] Waiting for DocDB Global Cluster (%s) availability", globalClusterID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -67,7 +67,7 @@ func waitForGlobalClusterUpdate(ctx context.Context, conn *docdb.DocDB, globalCl
 	}
 
 	log.Printf("[DEBUG] Waiting for DocDB Global Cluster (%s) availability", globalClusterID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -117,7 +117,7 @@ func WaitForDBClusterDeletion(ctx context.Context, conn *docdb.DocDB, dBClusterI
 	}
 
 	log.Printf("[DEBUG] Waiting for DocDB Cluster (%s) deletion", dBClusterID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -136,7 +136,7 @@ func WaitForDBClusterSnapshotDeletion(ctx context.Context, conn *docdb.DocDB, dB
 	}
 
 	log.Printf("[DEBUG] Waiting for DocDB Cluster Snapshot (%s) deletion", dBClusterSnapshotID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -155,7 +155,7 @@ func WaitForDBInstanceDeletion(ctx context.Context, conn *docdb.DocDB, dBInstanc
 	}
 
 	log.Printf("[DEBUG] Waiting for DocDB Instance (%s) deletion", dBInstanceID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -174,7 +174,7 @@ func WaitForGlobalClusterDeletion(ctx context.Context, conn *docdb.DocDB, global
 	}
 
 	log.Printf("[DEBUG] Waiting for DocDB Global Cluster (%s) deletion", globalClusterID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -193,7 +193,7 @@ func WaitForDBSubnetGroupDeletion(ctx context.Context, conn *docdb.DocDB, dBSubn
 	}
 
 	log.Printf("[DEBUG] Waiting for DocDB Subnet Group (%s) deletion", dBSubnetGroupName)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -210,7 +210,7 @@ func waitEventSubscriptionActive(ctx context.Context, conn *docdb.DocDB, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.EventSubscription); ok {
 		return output, err
@@ -227,7 +227,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *docdb.DocDB, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.EventSubscription); ok {
 		return output, err
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_directory_service_radius_settings")
 func ResourceRadiusSettings() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRadiusSettingsCreate,
@@ -19,7 +19,7 @@ func waitDirectoryCreated(ctx context.Context, conn *directoryservice.DirectoryS
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StageReason)))
@@ -38,7 +38,7 @@ func waitDirectoryDeleted(ctx context.Context, conn *directoryservice.DirectoryS
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StageReason)))
@@ -57,7 +57,7 @@ func waitDomainControllerCreated(ctx context.Context, conn *directoryservice.Dir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DomainController); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -76,7 +76,7 @@ func waitDomainControllerDeleted(ctx context.Context, conn *directoryservice.Dir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DomainController); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -95,7 +95,7 @@ func waitRadiusCompleted(ctx context.Context, conn *directoryservice.DirectorySe
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
 		return output, err
@@ -112,7 +112,7 @@ func waitRegionCreated(ctx context.Context, conn *directoryservice.DirectoryServ
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.RegionDescription); ok {
 		return output, err
@@ -129,7 +129,7 @@ func waitRegionDeleted(ctx context.Context, conn *directoryservice.DirectoryServ
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.RegionDescription); ok {
 		return output, err
@@ -155,7 +155,7 @@ func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.Dire
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.SharedDirectory); ok {
 		return output, err
@@ -173,7 +173,7 @@ func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectorySe
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.SharedDirectory); ok {
 		return output, err
@@ -13,9 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_dynamodb_global_table")
 func ResourceGlobalTable() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGlobalTableCreate,
@@ -93,7 +95,7 @@ func resourceGlobalTableCreate(ctx context.Context, d *schema.ResourceData, meta
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Global Table (%s): waiting for completion: %s", globalTableName, err)
 	}
@@ -165,7 +167,7 @@ func resourceGlobalTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 		}
-		_, err := stateConf.WaitForStateContext(ctx)
+		_, err := tfresource.WaitForStateContext(ctx, stateConf)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Global Table (%s): waiting for completion: %s", d.Id(), err)
 		}
@@ -201,7 +203,7 @@ func resourceGlobalTableDelete(ctx context.Context, d *schema.ResourceData, meta
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 10 * time.Second,
 	}
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Global Table (%s): waiting for completion: %s", d.Id(), err)
 }
 
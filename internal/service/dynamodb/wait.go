@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -39,7 +40,7 @@ func waitKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.D
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamArn, tableName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -52,7 +53,7 @@ func waitKinesisStreamingDestinationDisabled(ctx context.Context, conn *dynamodb
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamArn, tableName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -65,7 +66,7 @@ func waitTableActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 		Refresh: statusTable(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -82,7 +83,7 @@ func waitTableDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName st
 		Refresh: statusTable(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -99,7 +100,7 @@ func waitReplicaActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName,
 		Refresh: statusReplicaUpdate(ctx, conn, tableName, region),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -117,7 +118,7 @@ func waitReplicaDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName,
 		Refresh: statusReplicaDelete(ctx, conn, tableName, region),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -130,7 +131,7 @@ func waitGSIActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName, inde
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -147,7 +148,7 @@ func waitGSIDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName, ind
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -176,7 +177,7 @@ func waitPITRUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 		MinTimeout: 15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.PointInTimeRecoveryDescription); ok {
 		return output, err
@@ -201,7 +202,7 @@ func waitTTLUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		Refresh: statusTTL(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TimeToLiveDescription); ok {
 		return output, err
@@ -219,7 +220,7 @@ func waitSSEUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		Refresh: statusTableSES(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -243,7 +244,7 @@ func waitReplicaSSEUpdated(ctx context.Context, client *conns.AWSClient, region
 		Refresh: statusTableSES(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -260,7 +261,7 @@ func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -273,7 +274,7 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
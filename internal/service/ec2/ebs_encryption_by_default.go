@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_ebs_encryption_by_default")
 func ResourceEBSEncryptionByDefault() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEBSEncryptionByDefaultCreate,
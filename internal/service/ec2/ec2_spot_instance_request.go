@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_spot_instance_request")
 func ResourceSpotInstanceRequest() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSpotInstanceRequestCreate,
@@ -245,7 +246,7 @@ func resourceSpotInstanceRequestCreate(ctx context.Context, d *schema.ResourceDa
 		}
 
 		log.Printf("[DEBUG] waiting for spot bid to resolve... this may take several minutes.")
-		_, err = spotStateConf.WaitForStateContext(ctx)
+		_, err = tfresource.WaitForStateContext(ctx, spotStateConf)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "Error while waiting for spot request (%s) to resolve: %s", sir, err)
@@ -11,72 +11,820 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_ami",
+			Factory:  DataSourceAMI,
+		},
+		{
+			TypeName: "aws_ami_ids",
+			Factory:  DataSourceAMIIDs,
+		},
+		{
+			TypeName: "aws_availability_zone",
+			Factory:  DataSourceAvailabilityZone,
+		},
+		{
+			TypeName: "aws_availability_zones",
+			Factory:  DataSourceAvailabilityZones,
+		},
+		{
+			TypeName: "aws_customer_gateway",
+			Factory:  DataSourceCustomerGateway,
+		},
+		{
+			TypeName: "aws_ebs_default_kms_key",
+			Factory:  DataSourceEBSDefaultKMSKey,
+		},
+		{
+			TypeName: "aws_ebs_encryption_by_default",
+			Factory:  DataSourceEBSEncryptionByDefault,
+		},
+		{
+			TypeName: "aws_ebs_snapshot",
+			Factory:  DataSourceEBSSnapshot,
+		},
+		{
+			TypeName: "aws_ebs_snapshot_ids",
+			Factory:  DataSourceEBSSnapshotIDs,
+		},
+		{
+			TypeName: "aws_ebs_volume",
+			Factory:  DataSourceEBSVolume,
+		},
+		{
+			TypeName: "aws_ebs_volumes",
+			Factory:  DataSourceEBSVolumes,
+		},
+		{
+			TypeName: "aws_ec2_client_vpn_endpoint",
+			Factory:  DataSourceClientVPNEndpoint,
+		},
+		{
+			TypeName: "aws_ec2_coip_pool",
+			Factory:  DataSourceCoIPPool,
+		},
+		{
+			TypeName: "aws_ec2_coip_pools",
+			Factory:  DataSourceCoIPPools,
+		},
+		{
+			TypeName: "aws_ec2_host",
+			Factory:  DataSourceHost,
+		},
+		{
+			TypeName: "aws_ec2_instance_type",
+			Factory:  DataSourceInstanceType,
+		},
+		{
+			TypeName: "aws_ec2_instance_type_offering",
+			Factory:  DataSourceInstanceTypeOffering,
+		},
+		{
+			TypeName: "aws_ec2_instance_type_offerings",
+			Factory:  DataSourceInstanceTypeOfferings,
+		},
+		{
+			TypeName: "aws_ec2_instance_types",
+			Factory:  DataSourceInstanceTypes,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway",
+			Factory:  DataSourceLocalGateway,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway_route_table",
+			Factory:  DataSourceLocalGatewayRouteTable,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway_route_tables",
+			Factory:  DataSourceLocalGatewayRouteTables,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway_virtual_interface",
+			Factory:  DataSourceLocalGatewayVirtualInterface,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway_virtual_interface_group",
+			Factory:  DataSourceLocalGatewayVirtualInterfaceGroup,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway_virtual_interface_groups",
+			Factory:  DataSourceLocalGatewayVirtualInterfaceGroups,
+		},
+		{
+			TypeName: "aws_ec2_local_gateways",
+			Factory:  DataSourceLocalGateways,
+		},
+		{
+			TypeName: "aws_ec2_managed_prefix_list",
+			Factory:  DataSourceManagedPrefixList,
+		},
+		{
+			TypeName: "aws_ec2_managed_prefix_lists",
+			Factory:  DataSourceManagedPrefixLists,
+		},
+		{
+			TypeName: "aws_ec2_network_insights_analysis",
+			Factory:  DataSourceNetworkInsightsAnalysis,
+		},
+		{
+			TypeName: "aws_ec2_network_insights_path",
+			Factory:  DataSourceNetworkInsightsPath,
+		},
+		{
+			TypeName: "aws_ec2_serial_console_access",
+			Factory:  DataSourceSerialConsoleAccess,
+		},
+		{
+			TypeName: "aws_ec2_spot_price",
+			Factory:  DataSourceSpotPrice,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway",
+			Factory:  DataSourceTransitGateway,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_attachment",
+			Factory:  DataSourceTransitGatewayAttachment,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_connect",
+			Factory:  DataSourceTransitGatewayConnect,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_connect_peer",
+			Factory:  DataSourceTransitGatewayConnectPeer,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_dx_gateway_attachment",
+			Factory:  DataSourceTransitGatewayDxGatewayAttachment,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_multicast_domain",
+			Factory:  DataSourceTransitGatewayMulticastDomain,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_peering_attachment",
+			Factory:  DataSourceTransitGatewayPeeringAttachment,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_route_table",
+			Factory:  DataSourceTransitGatewayRouteTable,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_route_tables",
+			Factory:  DataSourceTransitGatewayRouteTables,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_vpc_attachment",
+			Factory:  DataSourceTransitGatewayVPCAttachment,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_vpc_attachments",
+			Factory:  DataSourceTransitGatewayVPCAttachments,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_vpn_attachment",
+			Factory:  DataSourceTransitGatewayVPNAttachment,
+		},
+		{
+			TypeName: "aws_eip",
+			Factory:  DataSourceEIP,
+		},
+		{
+			TypeName: "aws_eips",
+			Factory:  DataSourceEIPs,
+		},
+		{
+			TypeName: "aws_instance",
+			Factory:  DataSourceInstance,
+		},
+		{
+			TypeName: "aws_instances",
+			Factory:  DataSourceInstances,
+		},
+		{
+			TypeName: "aws_internet_gateway",
+			Factory:  DataSourceInternetGateway,
+		},
+		{
+			TypeName: "aws_key_pair",
+			Factory:  DataSourceKeyPair,
+		},
+		{
+			TypeName: "aws_launch_template",
+			Factory:  DataSourceLaunchTemplate,
+		},
+		{
+			TypeName: "aws_nat_gateway",
+			Factory:  DataSourceNATGateway,
+		},
+		{
+			TypeName: "aws_nat_gateways",
+			Factory:  DataSourceNATGateways,
+		},
+		{
+			TypeName: "aws_network_acls",
+			Factory:  DataSourceNetworkACLs,
+		},
+		{
+			TypeName: "aws_network_interface",
+			Factory:  DataSourceNetworkInterface,
+		},
+		{
+			TypeName: "aws_network_interfaces",
+			Factory:  DataSourceNetworkInterfaces,
+		},
+		{
+			TypeName: "aws_prefix_list",
+			Factory:  DataSourcePrefixList,
+		},
+		{
+			TypeName: "aws_route",
+			Factory:  DataSourceRoute,
+		},
+		{
+			TypeName: "aws_route_table",
+			Factory:  DataSourceRouteTable,
+		},
+		{
+			TypeName: "aws_route_tables",
+			Factory:  DataSourceRouteTables,
+		},
+		{
+			TypeName: "aws_security_group",
+			Factory:  DataSourceSecurityGroup,
+		},
+		{
+			TypeName: "aws_security_groups",
+			Factory:  DataSourceSecurityGroups,
+		},
+		{
+			TypeName: "aws_subnet",
+			Factory:  DataSourceSubnet,
+		},
+		{
+			TypeName: "aws_subnet_ids",
+			Factory:  DataSourceSubnetIDs,
+		},
+		{
+			TypeName: "aws_subnets",
+			Factory:  DataSourceSubnets,
+		},
+		{
+			TypeName: "aws_vpc",
+			Factory:  DataSourceVPC,
+		},
+		{
+			TypeName: "aws_vpc_dhcp_options",
+			Factory:  DataSourceVPCDHCPOptions,
+		},
+		{
+			TypeName: "aws_vpc_endpoint",
+			Factory:  DataSourceVPCEndpoint,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_service",
+			Factory:  DataSourceVPCEndpointService,
+		},
+		{
+			TypeName: "aws_vpc_ipam_pool",
+			Factory:  DataSourceIPAMPool,
+		},
+		{
+			TypeName: "aws_vpc_ipam_pool_cidrs",
+			Factory:  DataSourceIPAMPoolCIDRs,
+		},
+		{
+			TypeName: "aws_vpc_ipam_pools",
+			Factory:  DataSourceIPAMPools,
+		},
+		{
+			TypeName: "aws_vpc_ipam_preview_next_cidr",
+			Factory:  DataSourceIPAMPreviewNextCIDR,
+		},
+		{
+			TypeName: "aws_vpc_peering_connection",
+			Factory:  DataSourceVPCPeeringConnection,
+		},
+		{
+			TypeName: "aws_vpc_peering_connections",
+			Factory:  DataSourceVPCPeeringConnections,
+		},
+		{
+			TypeName: "aws_vpcs",
+			Factory:  DataSourceVPCs,
+		},
+		{
+			TypeName: "aws_vpn_gateway",
+			Factory:  DataSourceVPNGateway,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "ec2"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_ami",
+			Factory:  ResourceAMI,
+		},
+		{
+			TypeName: "aws_ami_copy",
+			Factory:  ResourceAMICopy,
+		},
+		{
+			TypeName: "aws_ami_from_instance",
+			Factory:  ResourceAMIFromInstance,
+		},
+		{
+			TypeName: "aws_ami_launch_permission",
+			Factory:  ResourceAMILaunchPermission,
+		},
+		{
+			TypeName: "aws_customer_gateway",
+			Factory:  ResourceCustomerGateway,
+		},
+		{
+			TypeName: "aws_default_network_acl",
+			Factory:  ResourceDefaultNetworkACL,
+		},
+		{
+			TypeName: "aws_default_route_table",
+			Factory:  ResourceDefaultRouteTable,
+		},
+		{
+			TypeName: "aws_default_security_group",
+			Factory:  ResourceDefaultSecurityGroup,
+		},
+		{
+			TypeName: "aws_default_subnet",
+			Factory:  ResourceDefaultSubnet,
+		},
+		{
+			TypeName: "aws_default_vpc",
+			Factory:  ResourceDefaultVPC,
+		},
+		{
+			TypeName: "aws_default_vpc_dhcp_options",
+			Factory:  ResourceDefaultVPCDHCPOptions,
+		},
+		{
+			TypeName: "aws_ebs_default_kms_key",
+			Factory:  ResourceEBSDefaultKMSKey,
+		},
+		{
+			TypeName: "aws_ebs_encryption_by_default",
+			Factory:  ResourceEBSEncryptionByDefault,
+		},
+		{
+			TypeName: "aws_ebs_snapshot",
+			Factory:  ResourceEBSSnapshot,
+		},
+		{
+			TypeName: "aws_ebs_snapshot_copy",
+			Factory:  ResourceEBSSnapshotCopy,
+		},
+		{
+			TypeName: "aws_ebs_snapshot_import",
+			Factory:  ResourceEBSSnapshotImport,
+		},
+		{
+			TypeName: "aws_ebs_volume",
+			Factory:  ResourceEBSVolume,
+		},
+		{
+			TypeName: "aws_ec2_availability_zone_group",
+			Factory:  ResourceAvailabilityZoneGroup,
+		},
+		{
+			TypeName: "aws_ec2_capacity_reservation",
+			Factory:  ResourceCapacityReservation,
+		},
+		{
+			TypeName: "aws_ec2_carrier_gateway",
+			Factory:  ResourceCarrierGateway,
+		},
+		{
+			TypeName: "aws_ec2_client_vpn_authorization_rule",
+			Factory:  ResourceClientVPNAuthorizationRule,
+		},
+		{
+			TypeName: "aws_ec2_client_vpn_endpoint",
+			Factory:  ResourceClientVPNEndpoint,
+		},
+		{
+			TypeName: "aws_ec2_client_vpn_network_association",
+			Factory:  ResourceClientVPNNetworkAssociation,
+		},
+		{
+			TypeName: "aws_ec2_client_vpn_route",
+			Factory:  ResourceClientVPNRoute,
+		},
+		{
+			TypeName: "aws_ec2_fleet",
+			Factory:  ResourceFleet,
+		},
+		{
+			TypeName: "aws_ec2_host",
+			Factory:  ResourceHost,
+		},
+		{
+			TypeName: "aws_ec2_instance_state",
+			Factory:  ResourceInstanceState,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway_route",
+			Factory:  ResourceLocalGatewayRoute,
+		},
+		{
+			TypeName: "aws_ec2_local_gateway_route_table_vpc_association",
+			Factory:  ResourceLocalGatewayRouteTableVPCAssociation,
+		},
+		{
+			TypeName: "aws_ec2_managed_prefix_list",
+			Factory:  ResourceManagedPrefixList,
+		},
+		{
+			TypeName: "aws_ec2_managed_prefix_list_entry",
+			Factory:  ResourceManagedPrefixListEntry,
+		},
+		{
+			TypeName: "aws_ec2_network_insights_analysis",
+			Factory:  ResourceNetworkInsightsAnalysis,
+		},
+		{
+			TypeName: "aws_ec2_network_insights_path",
+			Factory:  ResourceNetworkInsightsPath,
+		},
+		{
+			TypeName: "aws_ec2_serial_console_access",
+			Factory:  ResourceSerialConsoleAccess,
+		},
+		{
+			TypeName: "aws_ec2_subnet_cidr_reservation",
+			Factory:  ResourceSubnetCIDRReservation,
+		},
+		{
+			TypeName: "aws_ec2_tag",
+			Factory:  ResourceTag,
+		},
+		{
+			TypeName: "aws_ec2_traffic_mirror_filter",
+			Factory:  ResourceTrafficMirrorFilter,
+		},
+		{
+			TypeName: "aws_ec2_traffic_mirror_filter_rule",
+			Factory:  ResourceTrafficMirrorFilterRule,
+		},
+		{
+			TypeName: "aws_ec2_traffic_mirror_session",
+			Factory:  ResourceTrafficMirrorSession,
+		},
+		{
+			TypeName: "aws_ec2_traffic_mirror_target",
+			Factory:  ResourceTrafficMirrorTarget,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway",
+			Factory:  ResourceTransitGateway,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_connect",
+			Factory:  ResourceTransitGatewayConnect,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_connect_peer",
+			Factory:  ResourceTransitGatewayConnectPeer,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_multicast_domain",
+			Factory:  ResourceTransitGatewayMulticastDomain,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_multicast_domain_association",
+			Factory:  ResourceTransitGatewayMulticastDomainAssociation,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_multicast_group_member",
+			Factory:  ResourceTransitGatewayMulticastGroupMember,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_multicast_group_source",
+			Factory:  ResourceTransitGatewayMulticastGroupSource,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_peering_attachment",
+			Factory:  ResourceTransitGatewayPeeringAttachment,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_peering_attachment_accepter",
+			Factory:  ResourceTransitGatewayPeeringAttachmentAccepter,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_policy_table",
+			Factory:  ResourceTransitGatewayPolicyTable,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_policy_table_association",
+			Factory:  ResourceTransitGatewayPolicyTableAssociation,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_prefix_list_reference",
+			Factory:  ResourceTransitGatewayPrefixListReference,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_route",
+			Factory:  ResourceTransitGatewayRoute,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_route_table",
+			Factory:  ResourceTransitGatewayRouteTable,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_route_table_association",
+			Factory:  ResourceTransitGatewayRouteTableAssociation,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_route_table_propagation",
+			Factory:  ResourceTransitGatewayRouteTablePropagation,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_vpc_attachment",
+			Factory:  ResourceTransitGatewayVPCAttachment,
+		},
+		{
+			TypeName: "aws_ec2_transit_gateway_vpc_attachment_accepter",
+			Factory:  ResourceTransitGatewayVPCAttachmentAccepter,
+		},
+		{
+			TypeName: "aws_egress_only_internet_gateway",
+			Factory:  ResourceEgressOnlyInternetGateway,
+		},
+		{
+			TypeName: "aws_eip",
+			Factory:  ResourceEIP,
+		},
+		{
+			TypeName: "aws_eip_association",
+			Factory:  ResourceEIPAssociation,
+		},
+		{
+			TypeName: "aws_flow_log",
+			Factory:  ResourceFlowLog,
+		},
+		{
+			TypeName: "aws_instance",
+			Factory:  ResourceInstance,
+		},
+		{
+			TypeName: "aws_internet_gateway",
+			Factory:  ResourceInternetGateway,
+		},
+		{
+			TypeName: "aws_internet_gateway_attachment",
+			Factory:  ResourceInternetGatewayAttachment,
+		},
+		{
+			TypeName: "aws_key_pair",
+			Factory:  ResourceKeyPair,
+		},
+		{
+			TypeName: "aws_launch_template",
+			Factory:  ResourceLaunchTemplate,
+		},
+		{
+			TypeName: "aws_main_route_table_association",
+			Factory:  ResourceMainRouteTableAssociation,
+		},
+		{
+			TypeName: "aws_nat_gateway",
+			Factory:  ResourceNATGateway,
+		},
+		{
+			TypeName: "aws_network_acl",
+			Factory:  ResourceNetworkACL,
+		},
+		{
+			TypeName: "aws_network_acl_association",
+			Factory:  ResourceNetworkACLAssociation,
+		},
+		{
+			TypeName: "aws_network_acl_rule",
+			Factory:  ResourceNetworkACLRule,
+		},
+		{
+			TypeName: "aws_network_interface",
+			Factory:  ResourceNetworkInterface,
+		},
+		{
+			TypeName: "aws_network_interface_attachment",
+			Factory:  ResourceNetworkInterfaceAttachment,
+		},
+		{
+			TypeName: "aws_network_interface_sg_attachment",
+			Factory:  ResourceNetworkInterfaceSGAttachment,
+		},
+		{
+			TypeName: "aws_placement_group",
+			Factory:  ResourcePlacementGroup,
+		},
+		{
+			TypeName: "aws_route",
+			Factory:  ResourceRoute,
+		},
+		{
+			TypeName: "aws_route_table",
+			Factory:  ResourceRouteTable,
+		},
+		{
+			TypeName: "aws_route_table_association",
+			Factory:  ResourceRouteTableAssociation,
+		},
+		{
+			TypeName: "aws_security_group",
+			Factory:  ResourceSecurityGroup,
+		},
+		{
+			TypeName: "aws_security_group_rule",
+			Factory:  ResourceSecurityGroupRule,
+		},
+		{
+			TypeName: "aws_snapshot_create_volume_permission",
+			Factory:  ResourceSnapshotCreateVolumePermission,
+		},
+		{
+			TypeName: "aws_spot_datafeed_subscription",
+			Factory:  ResourceSpotDataFeedSubscription,
+		},
+		{
+			TypeName: "aws_spot_fleet_request",
+			Factory:  ResourceSpotFleetRequest,
+		},
+		{
+			TypeName: "aws_spot_instance_request",
+			Factory:  ResourceSpotInstanceRequest,
+		},
+		{
+			TypeName: "aws_subnet",
+			Factory:  ResourceSubnet,
+		},
+		{
+			TypeName: "aws_volume_attachment",
+			Factory:  ResourceVolumeAttachment,
+		},
+		{
+			TypeName: "aws_vpc",
+			Factory:  ResourceVPC,
+		},
+		{
+			TypeName: "aws_vpc_dhcp_options",
+			Factory:  ResourceVPCDHCPOptions,
+		},
+		{
+			TypeName: "aws_vpc_dhcp_options_association",
+			Factory:  ResourceVPCDHCPOptionsAssociation,
+		},
+		{
+			TypeName: "aws_vpc_endpoint",
+			Factory:  ResourceVPCEndpoint,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_connection_accepter",
+			Factory:  ResourceVPCEndpointConnectionAccepter,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_connection_notification",
+			Factory:  ResourceVPCEndpointConnectionNotification,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_policy",
+			Factory:  ResourceVPCEndpointPolicy,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_route_table_association",
+			Factory:  ResourceVPCEndpointRouteTableAssociation,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_security_group_association",
+			Factory:  ResourceVPCEndpointSecurityGroupAssociation,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_service",
+			Factory:  ResourceVPCEndpointService,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_service_allowed_principal",
+			Factory:  ResourceVPCEndpointServiceAllowedPrincipal,
+		},
+		{
+			TypeName: "aws_vpc_endpoint_subnet_association",
+			Factory:  ResourceVPCEndpointSubnetAssociation,
+		},
+		{
+			TypeName: "aws_vpc_ipam",
+			Factory:  ResourceIPAM,
+		},
+		{
+			TypeName: "aws_vpc_ipam_organization_admin_account",
+			Factory:  ResourceIPAMOrganizationAdminAccount,
+		},
+		{
+			TypeName: "aws_vpc_ipam_pool",
+			Factory:  ResourceIPAMPool,
+		},
+		{
+			TypeName: "aws_vpc_ipam_pool_cidr",
+			Factory:  ResourceIPAMPoolCIDR,
+		},
+		{
+			TypeName: "aws_vpc_ipam_pool_cidr_allocation",
+			Factory:  ResourceIPAMPoolCIDRAllocation,
+		},
+		{
+			TypeName: "aws_vpc_ipam_preview_next_cidr",
+			Factory:  ResourceIPAMPreviewNextCIDR,
+		},
+		{
+			TypeName: "aws_vpc_ipam_scope",
+			Factory:  ResourceIPAMScope,
+		},
+		{
+			TypeName: "aws_vpc_ipv4_cidr_block_association",
+			Factory:  ResourceVPCIPv4CIDRBlockAssociation,
+		},
+		{
+			TypeName: "aws_vpc_ipv6_cidr_block_association",
+			Factory:  ResourceVPCIPv6CIDRBlockAssociation,
+		},
+		{
+			TypeName: "aws_vpc_network_performance_metric_subscription",
+			Factory:  ResourceNetworkPerformanceMetricSubscription,
+		},
+		{
+			TypeName: "aws_vpc_peering_connection",
+			Factory:  ResourceVPCPeeringConnection,
+		},
+		{
+			TypeName: "aws_vpc_peering_connection_accepter",
+			Factory:  ResourceVPCPeeringConnectionAccepter,
+		},
+		{
+			TypeName: "aws_vpc_peering_connection_options",
+			Factory:  ResourceVPCPeeringConnectionOptions,
+		},
+		{
+			TypeName: "aws_vpn_connection",
+			Factory:  ResourceVPNConnection,
+		},
+		{
+			TypeName: "aws_vpn_connection_route",
+			Factory:  ResourceVPNConnectionRoute,
+		},
+		{
+			TypeName: "aws_vpn_gateway",
+			Factory:  ResourceVPNGateway,
+		},
+		{
+			TypeName: "aws_vpn_gateway_attachment",
+			Factory:  ResourceVPNGatewayAttachment,
+		},
+		{
+			TypeName: "aws_vpn_gateway_route_propagation",
+			Factory:  ResourceVPNGatewayRoutePropagation,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "ec2"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
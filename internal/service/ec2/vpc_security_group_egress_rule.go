@@ -9,11 +9,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
-func init() {
-	// _sp.registerFrameworkResourceFactory(newResourceSecurityGroupEgressRule)
-}
-
 // newResourceSecurityGroupEgressRule instantiates a new Resource for the aws_vpc_security_group_egress_rule resource.
+// TODO: Register with the FrameworkResource annotation.
 func newResourceSecurityGroupEgressRule(context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceSecurityGroupEgressRule{}, nil
 }
@@ -26,11 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func init() {
-	// _sp.registerFrameworkResourceFactory(newResourceSecurityGroupIngressRule)
-}
-
 // newResourceSecurityGroupIngressRule instantiates a new Resource for the aws_vpc_security_group_ingress_rule resource.
+// TODO: Register with the FrameworkResource annotation.
 func newResourceSecurityGroupIngressRule(context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceSecurityGroupIngressRule{}, nil
 }
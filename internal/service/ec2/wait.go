@@ -44,7 +44,7 @@ func WaitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.EC2, name s
 		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AvailabilityZone); ok {
 		return output, err
@@ -61,7 +61,7 @@ func WaitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.EC2, nam
 		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AvailabilityZone); ok {
 		return output, err
@@ -83,7 +83,7 @@ func WaitCapacityReservationActive(ctx context.Context, conn *ec2.EC2, id string
 		Timeout: CapacityReservationActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
 		return output, err
@@ -100,7 +100,7 @@ func WaitCapacityReservationDeleted(ctx context.Context, conn *ec2.EC2, id strin
 		Timeout: CapacityReservationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
 		return output, err
@@ -123,7 +123,7 @@ func WaitCarrierGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*
 		Timeout: CarrierGatewayAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -140,7 +140,7 @@ func WaitCarrierGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*
 		Timeout: CarrierGatewayDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -166,7 +166,7 @@ func WaitLocalGatewayRouteTableVPCAssociationAssociated(ctx context.Context, con
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -184,7 +184,7 @@ func WaitLocalGatewayRouteTableVPCAssociationDisassociated(ctx context.Context,
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -206,7 +206,7 @@ func WaitClientVPNEndpointDeleted(ctx context.Context, conn *ec2.EC2, id string)
 		Timeout: ClientVPNEndpointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -225,7 +225,7 @@ func WaitClientVPNEndpointClientConnectResponseOptionsUpdated(ctx context.Contex
 		Timeout: ClientVPNEndpointAttributeUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientConnectResponseOptions); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -249,7 +249,7 @@ func WaitClientVPNAuthorizationRuleCreated(ctx context.Context, conn *ec2.EC2, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -268,7 +268,7 @@ func WaitClientVPNAuthorizationRuleDeleted(ctx context.Context, conn *ec2.EC2, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -297,7 +297,7 @@ func WaitClientVPNNetworkAssociationCreated(ctx context.Context, conn *ec2.EC2,
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -318,7 +318,7 @@ func WaitClientVPNNetworkAssociationDeleted(ctx context.Context, conn *ec2.EC2,
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -342,7 +342,7 @@ func WaitClientVPNRouteCreated(ctx context.Context, conn *ec2.EC2, endpointID, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -361,7 +361,7 @@ func WaitClientVPNRouteDeleted(ctx context.Context, conn *ec2.EC2, endpointID, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -382,7 +382,7 @@ func WaitFleet(ctx context.Context, conn *ec2.EC2, id string, pending, target []
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.FleetData); ok {
 		return output, err
@@ -401,7 +401,7 @@ func WaitImageAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout t
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -424,7 +424,7 @@ func WaitImageDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout tim
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -446,7 +446,7 @@ func WaitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.EC2, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		return output, err
@@ -465,7 +465,7 @@ func WaitInstanceCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -494,7 +494,7 @@ func WaitInstanceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -517,7 +517,7 @@ func WaitInstanceReady(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -540,7 +540,7 @@ func WaitInstanceStarted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -568,7 +568,7 @@ func WaitInstanceStopped(ctx context.Context, conn *ec2.EC2, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -590,7 +590,7 @@ func WaitInstanceCapacityReservationSpecificationUpdated(ctx context.Context, co
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		return output, err
@@ -608,7 +608,7 @@ func WaitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx context.Context, conn
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InstanceMaintenanceOptions); ok {
 		return output, err
@@ -627,7 +627,7 @@ func WaitInstanceMetadataOptionsApplied(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InstanceMetadataOptionsResponse); ok {
 		return output, err
@@ -645,7 +645,7 @@ func WaitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.EbsInstanceBlockDevice); ok {
 		return output, err
@@ -665,7 +665,7 @@ func WaitRouteDeleted(ctx context.Context, conn *ec2.EC2, routeFinder RouteFinde
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -684,7 +684,7 @@ func WaitRouteReady(ctx context.Context, conn *ec2.EC2, routeFinder RouteFinder,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -711,7 +711,7 @@ func WaitRouteTableReady(ctx context.Context, conn *ec2.EC2, id string, timeout
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -729,7 +729,7 @@ func WaitRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string, timeou
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -747,7 +747,7 @@ func WaitRouteTableAssociationCreated(ctx context.Context, conn *ec2.EC2, id str
 		NotFoundChecks: RouteTableAssociationCreatedNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -768,7 +768,7 @@ func WaitRouteTableAssociationDeleted(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: RouteTableAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -789,7 +789,7 @@ func WaitRouteTableAssociationUpdated(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: RouteTableAssociationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -812,7 +812,7 @@ func WaitSecurityGroupCreated(ctx context.Context, conn *ec2.EC2, id string, tim
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SecurityGroup); ok {
 		return output, err
@@ -836,7 +836,7 @@ func WaitSubnetAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -853,7 +853,7 @@ func WaitSubnetIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC
 		Timeout: SubnetIPv6CIDRBlockAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SubnetCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.SubnetCidrBlockStateCodeFailed {
@@ -874,7 +874,7 @@ func WaitSubnetIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC
 		Timeout: SubnetIPv6CIDRBlockAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SubnetCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.SubnetCidrBlockStateCodeFailed {
@@ -896,7 +896,7 @@ func waitSubnetAssignIPv6AddressOnCreationUpdated(ctx context.Context, conn *ec2
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -914,7 +914,7 @@ func waitSubnetEnableDNS64Updated(ctx context.Context, conn *ec2.EC2, subnetID s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -932,7 +932,7 @@ func waitSubnetEnableResourceNameDNSAAAARecordOnLaunchUpdated(ctx context.Contex
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -950,7 +950,7 @@ func waitSubnetEnableResourceNameDNSARecordOnLaunchUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -968,7 +968,7 @@ func WaitSubnetMapCustomerOwnedIPOnLaunchUpdated(ctx context.Context, conn *ec2.
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -986,7 +986,7 @@ func WaitSubnetMapPublicIPOnLaunchUpdated(ctx context.Context, conn *ec2.EC2, su
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -1004,7 +1004,7 @@ func WaitSubnetPrivateDNSHostnameTypeOnLaunchUpdated(ctx context.Context, conn *
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -1025,7 +1025,7 @@ func WaitTransitGatewayCreated(ctx context.Context, conn *ec2.EC2, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -1043,7 +1043,7 @@ func WaitTransitGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string, ti
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -1060,7 +1060,7 @@ func WaitTransitGatewayUpdated(ctx context.Context, conn *ec2.EC2, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -1077,7 +1077,7 @@ func WaitTransitGatewayConnectCreated(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
 		return output, err
@@ -1095,7 +1095,7 @@ func WaitTransitGatewayConnectDeleted(ctx context.Context, conn *ec2.EC2, id str
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
 		return output, err
@@ -1112,7 +1112,7 @@ func WaitTransitGatewayConnectPeerCreated(ctx context.Context, conn *ec2.EC2, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -1129,7 +1129,7 @@ func WaitTransitGatewayConnectPeerDeleted(ctx context.Context, conn *ec2.EC2, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -1146,7 +1146,7 @@ func WaitTransitGatewayMulticastDomainCreated(ctx context.Context, conn *ec2.EC2
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -1163,7 +1163,7 @@ func WaitTransitGatewayMulticastDomainDeleted(ctx context.Context, conn *ec2.EC2
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -1180,7 +1180,7 @@ func WaitTransitGatewayMulticastDomainAssociationCreated(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -1197,7 +1197,7 @@ func WaitTransitGatewayMulticastDomainAssociationDeleted(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -1220,7 +1220,7 @@ func WaitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1241,7 +1241,7 @@ func WaitTransitGatewayPeeringAttachmentCreated(ctx context.Context, conn *ec2.E
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1267,7 +1267,7 @@ func WaitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.E
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1292,7 +1292,7 @@ func WaitTransitGatewayPrefixListReferenceStateCreated(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1309,7 +1309,7 @@ func WaitTransitGatewayPrefixListReferenceStateDeleted(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1326,7 +1326,7 @@ func WaitTransitGatewayPrefixListReferenceStateUpdated(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1348,7 +1348,7 @@ func WaitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.EC2, transitG
 		Refresh: StatusTransitGatewayRouteState(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
 		return output, err
@@ -1365,7 +1365,7 @@ func WaitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.EC2, transitG
 		Refresh: StatusTransitGatewayRouteState(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
 		return output, err
@@ -1389,7 +1389,7 @@ func WaitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayPolicyTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -1406,7 +1406,7 @@ func WaitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTable); ok {
 		return output, err
@@ -1423,7 +1423,7 @@ func WaitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayPolicyTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -1440,7 +1440,7 @@ func WaitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTable); ok {
 		return output, err
@@ -1464,7 +1464,7 @@ func WaitTransitGatewayPolicyTableAssociationCreated(ctx context.Context, conn *
 		Refresh: StatusTransitGatewayPolicyTableAssociationState(ctx, conn, transitGatewayPolicyTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -1482,7 +1482,7 @@ func WaitTransitGatewayPolicyTableAssociationDeleted(ctx context.Context, conn *
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -1499,7 +1499,7 @@ func WaitTransitGatewayRouteTableAssociationCreated(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTableAssociationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAssociation); ok {
 		return output, err
@@ -1517,7 +1517,7 @@ func WaitTransitGatewayRouteTableAssociationDeleted(ctx context.Context, conn *e
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAssociation); ok {
 		return output, err
@@ -1539,7 +1539,7 @@ func WaitTransitGatewayRouteTablePropagationCreated(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTablePropagationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTablePropagation); ok {
 		return output, err
@@ -1556,7 +1556,7 @@ func WaitTransitGatewayRouteTablePropagationDeleted(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTablePropagationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
 		return nil, nil
@@ -1583,7 +1583,7 @@ func WaitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1600,7 +1600,7 @@ func WaitTransitGatewayVPCAttachmentCreated(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1622,7 +1622,7 @@ func WaitTransitGatewayVPCAttachmentDeleted(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1639,7 +1639,7 @@ func WaitTransitGatewayVPCAttachmentUpdated(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1658,7 +1658,7 @@ func WaitVolumeCreated(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Volume); ok {
 		return output, err
@@ -1677,7 +1677,7 @@ func WaitVolumeDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Volume); ok {
 		return output, err
@@ -1696,7 +1696,7 @@ func WaitVolumeUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Volume); ok {
 		return output, err
@@ -1715,7 +1715,7 @@ func WaitVolumeAttachmentCreated(ctx context.Context, conn *ec2.EC2, volumeID, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeAttachment); ok {
 		return output, err
@@ -1734,7 +1734,7 @@ func WaitVolumeAttachmentDeleted(ctx context.Context, conn *ec2.EC2, volumeID, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeAttachment); ok {
 		return output, err
@@ -1756,7 +1756,7 @@ func WaitVolumeModificationComplete(ctx context.Context, conn *ec2.EC2, id strin
 		MinTimeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeModification); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -1781,7 +1781,7 @@ func WaitVPCCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Vpc, er
 		Timeout: vpcCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Vpc); ok {
 		return output, err
@@ -1799,7 +1799,7 @@ func WaitVPCAttributeUpdated(ctx context.Context, conn *ec2.EC2, vpcID string, a
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Vpc); ok {
 		return output, err
@@ -1818,7 +1818,7 @@ func WaitVPCCIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1841,7 +1841,7 @@ func WaitVPCCIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1869,7 +1869,7 @@ func WaitVPCIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1892,7 +1892,7 @@ func WaitVPCIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1917,7 +1917,7 @@ func WaitVPCPeeringConnectionActive(ctx context.Context, conn *ec2.EC2, id strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -1940,7 +1940,7 @@ func WaitVPCPeeringConnectionDeleted(ctx context.Context, conn *ec2.EC2, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -1966,7 +1966,7 @@ func WaitVPNGatewayVPCAttachmentAttached(ctx context.Context, conn *ec2.EC2, vpn
 		Timeout: VPNGatewayVPCAttachmentAttachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -1983,7 +1983,7 @@ func WaitVPNGatewayVPCAttachmentDetached(ctx context.Context, conn *ec2.EC2, vpn
 		Timeout: VPNGatewayVPCAttachmentDetachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -2007,7 +2007,7 @@ func WaitCustomerGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CustomerGateway); ok {
 		return output, err
@@ -2024,7 +2024,7 @@ func WaitCustomerGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (
 		Timeout: customerGatewayDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CustomerGateway); ok {
 		return output, err
@@ -2046,7 +2046,7 @@ func WaitNATGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 		Timeout: natGatewayCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGateway); ok {
 		if state := aws.StringValue(output.State); state == ec2.NatGatewayStateFailed {
@@ -2069,7 +2069,7 @@ func WaitNATGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGateway); ok {
 		if state := aws.StringValue(output.State); state == ec2.NatGatewayStateFailed {
@@ -2098,7 +2098,7 @@ func WaitVPNConnectionCreated(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2117,7 +2117,7 @@ func WaitVPNConnectionDeleted(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2136,7 +2136,7 @@ func WaitVPNConnectionUpdated(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2158,7 +2158,7 @@ func WaitVPNConnectionRouteCreated(ctx context.Context, conn *ec2.EC2, vpnConnec
 		Timeout: vpnConnectionRouteCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnStaticRoute); ok {
 		return output, err
@@ -2175,7 +2175,7 @@ func WaitVPNConnectionRouteDeleted(ctx context.Context, conn *ec2.EC2, vpnConnec
 		Timeout: vpnConnectionRouteDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnStaticRoute); ok {
 		return output, err
@@ -2198,7 +2198,7 @@ func WaitHostCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host,
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2215,7 +2215,7 @@ func WaitHostUpdated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host,
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2232,7 +2232,7 @@ func WaitHostDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host,
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2254,7 +2254,7 @@ func WaitInternetGatewayAttached(ctx context.Context, conn *ec2.EC2, internetGat
 		Refresh:        StatusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InternetGatewayAttachment); ok {
 		return output, err
@@ -2271,7 +2271,7 @@ func WaitInternetGatewayDetached(ctx context.Context, conn *ec2.EC2, internetGat
 		Refresh: StatusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InternetGatewayAttachment); ok {
 		return output, err
@@ -2292,7 +2292,7 @@ func WaitManagedPrefixListCreated(ctx context.Context, conn *ec2.EC2, id string)
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateCreateFailed {
@@ -2313,7 +2313,7 @@ func WaitManagedPrefixListModified(ctx context.Context, conn *ec2.EC2, id string
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateModifyFailed {
@@ -2334,7 +2334,7 @@ func WaitManagedPrefixListDeleted(ctx context.Context, conn *ec2.EC2, id string)
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateDeleteFailed {
@@ -2357,7 +2357,7 @@ func WaitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInsightsAnalysis); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -2381,7 +2381,7 @@ func WaitNetworkInterfaceAttached(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceAttachmentStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -2405,7 +2405,7 @@ func WaitNetworkInterfaceAvailableAfterUse(ctx context.Context, conn *ec2.EC2, i
 		NotFoundChecks:            1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterface); ok {
 		return output, err
@@ -2422,7 +2422,7 @@ func WaitNetworkInterfaceCreated(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterface); ok {
 		return output, err
@@ -2439,7 +2439,7 @@ func WaitNetworkInterfaceDetached(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceAttachmentStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -2461,7 +2461,7 @@ func WaitPlacementGroupCreated(ctx context.Context, conn *ec2.EC2, name string)
 		Refresh: StatusPlacementGroupState(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -2478,7 +2478,7 @@ func WaitPlacementGroupDeleted(ctx context.Context, conn *ec2.EC2, name string)
 		Refresh: StatusPlacementGroupState(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -2497,7 +2497,7 @@ func WaitSpotFleetRequestCreated(ctx context.Context, conn *ec2.EC2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		return output, err
@@ -2516,7 +2516,7 @@ func WaitSpotFleetRequestFulfilled(ctx context.Context, conn *ec2.EC2, id string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		if activityStatus := aws.StringValue(output.ActivityStatus); activityStatus == ec2.ActivityStatusError {
@@ -2554,7 +2554,7 @@ func WaitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.EC2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		return output, err
@@ -2573,7 +2573,7 @@ func WaitVPCEndpointAccepted(ctx context.Context, conn *ec2.EC2, vpcEndpointID s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == vpcEndpointStateFailed && lastError != nil {
@@ -2596,7 +2596,7 @@ func WaitVPCEndpointAvailable(ctx context.Context, conn *ec2.EC2, vpcEndpointID
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == vpcEndpointStateFailed && lastError != nil {
@@ -2619,7 +2619,7 @@ func WaitVPCEndpointDeleted(ctx context.Context, conn *ec2.EC2, vpcEndpointID st
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		return output, err
@@ -2638,7 +2638,7 @@ func WaitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.EC2, id stri
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
 		return output, err
@@ -2657,7 +2657,7 @@ func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
 		return output, err
@@ -2675,7 +2675,7 @@ func WaitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2689,7 +2689,7 @@ func WaitVPCEndpointRouteTableAssociationReady(ctx context.Context, conn *ec2.EC
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2709,7 +2709,7 @@ func WaitEBSSnapshotImportComplete(ctx context.Context, conn *ec2.EC2, importTas
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SnapshotTaskDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -2730,7 +2730,7 @@ func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.EC2, servi
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpointConnection); ok {
 		return output, err
@@ -2752,7 +2752,7 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.EC2, id string, t
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SnapshotTierStatus); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.LastTieringOperationStatus), aws.StringValue(output.LastTieringOperationStatusDetail)))
@@ -2772,7 +2772,7 @@ func WaitIPAMCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2790,7 +2790,7 @@ func WaitIPAMDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2808,7 +2808,7 @@ func WaitIPAMUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2826,7 +2826,7 @@ func WaitIPAMPoolCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateCreateFailed {
@@ -2848,7 +2848,7 @@ func WaitIPAMPoolDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateDeleteFailed {
@@ -2870,7 +2870,7 @@ func WaitIPAMPoolUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateModifyFailed {
@@ -2893,7 +2893,7 @@ func WaitIPAMPoolCIDRIdCreated(ctx context.Context, conn *ec2.EC2, poolCidrId, p
 		NotFoundChecks: IPAMPoolCIDRNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolCidr); ok {
 		if state, failureReason := aws.StringValue(output.State), output.FailureReason; state == ec2.IpamPoolCidrStateFailedProvision && failureReason != nil {
@@ -2915,7 +2915,7 @@ func WaitIPAMPoolCIDRDeleted(ctx context.Context, conn *ec2.EC2, cidrBlock, pool
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolCidr); ok {
 		if state, failureReason := aws.StringValue(output.State), output.FailureReason; state == ec2.IpamPoolCidrStateFailedDeprovision && failureReason != nil {
@@ -2937,7 +2937,7 @@ func WaitIPAMPoolCIDRAllocationCreated(ctx context.Context, conn *ec2.EC2, alloc
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolAllocation); ok {
 		return output, err
@@ -2955,7 +2955,7 @@ func WaitIPAMScopeCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -2973,7 +2973,7 @@ func WaitIPAMScopeDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -2991,7 +2991,7 @@ func WaitIPAMScopeUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -3015,7 +3015,7 @@ func WaitInstanceStoppedWithContext(ctx context.Context, conn *ec2.EC2, id strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3038,7 +3038,7 @@ func WaitInstanceStartedWithContext(ctx context.Context, conn *ec2.EC2, id strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3061,7 +3061,7 @@ func WaitInstanceReadyWithContext(ctx context.Context, conn *ec2.EC2, id string,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_ecs_cluster_capacity_providers")
 func ResourceClusterCapacityProviders() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCapacityProvidersPut,
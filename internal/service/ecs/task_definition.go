@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_ecs_task_definition")
 func ResourceTaskDefinition() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -52,7 +54,10 @@ func ResourceTaskDefinition() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			containerDefinitionCustomizeDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceTaskDefinitionMigrateState,
@@ -62,10 +67,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": containerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -438,10 +446,15 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+	var definitions []*ecs.ContainerDefinition
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandContainerDefinitionBlocks(v.([]interface{}))
+	} else {
+		var err error
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+		}
 	}
 
 	input := ecs.RegisterTaskDefinitionInput{
@@ -602,6 +615,11 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("family", taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
 
+	// The typed blocks carry their own defaults and need no equivalency normalization.
+	if err := d.Set("container_definition", flattenContainerDefinitionBlocks(taskDefinition.ContainerDefinitions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+	}
+
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
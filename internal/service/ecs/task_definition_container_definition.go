@@ -0,0 +1,1566 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// containerDefinitionSchema returns the typed alternative to the JSON
+// container_definitions argument. Schema defaults mirror the values the ECS API
+// returns for omitted fields so that no equivalency normalization is needed.
+func containerDefinitionSchema() *schema.Schema {
+	secretSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value_from": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"container_definition", "container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"disable_networking": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"dns_search_domains": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"dns_servers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"docker_labels": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"docker_security_options": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment_file": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.EnvironmentFileTypeS3,
+								ValidateFunc: validation.StringInSlice(ecs.EnvironmentFileType_Values(), false),
+							},
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"extra_host": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hostname": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"ip_address": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"firelens_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.FirelensConfigurationType_Values(), false),
+							},
+						},
+					},
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      30,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      3,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      5,
+								ValidateFunc: validation.IntBetween(2, 60),
+							},
+						},
+					},
+				},
+				"hostname": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"interactive": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"links": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"linux_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"capabilities": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"add": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"drop": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"device": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"container_path": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+										"host_path": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"permissions": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem: &schema.Schema{
+												Type:         schema.TypeString,
+												ValidateFunc: validation.StringInSlice(ecs.DeviceCgroupPermission_Values(), false),
+											},
+										},
+									},
+								},
+							},
+							"init_process_enabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"max_swap": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"shared_memory_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"swappiness": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 100),
+							},
+							"tmpfs": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"container_path": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"mount_options": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"size": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem:     secretSchema,
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(4),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(4),
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"container_port_range": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							// The API fills in the host port for awsvpc and host network modes.
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"privileged": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"pseudo_terminal": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"readonly_root_filesystem": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"repository_credentials": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"credentials_parameter": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"resource_requirement": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ResourceType_Values(), false),
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"secret": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     secretSchema,
+				},
+				"start_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"stop_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 120),
+				},
+				"system_control": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"namespace": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"ulimit": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hard_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+							},
+							"soft_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"volumes_from": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_container": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+// containerDefinitionCustomizeDiff validates the typed container_definition
+// blocks as a whole, reporting the offending container and field.
+func containerDefinitionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.GetRawConfig().GetAttr("container_definition"); !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	return validContainerDefinitionBlocks(d.Get("container_definition").([]interface{}), d.Get("network_mode").(string))
+}
+
+func validContainerDefinitionBlocks(tfList []interface{}, networkMode string) error {
+	var errs *multierror.Error
+
+	names := make(map[string]int)
+	essential := false
+	firelens := false
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Unknown values are empty at plan time.
+		name := tfMap["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		if j, ok := names[name]; ok {
+			errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): name: duplicates container_definition.%d", i, name, j))
+		} else {
+			names[name] = i
+		}
+
+		if tfMap["essential"].(bool) {
+			essential = true
+		}
+
+		if v, ok := tfMap["firelens_configuration"].([]interface{}); ok && len(v) > 0 {
+			firelens = true
+		}
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		if memory, reservation := tfMap["memory"].(int), tfMap["memory_reservation"].(int); memory > 0 && reservation > memory {
+			errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): memory_reservation: %d must not exceed memory (%d)", i, name, reservation, memory))
+		}
+
+		for j, tfMapRaw := range tfMap["depends_on"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch v := tfMap["container_name"].(string); {
+			case v == "":
+			case v == name:
+				errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): depends_on.%d.container_name: container cannot depend on itself", i, name, j))
+			default:
+				if _, ok := names[v]; !ok {
+					errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): depends_on.%d.container_name: container %q is not defined", i, name, j, v))
+				}
+			}
+		}
+
+		for j, tfMapRaw := range tfMap["port_mapping"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			containerPort, containerPortRange := tfMap["container_port"].(int), tfMap["container_port_range"].(string)
+
+			if containerPort == 0 && containerPortRange == "" {
+				errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): port_mapping.%d: one of container_port or container_port_range must be specified", i, name, j))
+			}
+
+			if containerPort > 0 && containerPortRange != "" {
+				errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): port_mapping.%d: only one of container_port or container_port_range can be specified", i, name, j))
+			}
+
+			if hostPort := tfMap["host_port"].(int); hostPort > 0 && containerPort > 0 && hostPort != containerPort && (networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost) {
+				errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): port_mapping.%d.host_port: must equal container_port (%d) in %s network mode", i, name, j, containerPort, networkMode))
+			}
+		}
+
+		for j, tfMapRaw := range tfMap["ulimit"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if soft, hard := tfMap["soft_limit"].(int), tfMap["hard_limit"].(int); soft > hard {
+				errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): ulimit.%d.soft_limit: %d must not exceed hard_limit (%d)", i, name, j, soft, hard))
+			}
+		}
+
+		for j, tfMapRaw := range tfMap["volumes_from"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if v := tfMap["source_container"].(string); v != "" {
+				if _, ok := names[v]; !ok {
+					errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): volumes_from.%d.source_container: container %q is not defined", i, name, j, v))
+				}
+			}
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v := v[0].(map[string]interface{})["log_driver"].(string); v == ecs.LogDriverAwsfirelens && !firelens {
+				errs = multierror.Append(errs, fmt.Errorf("container_definition.%d (%s): log_configuration.0.log_driver: %s requires a container with firelens_configuration", i, name, v))
+			}
+		}
+	}
+
+	if len(names) > 0 && !essential {
+		errs = multierror.Append(errs, fmt.Errorf("container_definition: at least one container must be essential"))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func expandContainerDefinitionBlocks(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandContainerDefinitionBlock(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionBlock(tfMap map[string]interface{}) *ecs.ContainerDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.ContainerDefinition{
+		Essential: aws.Bool(tfMap["essential"].(bool)),
+		Image:     aws.String(tfMap["image"].(string)),
+		Name:      aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v > 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DependsOn = expandContainerDependencies(v)
+	}
+
+	if v, ok := tfMap["disable_networking"].(bool); ok && v {
+		apiObject.DisableNetworking = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["dns_search_domains"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DnsSearchDomains = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["dns_servers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DnsServers = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.DockerLabels = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["docker_security_options"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DockerSecurityOptions = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EntryPoint = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Environment = expandKeyValuePairs(v)
+	}
+
+	if v, ok := tfMap["environment_file"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EnvironmentFiles = expandEnvironmentFiles(v)
+	}
+
+	if v, ok := tfMap["extra_host"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ExtraHosts = expandHostEntries(v)
+	}
+
+	if v, ok := tfMap["firelens_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FirelensConfiguration = expandFirelensConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandHealthCheck(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["hostname"].(string); ok && v != "" {
+		apiObject.Hostname = aws.String(v)
+	}
+
+	if v, ok := tfMap["interactive"].(bool); ok && v {
+		apiObject.Interactive = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["links"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Links = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["linux_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LinuxParameters = expandLinuxParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandContainerLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v > 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v > 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MountPoints = expandMountPoints(v)
+	}
+
+	if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PortMappings = expandPortMappings(v)
+	}
+
+	if v, ok := tfMap["privileged"].(bool); ok && v {
+		apiObject.Privileged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["pseudo_terminal"].(bool); ok && v {
+		apiObject.PseudoTerminal = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+		apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["repository_credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RepositoryCredentials = &ecs.RepositoryCredentials{
+			CredentialsParameter: aws.String(v[0].(map[string]interface{})["credentials_parameter"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["resource_requirement"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ResourceRequirements = expandResourceRequirements(v)
+	}
+
+	if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Secrets = expandSecrets(v)
+	}
+
+	if v, ok := tfMap["start_timeout"].(int); ok && v > 0 {
+		apiObject.StartTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stop_timeout"].(int); ok && v > 0 {
+		apiObject.StopTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["system_control"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SystemControls = expandSystemControls(v)
+	}
+
+	if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Ulimits = expandUlimits(v)
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["volumes_from"].([]interface{}); ok && len(v) > 0 {
+		apiObject.VolumesFrom = expandVolumesFrom(v)
+	}
+
+	if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+		apiObject.WorkingDirectory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerDependencies(tfList []interface{}) []*ecs.ContainerDependency {
+	var apiObjects []*ecs.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ContainerDependency{
+			Condition:     aws.String(tfMap["condition"].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandKeyValuePairs(tfMap map[string]interface{}) []*ecs.KeyValuePair {
+	var apiObjects []*ecs.KeyValuePair
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	// Keep requests stable regardless of map iteration order.
+	sort.Slice(apiObjects, func(i, j int) bool {
+		return aws.StringValue(apiObjects[i].Name) < aws.StringValue(apiObjects[j].Name)
+	})
+
+	return apiObjects
+}
+
+func expandEnvironmentFiles(tfList []interface{}) []*ecs.EnvironmentFile {
+	var apiObjects []*ecs.EnvironmentFile
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.EnvironmentFile{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandHostEntries(tfList []interface{}) []*ecs.HostEntry {
+	var apiObjects []*ecs.HostEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.HostEntry{
+			Hostname:  aws.String(tfMap["hostname"].(string)),
+			IpAddress: aws.String(tfMap["ip_address"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandFirelensConfiguration(tfMap map[string]interface{}) *ecs.FirelensConfiguration {
+	apiObject := &ecs.FirelensConfiguration{
+		Type: aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	apiObject := &ecs.HealthCheck{
+		Command:  flex.ExpandStringList(tfMap["command"].([]interface{})),
+		Interval: aws.Int64(int64(tfMap["interval"].(int))),
+		Retries:  aws.Int64(int64(tfMap["retries"].(int))),
+		Timeout:  aws.Int64(int64(tfMap["timeout"].(int))),
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v > 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLinuxParameters(tfMap map[string]interface{}) *ecs.LinuxParameters {
+	apiObject := &ecs.LinuxParameters{}
+
+	if v, ok := tfMap["capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Capabilities = &ecs.KernelCapabilities{}
+
+		if v, ok := tfMap["add"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Capabilities.Add = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["drop"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Capabilities.Drop = flex.ExpandStringList(v)
+		}
+	}
+
+	if v, ok := tfMap["device"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			device := &ecs.Device{
+				HostPath: aws.String(tfMap["host_path"].(string)),
+			}
+
+			if v, ok := tfMap["container_path"].(string); ok && v != "" {
+				device.ContainerPath = aws.String(v)
+			}
+
+			if v, ok := tfMap["permissions"].([]interface{}); ok && len(v) > 0 {
+				device.Permissions = flex.ExpandStringList(v)
+			}
+
+			apiObject.Devices = append(apiObject.Devices, device)
+		}
+	}
+
+	if v, ok := tfMap["init_process_enabled"].(bool); ok && v {
+		apiObject.InitProcessEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["max_swap"].(int); ok && v > 0 {
+		apiObject.MaxSwap = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["shared_memory_size"].(int); ok && v > 0 {
+		apiObject.SharedMemorySize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["swappiness"].(int); ok && v > 0 {
+		apiObject.Swappiness = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["tmpfs"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			tmpfs := &ecs.Tmpfs{
+				ContainerPath: aws.String(tfMap["container_path"].(string)),
+				Size:          aws.Int64(int64(tfMap["size"].(int))),
+			}
+
+			if v, ok := tfMap["mount_options"].([]interface{}); ok && len(v) > 0 {
+				tmpfs.MountOptions = flex.ExpandStringList(v)
+			}
+
+			apiObject.Tmpfs = append(apiObject.Tmpfs, tmpfs)
+		}
+	}
+
+	return apiObject
+}
+
+func expandContainerLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecretOptions = expandSecrets(v)
+	}
+
+	return apiObject
+}
+
+func expandMountPoints(tfList []interface{}) []*ecs.MountPoint {
+	var apiObjects []*ecs.MountPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.MountPoint{
+			ContainerPath: aws.String(tfMap["container_path"].(string)),
+			ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+			SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	var apiObjects []*ecs.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			Protocol: aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["container_port"].(int); ok && v > 0 {
+			apiObject.ContainerPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["container_port_range"].(string); ok && v != "" {
+			apiObject.ContainerPortRange = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v > 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResourceRequirements(tfList []interface{}) []*ecs.ResourceRequirement {
+	var apiObjects []*ecs.ResourceRequirement
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ResourceRequirement{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandSystemControls(tfList []interface{}) []*ecs.SystemControl {
+	var apiObjects []*ecs.SystemControl
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.SystemControl{
+			Namespace: aws.String(tfMap["namespace"].(string)),
+			Value:     aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandUlimits(tfList []interface{}) []*ecs.Ulimit {
+	var apiObjects []*ecs.Ulimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Ulimit{
+			HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+			Name:      aws.String(tfMap["name"].(string)),
+			SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandVolumesFrom(tfList []interface{}) []*ecs.VolumeFrom {
+	var apiObjects []*ecs.VolumeFrom
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.VolumeFrom{
+			ReadOnly:        aws.Bool(tfMap["read_only"].(bool)),
+			SourceContainer: aws.String(tfMap["source_container"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenContainerDefinitionBlocks(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenContainerDefinitionBlock(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionBlock(apiObject *ecs.ContainerDefinition) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"command":                  aws.StringValueSlice(apiObject.Command),
+		"cpu":                      aws.Int64Value(apiObject.Cpu),
+		"disable_networking":       aws.BoolValue(apiObject.DisableNetworking),
+		"dns_search_domains":       aws.StringValueSlice(apiObject.DnsSearchDomains),
+		"dns_servers":              aws.StringValueSlice(apiObject.DnsServers),
+		"docker_labels":            aws.StringValueMap(apiObject.DockerLabels),
+		"docker_security_options":  aws.StringValueSlice(apiObject.DockerSecurityOptions),
+		"entry_point":              aws.StringValueSlice(apiObject.EntryPoint),
+		"essential":                aws.BoolValue(apiObject.Essential),
+		"hostname":                 aws.StringValue(apiObject.Hostname),
+		"image":                    aws.StringValue(apiObject.Image),
+		"interactive":              aws.BoolValue(apiObject.Interactive),
+		"links":                    aws.StringValueSlice(apiObject.Links),
+		"memory":                   aws.Int64Value(apiObject.Memory),
+		"memory_reservation":       aws.Int64Value(apiObject.MemoryReservation),
+		"name":                     aws.StringValue(apiObject.Name),
+		"privileged":               aws.BoolValue(apiObject.Privileged),
+		"pseudo_terminal":          aws.BoolValue(apiObject.PseudoTerminal),
+		"readonly_root_filesystem": aws.BoolValue(apiObject.ReadonlyRootFilesystem),
+		"start_timeout":            aws.Int64Value(apiObject.StartTimeout),
+		"stop_timeout":             aws.Int64Value(apiObject.StopTimeout),
+		"user":                     aws.StringValue(apiObject.User),
+		"working_directory":        aws.StringValue(apiObject.WorkingDirectory),
+	}
+
+	// The API omits essential when it has its default value.
+	if apiObject.Essential == nil {
+		tfMap["essential"] = true
+	}
+
+	if v := apiObject.DependsOn; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"condition":      aws.StringValue(apiObject.Condition),
+				"container_name": aws.StringValue(apiObject.ContainerName),
+			})
+		}
+
+		tfMap["depends_on"] = tfList
+	}
+
+	if v := apiObject.Environment; len(v) > 0 {
+		environment := make(map[string]interface{}, len(v))
+
+		for _, apiObject := range v {
+			environment[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.Value)
+		}
+
+		tfMap["environment"] = environment
+	}
+
+	if v := apiObject.EnvironmentFiles; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"type":  aws.StringValue(apiObject.Type),
+				"value": aws.StringValue(apiObject.Value),
+			})
+		}
+
+		tfMap["environment_file"] = tfList
+	}
+
+	if v := apiObject.ExtraHosts; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"hostname":   aws.StringValue(apiObject.Hostname),
+				"ip_address": aws.StringValue(apiObject.IpAddress),
+			})
+		}
+
+		tfMap["extra_host"] = tfList
+	}
+
+	if v := apiObject.FirelensConfiguration; v != nil {
+		tfMap["firelens_configuration"] = []interface{}{map[string]interface{}{
+			"options": aws.StringValueMap(v.Options),
+			"type":    aws.StringValue(v.Type),
+		}}
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{map[string]interface{}{
+			"command":      aws.StringValueSlice(v.Command),
+			"interval":     aws.Int64Value(v.Interval),
+			"retries":      aws.Int64Value(v.Retries),
+			"start_period": aws.Int64Value(v.StartPeriod),
+			"timeout":      aws.Int64Value(v.Timeout),
+		}}
+	}
+
+	if v := apiObject.LinuxParameters; v != nil {
+		tfMap["linux_parameters"] = []interface{}{flattenLinuxParameters(v)}
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []interface{}{map[string]interface{}{
+			"log_driver":    aws.StringValue(v.LogDriver),
+			"options":       aws.StringValueMap(v.Options),
+			"secret_option": flattenSecrets(v.SecretOptions),
+		}}
+	}
+
+	if v := apiObject.MountPoints; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"container_path": aws.StringValue(apiObject.ContainerPath),
+				"read_only":      aws.BoolValue(apiObject.ReadOnly),
+				"source_volume":  aws.StringValue(apiObject.SourceVolume),
+			})
+		}
+
+		tfMap["mount_point"] = tfList
+	}
+
+	if v := apiObject.PortMappings; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfMap := map[string]interface{}{
+				"app_protocol":         aws.StringValue(apiObject.AppProtocol),
+				"container_port":       aws.Int64Value(apiObject.ContainerPort),
+				"container_port_range": aws.StringValue(apiObject.ContainerPortRange),
+				"host_port":            aws.Int64Value(apiObject.HostPort),
+				"name":                 aws.StringValue(apiObject.Name),
+				"protocol":             aws.StringValue(apiObject.Protocol),
+			}
+
+			if apiObject.Protocol == nil {
+				tfMap["protocol"] = ecs.TransportProtocolTcp
+			}
+
+			tfList = append(tfList, tfMap)
+		}
+
+		tfMap["port_mapping"] = tfList
+	}
+
+	if v := apiObject.RepositoryCredentials; v != nil {
+		tfMap["repository_credentials"] = []interface{}{map[string]interface{}{
+			"credentials_parameter": aws.StringValue(v.CredentialsParameter),
+		}}
+	}
+
+	if v := apiObject.ResourceRequirements; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"type":  aws.StringValue(apiObject.Type),
+				"value": aws.StringValue(apiObject.Value),
+			})
+		}
+
+		tfMap["resource_requirement"] = tfList
+	}
+
+	if v := apiObject.Secrets; len(v) > 0 {
+		tfMap["secret"] = flattenSecrets(v)
+	}
+
+	if v := apiObject.SystemControls; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"namespace": aws.StringValue(apiObject.Namespace),
+				"value":     aws.StringValue(apiObject.Value),
+			})
+		}
+
+		tfMap["system_control"] = tfList
+	}
+
+	if v := apiObject.Ulimits; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"hard_limit": aws.Int64Value(apiObject.HardLimit),
+				"name":       aws.StringValue(apiObject.Name),
+				"soft_limit": aws.Int64Value(apiObject.SoftLimit),
+			})
+		}
+
+		tfMap["ulimit"] = tfList
+	}
+
+	if v := apiObject.VolumesFrom; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"read_only":        aws.BoolValue(apiObject.ReadOnly),
+				"source_container": aws.StringValue(apiObject.SourceContainer),
+			})
+		}
+
+		tfMap["volumes_from"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenLinuxParameters(apiObject *ecs.LinuxParameters) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"init_process_enabled": aws.BoolValue(apiObject.InitProcessEnabled),
+		"max_swap":             aws.Int64Value(apiObject.MaxSwap),
+		"shared_memory_size":   aws.Int64Value(apiObject.SharedMemorySize),
+		"swappiness":           aws.Int64Value(apiObject.Swappiness),
+	}
+
+	if v := apiObject.Capabilities; v != nil {
+		tfMap["capabilities"] = []interface{}{map[string]interface{}{
+			"add":  aws.StringValueSlice(v.Add),
+			"drop": aws.StringValueSlice(v.Drop),
+		}}
+	}
+
+	if v := apiObject.Devices; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"container_path": aws.StringValue(apiObject.ContainerPath),
+				"host_path":      aws.StringValue(apiObject.HostPath),
+				"permissions":    aws.StringValueSlice(apiObject.Permissions),
+			})
+		}
+
+		tfMap["device"] = tfList
+	}
+
+	if v := apiObject.Tmpfs; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"container_path": aws.StringValue(apiObject.ContainerPath),
+				"mount_options":  aws.StringValueSlice(apiObject.MountOptions),
+				"size":           aws.Int64Value(apiObject.Size),
+			})
+		}
+
+		tfMap["tmpfs"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenSecrets(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,309 @@
+package ecs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidContainerDefinitionBlocks(t *testing.T) {
+	t.Parallel()
+
+	container := func(name string, essential bool, m map[string]interface{}) map[string]interface{} {
+		tfMap := map[string]interface{}{
+			"depends_on":             []interface{}{},
+			"essential":              essential,
+			"firelens_configuration": []interface{}{},
+			"log_configuration":      []interface{}{},
+			"memory":                 0,
+			"memory_reservation":     0,
+			"name":                   name,
+			"port_mapping":           []interface{}{},
+			"ulimit":                 []interface{}{},
+			"volumes_from":           []interface{}{},
+		}
+
+		for k, v := range m {
+			tfMap[k] = v
+		}
+
+		return tfMap
+	}
+
+	testCases := []struct {
+		name        string
+		networkMode string
+		containers  []interface{}
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"depends_on": []interface{}{
+						map[string]interface{}{"container_name": "sidecar", "condition": ecs.ContainerConditionStart},
+					},
+				}),
+				container("sidecar", false, nil),
+			},
+		},
+		{
+			name: "duplicate name",
+			containers: []interface{}{
+				container("web", true, nil),
+				container("web", true, nil),
+			},
+			expectedErr: "container_definition.1 (web): name: duplicates container_definition.0",
+		},
+		{
+			name: "no essential container",
+			containers: []interface{}{
+				container("web", false, nil),
+			},
+			expectedErr: "container_definition: at least one container must be essential",
+		},
+		{
+			name: "undefined dependency",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"depends_on": []interface{}{
+						map[string]interface{}{"container_name": "db", "condition": ecs.ContainerConditionHealthy},
+					},
+				}),
+			},
+			expectedErr: `container_definition.0 (web): depends_on.0.container_name: container "db" is not defined`,
+		},
+		{
+			name: "self dependency",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"depends_on": []interface{}{
+						map[string]interface{}{"container_name": "web", "condition": ecs.ContainerConditionStart},
+					},
+				}),
+			},
+			expectedErr: "container_definition.0 (web): depends_on.0.container_name: container cannot depend on itself",
+		},
+		{
+			name:        "awsvpc host port mismatch",
+			networkMode: ecs.NetworkModeAwsvpc,
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"port_mapping": []interface{}{
+						map[string]interface{}{"container_port": 80, "container_port_range": "", "host_port": 8080},
+					},
+				}),
+			},
+			expectedErr: "container_definition.0 (web): port_mapping.0.host_port: must equal container_port (80) in awsvpc network mode",
+		},
+		{
+			name:        "bridge host port mismatch",
+			networkMode: ecs.NetworkModeBridge,
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"port_mapping": []interface{}{
+						map[string]interface{}{"container_port": 80, "container_port_range": "", "host_port": 8080},
+					},
+				}),
+			},
+		},
+		{
+			name: "missing container port",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"port_mapping": []interface{}{
+						map[string]interface{}{"container_port": 0, "container_port_range": "", "host_port": 0},
+					},
+				}),
+			},
+			expectedErr: "container_definition.0 (web): port_mapping.0: one of container_port or container_port_range must be specified",
+		},
+		{
+			name: "memory reservation exceeds memory",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"memory":             128,
+					"memory_reservation": 256,
+				}),
+			},
+			expectedErr: "container_definition.0 (web): memory_reservation: 256 must not exceed memory (128)",
+		},
+		{
+			name: "ulimit soft exceeds hard",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"ulimit": []interface{}{
+						map[string]interface{}{"name": ecs.UlimitNameNofile, "soft_limit": 2048, "hard_limit": 1024},
+					},
+				}),
+			},
+			expectedErr: "container_definition.0 (web): ulimit.0.soft_limit: 2048 must not exceed hard_limit (1024)",
+		},
+		{
+			name: "firelens log driver without router",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"log_configuration": []interface{}{
+						map[string]interface{}{"log_driver": ecs.LogDriverAwsfirelens},
+					},
+				}),
+			},
+			expectedErr: "container_definition.0 (web): log_configuration.0.log_driver: awsfirelens requires a container with firelens_configuration",
+		},
+		{
+			name: "firelens log driver with router",
+			containers: []interface{}{
+				container("web", true, map[string]interface{}{
+					"log_configuration": []interface{}{
+						map[string]interface{}{"log_driver": ecs.LogDriverAwsfirelens},
+					},
+				}),
+				container("log_router", true, map[string]interface{}{
+					"firelens_configuration": []interface{}{
+						map[string]interface{}{"type": ecs.FirelensConfigurationTypeFluentbit},
+					},
+				}),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validContainerDefinitionBlocks(testCase.containers, testCase.networkMode)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.expectedErr)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Fatalf("expected error containing %q, got %q", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestFlattenContainerDefinitionBlocks(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []*ecs.ContainerDefinition{
+		{
+			Environment: []*ecs.KeyValuePair{
+				{Name: aws.String("B_VAR"), Value: aws.String("b")},
+				{Name: aws.String("A_VAR"), Value: aws.String("a")},
+			},
+			Image: aws.String("nginx"),
+			Name:  aws.String("web"),
+			PortMappings: []*ecs.PortMapping{
+				{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80)},
+			},
+		},
+	}
+
+	tfList := flattenContainerDefinitionBlocks(apiObjects)
+
+	if got, want := len(tfList), 1; got != want {
+		t.Fatalf("expected %d containers, got %d", want, got)
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if got, want := tfMap["essential"], true; got != want {
+		t.Errorf("expected essential %v, got %v", want, got)
+	}
+
+	if diff := cmp.Diff(tfMap["environment"], map[string]interface{}{"A_VAR": "a", "B_VAR": "b"}); diff != "" {
+		t.Errorf("unexpected environment (-got +want):\n%s", diff)
+	}
+
+	portMapping := tfMap["port_mapping"].([]interface{})[0].(map[string]interface{})
+
+	if got, want := portMapping["protocol"], ecs.TransportProtocolTcp; got != want {
+		t.Errorf("expected protocol %q, got %q", want, got)
+	}
+
+	// Expanding the flattened form sends environment variables in a stable order.
+	expanded := expandKeyValuePairs(tfMap["environment"].(map[string]interface{}))
+
+	if got, want := aws.StringValue(expanded[0].Name), "A_VAR"; got != want {
+		t.Errorf("expected first environment variable %q, got %q", want, got)
+	}
+}
+
+func TestExpandFlattenContainerDefinitionBlock(t *testing.T) {
+	t.Parallel()
+
+	apiObject := &ecs.ContainerDefinition{
+		DisableNetworking:     aws.Bool(true),
+		DnsSearchDomains:      aws.StringSlice([]string{"example.com"}),
+		DnsServers:            aws.StringSlice([]string{"10.0.0.2"}),
+		DockerSecurityOptions: aws.StringSlice([]string{"no-new-privileges"}),
+		Essential:             aws.Bool(true),
+		ExtraHosts: []*ecs.HostEntry{
+			{Hostname: aws.String("db"), IpAddress: aws.String("10.0.0.10")},
+		},
+		Image: aws.String("nginx"),
+		Name:  aws.String("web"),
+		ResourceRequirements: []*ecs.ResourceRequirement{
+			{Type: aws.String(ecs.ResourceTypeGpu), Value: aws.String("1")},
+		},
+		SystemControls: []*ecs.SystemControl{
+			{Namespace: aws.String("net.core.somaxconn"), Value: aws.String("1024")},
+		},
+	}
+
+	tfMap := map[string]interface{}{
+		"disable_networking":      true,
+		"dns_search_domains":      []interface{}{"example.com"},
+		"dns_servers":             []interface{}{"10.0.0.2"},
+		"docker_security_options": []interface{}{"no-new-privileges"},
+		"essential":               true,
+		"extra_host": []interface{}{map[string]interface{}{
+			"hostname":   "db",
+			"ip_address": "10.0.0.10",
+		}},
+		"image": "nginx",
+		"name":  "web",
+		"resource_requirement": []interface{}{map[string]interface{}{
+			"type":  ecs.ResourceTypeGpu,
+			"value": "1",
+		}},
+		"system_control": []interface{}{map[string]interface{}{
+			"namespace": "net.core.somaxconn",
+			"value":     "1024",
+		}},
+	}
+
+	if diff := cmp.Diff(expandContainerDefinitionBlock(tfMap), apiObject); diff != "" {
+		t.Errorf("unexpected expanded container definition (-got +want):\n%s", diff)
+	}
+
+	flattened := flattenContainerDefinitionBlock(apiObject)
+
+	for _, k := range []string{"extra_host", "resource_requirement", "system_control"} {
+		if diff := cmp.Diff(flattened[k], tfMap[k]); diff != "" {
+			t.Errorf("unexpected flattened %s (-got +want):\n%s", k, diff)
+		}
+	}
+
+	if diff := cmp.Diff(flattened["dns_servers"], []string{"10.0.0.2"}); diff != "" {
+		t.Errorf("unexpected flattened dns_servers (-got +want):\n%s", diff)
+	}
+
+	if got, want := flattened["disable_networking"], true; got != want {
+		t.Errorf("expected disable_networking %v, got %v", want, got)
+	}
+}
@@ -65,6 +65,79 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlocks(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlocks(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.B_VAR", "b"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.host_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.container_name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.condition", "START"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.linux_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.linux_parameters.0.init_process_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.ulimit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.log_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.log_configuration.0.log_driver", "awslogs"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_ContainerDefinitionBlocks_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionConfig_containerDefinitionBlocksInvalid(rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`container_definition.0 \(web\): depends_on.0.container_name: container "missing" is not defined`),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/2370
 func TestAccECSTaskDefinition_scratchVolume(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1310,6 +1383,91 @@ TASK_DEFINITION
 `, rName))
 }
 
+func testAccTaskDefinitionConfig_containerDefinitionBlocks(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  network_mode = "awsvpc"
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 128
+    memory = 256
+
+    environment = {
+      A_VAR = "a"
+      B_VAR = "b"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+
+    depends_on {
+      container_name = "sidecar"
+      condition      = "START"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    linux_parameters {
+      init_process_enabled = true
+    }
+
+    ulimit {
+      name       = "nofile"
+      soft_limit = 1024
+      hard_limit = 4096
+    }
+
+    log_configuration {
+      log_driver = "awslogs"
+
+      options = {
+        awslogs-group         = aws_cloudwatch_log_group.test.name
+        awslogs-region        = %[2]q
+        awslogs-stream-prefix = "web"
+      }
+    }
+  }
+
+  container_definition {
+    name      = "sidecar"
+    image     = "busybox:latest"
+    essential = false
+    memory    = 64
+    command   = ["sleep", "3600"]
+  }
+}
+`, rName, acctest.Region())
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionBlocksInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    memory = 256
+
+    depends_on {
+      container_name = "missing"
+      condition      = "START"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccTaskDefinitionConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
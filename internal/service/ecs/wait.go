@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -36,7 +37,7 @@ func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string)
 		Timeout: capacityProviderDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
 		return v, err
@@ -53,7 +54,7 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 		Timeout: capacityProviderUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
 		return v, err
@@ -79,7 +80,7 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
@@ -106,7 +107,7 @@ func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string,
 		MinTimeout: serviceInactiveTimeoutMin,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -120,7 +121,7 @@ func waitServiceActive(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
@@ -138,7 +139,7 @@ func waitClusterAvailable(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.
 		Delay:   clusterAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.Cluster); ok {
 		return v, err
@@ -155,7 +156,7 @@ func waitClusterDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.Cl
 		Timeout: clusterDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.Cluster); ok {
 		return v, err
@@ -172,7 +173,7 @@ func waitTaskSetStable(ctx context.Context, conn *ecs.ECS, timeout time.Duration
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -185,7 +186,7 @@ func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service,
 		Timeout: taskSetDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
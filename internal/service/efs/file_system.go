@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_efs_file_system")
 func ResourceFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemCreate,
@@ -415,7 +416,7 @@ func waitFileSystemAvailable(ctx context.Context, conn *efs.EFS, fileSystemID st
 		MinTimeout: fileSystemAvailableMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.FileSystemDescription); ok {
 		return output, err
@@ -434,7 +435,7 @@ func waitFileSystemDeleted(ctx context.Context, conn *efs.EFS, fileSystemID stri
 		MinTimeout: fileSystemDeletedMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.FileSystemDescription); ok {
 		return output, err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -26,7 +27,7 @@ func waitAccessPointCreated(ctx context.Context, conn *efs.EFS, accessPointId st
 		Timeout: accessPointCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.AccessPointDescription); ok {
 		return output, err
@@ -44,7 +45,7 @@ func waitAccessPointDeleted(ctx context.Context, conn *efs.EFS, accessPointId st
 		Timeout: accessPointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.AccessPointDescription); ok {
 		return output, err
@@ -61,7 +62,7 @@ func waitBackupPolicyDisabled(ctx context.Context, conn *efs.EFS, id string) (*e
 		Timeout: backupPolicyDisabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.BackupPolicy); ok {
 		return output, err
@@ -78,7 +79,7 @@ func waitBackupPolicyEnabled(ctx context.Context, conn *efs.EFS, id string) (*ef
 		Timeout: backupPolicyEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.BackupPolicy); ok {
 		return output, err
@@ -95,7 +96,7 @@ func waitReplicationConfigurationCreated(ctx context.Context, conn *efs.EFS, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.ReplicationConfigurationDescription); ok {
 		return output, err
@@ -113,7 +114,7 @@ func waitReplicationConfigurationDeleted(ctx context.Context, conn *efs.EFS, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.ReplicationConfigurationDescription); ok {
 		return output, err
@@ -0,0 +1,246 @@
+package eks
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_eks_access_entry")
+func ResourceAccessEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessEntryCreate,
+		ReadWithoutTimeout:   resourceAccessEntryRead,
+		UpdateWithoutTimeout: resourceAccessEntryUpdate,
+		DeleteWithoutTimeout: resourceAccessEntryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_entry_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubernetes_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      AccessEntryTypeStandard,
+				ValidateFunc: validation.StringInSlice(AccessEntryType_Values(), false),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	clusterName := d.Get("cluster_name").(string)
+	principalARN := d.Get("principal_arn").(string)
+	id := AccessEntryCreateResourceID(clusterName, principalARN)
+	input := &eks.CreateAccessEntryInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		PrincipalArn:       aws.String(principalARN),
+		Type:               aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("kubernetes_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.KubernetesGroups = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("user_name"); ok {
+		input.Username = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateAccessEntryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EKS Access Entry (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAccessEntryRead(ctx, d, meta)
+}
+
+func resourceAccessEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accessEntry, err := FindAccessEntryByClusterNameAndPrincipalARN(ctx, conn, clusterName, principalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Access Entry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EKS Access Entry (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_entry_arn", accessEntry.AccessEntryArn)
+	d.Set("cluster_name", accessEntry.ClusterName)
+	d.Set("created_at", aws.TimeValue(accessEntry.CreatedAt).Format(time.RFC3339))
+	d.Set("kubernetes_groups", aws.StringValueSlice(accessEntry.KubernetesGroups))
+	d.Set("modified_at", aws.TimeValue(accessEntry.ModifiedAt).Format(time.RFC3339))
+	d.Set("principal_arn", accessEntry.PrincipalArn)
+	d.Set("type", accessEntry.Type)
+	d.Set("user_name", accessEntry.Username)
+
+	tags := KeyValueTags(accessEntry.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAccessEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn()
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("kubernetes_groups", "user_name") {
+		input := &eks.UpdateAccessEntryInput{
+			ClientRequestToken: aws.String(resource.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			PrincipalArn:       aws.String(principalARN),
+		}
+
+		if d.HasChange("kubernetes_groups") {
+			input.KubernetesGroups = flex.ExpandStringSet(d.Get("kubernetes_groups").(*schema.Set))
+		}
+
+		if d.HasChange("user_name") {
+			input.Username = aws.String(d.Get("user_name").(string))
+		}
+
+		_, err := conn.UpdateAccessEntryWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Access Entry (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(ctx, conn, d.Get("access_entry_arn").(string), o, n); err != nil {
+			return diag.Errorf("updating tags: %s", err)
+		}
+	}
+
+	return resourceAccessEntryRead(ctx, d, meta)
+}
+
+func resourceAccessEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn()
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Access Entry: %s", d.Id())
+	_, err = conn.DeleteAccessEntryWithContext(ctx, &eks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EKS Access Entry (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return FindAccessEntryByClusterNameAndPrincipalARN(ctx, conn, clusterName, principalARN)
+	})
+
+	if err != nil {
+		return diag.Errorf("waiting for EKS Access Entry (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package eks
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_eks_access_policy_association")
+func ResourceAccessPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessPolicyAssociationCreate,
+		ReadWithoutTimeout:   resourceAccessPolicyAssociationRead,
+		DeleteWithoutTimeout: resourceAccessPolicyAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_scope": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespaces": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(eks.AccessScopeType_Values(), false),
+						},
+					},
+				},
+			},
+			"associated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAccessPolicyAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn()
+
+	clusterName := d.Get("cluster_name").(string)
+	principalARN := d.Get("principal_arn").(string)
+	policyARN := d.Get("policy_arn").(string)
+	id := AccessPolicyAssociationCreateResourceID(clusterName, principalARN, policyARN)
+	input := &eks.AssociateAccessPolicyInput{
+		AccessScope:  expandAccessScope(d.Get("access_scope").([]interface{})),
+		ClusterName:  aws.String(clusterName),
+		PolicyArn:    aws.String(policyARN),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	_, err := conn.AssociateAccessPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EKS Access Policy Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAccessPolicyAssociationRead(ctx, d, meta)
+}
+
+func resourceAccessPolicyAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn()
+
+	clusterName, principalARN, policyARN, err := AccessPolicyAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := FindAssociatedAccessPolicyByClusterNamePrincipalARNAndPolicyARN(ctx, conn, clusterName, principalARN, policyARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Access Policy Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EKS Access Policy Association (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("access_scope", flattenAccessScope(policy.AccessScope)); err != nil {
+		return diag.Errorf("setting access_scope: %s", err)
+	}
+	d.Set("associated_at", aws.TimeValue(policy.AssociatedAt).Format(time.RFC3339))
+	d.Set("cluster_name", clusterName)
+	d.Set("modified_at", aws.TimeValue(policy.ModifiedAt).Format(time.RFC3339))
+	d.Set("policy_arn", policy.PolicyArn)
+	d.Set("principal_arn", principalARN)
+
+	return nil
+}
+
+func resourceAccessPolicyAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn()
+
+	clusterName, principalARN, policyARN, err := AccessPolicyAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Access Policy Association: %s", d.Id())
+	_, err = conn.DisassociateAccessPolicyWithContext(ctx, &eks.DisassociateAccessPolicyInput{
+		ClusterName:  aws.String(clusterName),
+		PolicyArn:    aws.String(policyARN),
+		PrincipalArn: aws.String(principalARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EKS Access Policy Association (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return FindAssociatedAccessPolicyByClusterNamePrincipalARNAndPolicyARN(ctx, conn, clusterName, principalARN, policyARN)
+	})
+
+	if err != nil {
+		return diag.Errorf("waiting for EKS Access Policy Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAccessScope(tfList []interface{}) *eks.AccessScope {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &eks.AccessScope{}
+
+	if v, ok := tfMap["namespaces"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Namespaces = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccessScope(apiObject *eks.AccessScope) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"namespaces": aws.StringValueSlice(apiObject.Namespaces),
+		"type":       aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,200 @@
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEKSAccessPolicyAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy eks.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig_cluster(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.namespaces.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.type", eks.AccessScopeTypeCluster),
+					resource.TestCheckResourceAttrSet(resourceName, "associated_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "modified_at"),
+					resource.TestMatchResourceAttr(resourceName, "policy_arn", regexp.MustCompile(`:eks::aws:cluster-access-policy/AmazonEKSViewPolicy$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_eks_access_entry.test", "principal_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessPolicyAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy eks.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig_cluster(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfeks.ResourceAccessPolicyAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessPolicyAssociation_namespace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy eks.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig_namespace(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.namespaces.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_scope.0.namespaces.*", "default"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_scope.0.namespaces.*", "kube-system"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.type", eks.AccessScopeTypeNamespace),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessPolicyAssociationExists(ctx context.Context, resourceName string, policy *eks.AssociatedAccessPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Access Policy Association ID is set")
+		}
+
+		clusterName, principalARN, policyARN, err := tfeks.AccessPolicyAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn()
+
+		output, err := tfeks.FindAssociatedAccessPolicyByClusterNamePrincipalARNAndPolicyARN(ctx, conn, clusterName, principalARN, policyARN)
+
+		if err != nil {
+			return err
+		}
+
+		*policy = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAccessPolicyAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eks_access_policy_association" {
+				continue
+			}
+
+			clusterName, principalARN, policyARN, err := tfeks.AccessPolicyAssociationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfeks.FindAssociatedAccessPolicyByClusterNamePrincipalARNAndPolicyARN(ctx, conn, clusterName, principalARN, policyARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EKS Access Policy Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccessPolicyAssociationConfig_cluster(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_basic(rName), `
+resource "aws_eks_access_policy_association" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_eks_access_entry.test.principal_arn
+  policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+  access_scope {
+    type = "cluster"
+  }
+}
+`)
+}
+
+func testAccAccessPolicyAssociationConfig_namespace(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_basic(rName), `
+resource "aws_eks_access_policy_association" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_eks_access_entry.test.principal_arn
+  policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+  access_scope {
+    type       = "namespace"
+    namespaces = ["default", "kube-system"]
+  }
+}
+`)
+}
@@ -2,8 +2,10 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,8 +21,10 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
 )
 
+// @SDKResource("aws_eks_cluster")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -38,6 +42,13 @@ func ResourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			customdiff.ValidateChange("access_config.0.authentication_mode", func(_ context.Context, old, new, meta interface{}) error {
+				if !authenticationModeCanBeUpdated(old.(string), new.(string)) {
+					return fmt.Errorf("access_config.authentication_mode cannot be changed from %s to %s; the authentication mode can only be changed from %s", old, new, strings.Join(authenticationModes, " to "))
+				}
+
+				return nil
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -47,6 +58,31 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(eks.AuthenticationMode_Values(), false),
+						},
+						"bootstrap_cluster_creator_admin_permissions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+							// Only used on creation and not returned by the API.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return d.Id() != ""
+							},
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -292,6 +328,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	name := d.Get("name").(string)
 
 	input := &eks.CreateClusterInput{
+		AccessConfig:       expandCreateAccessConfigRequest(d.Get("access_config").([]interface{})),
 		EncryptionConfig:   expandEncryptionConfig(d.Get("encryption_config").([]interface{})),
 		Logging:            expandLogging(d.Get("enabled_cluster_log_types").(*schema.Set)),
 		Name:               aws.String(name),
@@ -378,6 +415,9 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("reading EKS Cluster (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("access_config", flattenAccessConfigResponse(cluster.AccessConfig, d.Get("access_config.0.bootstrap_cluster_creator_admin_permissions").(bool))); err != nil {
+		return diag.Errorf("setting access_config: %s", err)
+	}
 	d.Set("arn", cluster.Arn)
 	if err := d.Set("certificate_authority", flattenCertificate(cluster.CertificateAuthority)); err != nil {
 		return diag.Errorf("setting certificate_authority: %s", err)
@@ -451,6 +491,34 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("access_config.0.authentication_mode") {
+		o, n := d.GetChange("access_config.0.authentication_mode")
+
+		// Step through API_AND_CONFIG_MAP when moving from CONFIG_MAP to API.
+		for _, authenticationMode := range authenticationModeUpdatePath(o.(string), n.(string)) {
+			input := &eks.UpdateClusterConfigInput{
+				AccessConfig: &eks.UpdateAccessConfigRequest{
+					AuthenticationMode: aws.String(authenticationMode),
+				},
+				Name: aws.String(d.Id()),
+			}
+
+			output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating EKS Cluster (%s) authentication mode (%s): %s", d.Id(), authenticationMode, err)
+			}
+
+			updateID := aws.StringValue(output.Update.Id)
+
+			_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return diag.Errorf("waiting for EKS Cluster (%s) authentication mode update (%s): %s", d.Id(), updateID, err)
+			}
+		}
+	}
+
 	if d.HasChange("encryption_config") {
 		o, n := d.GetChange("encryption_config")
 
@@ -674,7 +742,7 @@ func waitClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -691,7 +759,7 @@ func waitClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -708,7 +776,7 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -721,6 +789,57 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id st
 	return nil, err
 }
 
+// authenticationModes lists the cluster authentication modes in the only order in which they can be updated.
+var authenticationModes = []string{
+	eks.AuthenticationModeConfigMap,
+	eks.AuthenticationModeApiAndConfigMap,
+	eks.AuthenticationModeApi,
+}
+
+func authenticationModeCanBeUpdated(old, new string) bool {
+	if old == "" || new == "" {
+		return true
+	}
+
+	return slices.Index(authenticationModes, old) <= slices.Index(authenticationModes, new)
+}
+
+// authenticationModeUpdatePath returns the sequence of authentication modes that a cluster is updated through
+// to move from the old to the new mode.
+func authenticationModeUpdatePath(old, new string) []string {
+	if new == "" || old == new {
+		return nil
+	}
+
+	i, j := slices.Index(authenticationModes, old), slices.Index(authenticationModes, new)
+
+	if i == -1 || j < i {
+		return []string{new}
+	}
+
+	return authenticationModes[i+1 : j+1]
+}
+
+func expandCreateAccessConfigRequest(tfList []interface{}) *eks.CreateAccessConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &eks.CreateAccessConfigRequest{}
+
+	if v, ok := tfMap["authentication_mode"].(string); ok && v != "" {
+		apiObject.AuthenticationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["bootstrap_cluster_creator_admin_permissions"].(bool); ok {
+		apiObject.BootstrapClusterCreatorAdminPermissions = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -890,6 +1009,25 @@ func expandLogging(vEnabledLogTypes *schema.Set) *eks.Logging {
 	}
 }
 
+// flattenAccessConfigResponse flattens the cluster's access configuration.
+// bootstrap_cluster_creator_admin_permissions is not returned by the API, so the configured value is kept.
+func flattenAccessConfigResponse(apiObject *eks.AccessConfigResponse, bootstrapClusterCreatorAdminPermissions bool) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authentication_mode":                         aws.StringValue(apiObject.AuthenticationMode),
+		"bootstrap_cluster_creator_admin_permissions": bootstrapClusterCreatorAdminPermissions,
+	}
+
+	if v := apiObject.BootstrapClusterCreatorAdminPermissions; v != nil {
+		tfMap["bootstrap_cluster_creator_admin_permissions"] = aws.BoolValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenCertificate(certificate *eks.Certificate) []map[string]interface{} {
 	if certificate == nil {
 		return []map[string]interface{}{}
@@ -4,6 +4,22 @@ import (
 	"time"
 )
 
+const (
+	AccessEntryTypeEC2Linux     = "EC2_LINUX"
+	AccessEntryTypeEC2Windows   = "EC2_WINDOWS"
+	AccessEntryTypeFargateLinux = "FARGATE_LINUX"
+	AccessEntryTypeStandard     = "STANDARD"
+)
+
+func AccessEntryType_Values() []string {
+	return []string{
+		AccessEntryTypeEC2Linux,
+		AccessEntryTypeEC2Windows,
+		AccessEntryTypeFargateLinux,
+		AccessEntryTypeStandard,
+	}
+}
+
 const (
 	IdentityProviderConfigTypeOIDC = "oidc"
 )
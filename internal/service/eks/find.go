@@ -9,6 +9,79 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func FindAccessEntryByClusterNameAndPrincipalARN(ctx context.Context, conn *eks.EKS, clusterName, principalARN string) (*eks.AccessEntry, error) {
+	input := &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	output, err := conn.DescribeAccessEntryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessEntry == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.AccessEntry, nil
+}
+
+func FindAssociatedAccessPolicyByClusterNamePrincipalARNAndPolicyARN(ctx context.Context, conn *eks.EKS, clusterName, principalARN, policyARN string) (*eks.AssociatedAccessPolicy, error) {
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+	var policy *eks.AssociatedAccessPolicy
+
+	err := conn.ListAssociatedAccessPoliciesPagesWithContext(ctx, input, func(page *eks.ListAssociatedAccessPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssociatedAccessPolicies {
+			if v != nil && aws.StringValue(v.PolicyArn) == policyARN {
+				policy = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return policy, nil
+}
+
 func FindAddonByClusterNameAndAddonName(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
 	input := &eks.DescribeAddonInput{
 		AddonName:   aws.String(addonName),
@@ -237,3 +310,32 @@ func FindOIDCIdentityProviderConfigByClusterNameAndConfigName(ctx context.Contex
 
 	return output.IdentityProviderConfig.Oidc, nil
 }
+
+func FindPodIdentityAssociationByClusterNameAndAssociationID(ctx context.Context, conn *eks.EKS, clusterName, associationID string) (*eks.PodIdentityAssociation, error) {
+	input := &eks.DescribePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	}
+
+	output, err := conn.DescribePodIdentityAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Association == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Association, nil
+}
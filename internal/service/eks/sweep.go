@@ -29,6 +29,7 @@ func init() {
 			"aws_eks_addon",
 			"aws_eks_fargate_profile",
 			"aws_eks_node_group",
+			"aws_eks_pod_identity_association",
 			"aws_emrcontainers_virtual_cluster",
 		},
 	})
@@ -47,6 +48,11 @@ func init() {
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
+
+	resource.AddTestSweepers("aws_eks_pod_identity_association", &resource.Sweeper{
+		Name: "aws_eks_pod_identity_association",
+		F:    sweepPodIdentityAssociations,
+	})
 }
 
 func sweepAddons(region string) error {
@@ -387,3 +393,75 @@ func sweepNodeGroups(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepPodIdentityAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).EKSConn()
+	input := &eks.ListClustersInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListClustersPagesWithContext(ctx, input, func(page *eks.ListClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, cluster := range page.Clusters {
+			input := &eks.ListPodIdentityAssociationsInput{
+				ClusterName: cluster,
+			}
+
+			err := conn.ListPodIdentityAssociationsPagesWithContext(ctx, input, func(page *eks.ListPodIdentityAssociationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, association := range page.Associations {
+					r := ResourcePodIdentityAssociation()
+					d := r.Data(nil)
+					d.SetId(PodIdentityAssociationCreateResourceID(aws.StringValue(cluster), aws.StringValue(association.AssociationId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if sweep.SkipSweepError(err) {
+				continue
+			}
+
+			if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EKS Pod Identity Associations (%s): %w", region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Print(fmt.Errorf("[WARN] Skipping EKS Pod Identity Associations sweep for %s: %w", region, err))
+		return sweeperErrs // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EKS Clusters (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EKS Pod Identity Associations (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
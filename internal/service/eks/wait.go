@@ -22,7 +22,7 @@ func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*eks.Addon); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusCreateFailed && health != nil {
@@ -43,7 +43,7 @@ func waitAddonDeleted(ctx context.Context, conn *eks.EKS, clusterName, addonName
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Addon); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusDeleteFailed && health != nil {
@@ -64,7 +64,7 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -85,7 +85,7 @@ func waitFargateProfileCreated(ctx context.Context, conn *eks.EKS, clusterName,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -102,7 +102,7 @@ func waitFargateProfileDeleted(ctx context.Context, conn *eks.EKS, clusterName,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -119,7 +119,7 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusCreateFailed && health != nil {
@@ -140,7 +140,7 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusDeleteFailed && health != nil {
@@ -161,7 +161,7 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterNa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -182,7 +182,7 @@ func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, c
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
 		return output, err
@@ -199,7 +199,7 @@ func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, c
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
 		return output, err
@@ -279,3 +279,32 @@ func FindCacheSubnetGroupByName(ctx context.Context, conn *elasticache.ElastiCac
 
 	return output.CacheSubnetGroups[0], nil
 }
+
+func FindServerlessCacheByName(ctx context.Context, conn *elasticache.ElastiCache, name string) (*elasticache.ServerlessCache, error) {
+	input := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: aws.String(name),
+	}
+
+	output, err := conn.DescribeServerlessCachesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ServerlessCaches) == 0 || output.ServerlessCaches[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ServerlessCaches); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ServerlessCaches[0], nil
+}
@@ -0,0 +1,555 @@
+package elasticache
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_elasticache_serverless_cache")
+func ResourceServerlessCache() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServerlessCacheCreate,
+		ReadWithoutTimeout:   resourceServerlessCacheRead,
+		UpdateWithoutTimeout: resourceServerlessCacheUpdate,
+		DeleteWithoutTimeout: resourceServerlessCacheDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_usage_limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_storage": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(elasticache.DataStorageUnit_Values(), false),
+									},
+								},
+							},
+						},
+						"ecpu_per_second": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1000, 15000000),
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1000, 15000000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"daily_snapshot_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"endpoint": serverlessCacheEndpointSchema(),
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{engineMemcached, engineRedis}, false),
+			},
+			"full_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reader_endpoint": serverlessCacheEndpointSchema(),
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"snapshot_arns_to_restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 35),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func serverlessCacheEndpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceServerlessCacheCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &elasticache.CreateServerlessCacheInput{
+		Engine:              aws.String(d.Get("engine").(string)),
+		ServerlessCacheName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("cache_usage_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CacheUsageLimits = expandCacheUsageLimits(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("daily_snapshot_time"); ok {
+		input.DailySnapshotTime = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_engine_version"); ok {
+		input.MajorEngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_arns_to_restore"); ok && len(v.([]interface{})) > 0 {
+		input.SnapshotArnsToRestore = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("user_group_id"); ok {
+		input.UserGroupId = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateServerlessCacheWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ElastiCache Serverless Cache (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitServerlessCacheAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceServerlessCacheRead(ctx, d, meta)...)
+}
+
+func resourceServerlessCacheRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cache, err := FindServerlessCacheByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Serverless Cache (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", cache.ARN)
+	if cache.CacheUsageLimits != nil {
+		if err := d.Set("cache_usage_limits", []interface{}{flattenCacheUsageLimits(cache.CacheUsageLimits)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cache_usage_limits: %s", err)
+		}
+	} else {
+		d.Set("cache_usage_limits", nil)
+	}
+	d.Set("create_time", aws.TimeValue(cache.CreateTime).Format(time.RFC3339))
+	d.Set("daily_snapshot_time", cache.DailySnapshotTime)
+	d.Set("description", cache.Description)
+	if err := d.Set("endpoint", flattenServerlessCacheEndpoint(cache.Endpoint)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint: %s", err)
+	}
+	d.Set("engine", cache.Engine)
+	d.Set("full_engine_version", cache.FullEngineVersion)
+	d.Set("kms_key_id", cache.KmsKeyId)
+	d.Set("major_engine_version", cache.MajorEngineVersion)
+	d.Set("name", cache.ServerlessCacheName)
+	if err := d.Set("reader_endpoint", flattenServerlessCacheEndpoint(cache.ReaderEndpoint)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reader_endpoint: %s", err)
+	}
+	d.Set("security_group_ids", aws.StringValueSlice(cache.SecurityGroupIds))
+	d.Set("snapshot_retention_limit", cache.SnapshotRetentionLimit)
+	d.Set("status", cache.Status)
+	d.Set("subnet_ids", aws.StringValueSlice(cache.SubnetIds))
+	d.Set("user_group_id", cache.UserGroupId)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(cache.ARN))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceServerlessCacheUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &elasticache.ModifyServerlessCacheInput{
+			ServerlessCacheName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("cache_usage_limits") {
+			if v, ok := d.GetOk("cache_usage_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CacheUsageLimits = expandCacheUsageLimits(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("daily_snapshot_time") {
+			input.DailySnapshotTime = aws.String(d.Get("daily_snapshot_time").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("security_group_ids") {
+			input.SecurityGroupIds = flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set))
+		}
+
+		if d.HasChange("snapshot_retention_limit") {
+			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		}
+
+		if d.HasChange("user_group_id") {
+			if v, ok := d.GetOk("user_group_id"); ok {
+				input.UserGroupId = aws.String(v.(string))
+			} else {
+				input.RemoveUserGroup = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.ModifyServerlessCacheWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitServerlessCacheAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ElastiCache Serverless Cache (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceServerlessCacheRead(ctx, d, meta)...)
+}
+
+func resourceServerlessCacheDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
+
+	log.Printf("[INFO] Deleting ElastiCache Serverless Cache: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 5*time.Minute, func() (interface{}, error) {
+		return conn.DeleteServerlessCacheWithContext(ctx, &elasticache.DeleteServerlessCacheInput{
+			ServerlessCacheName: aws.String(d.Id()),
+		})
+	}, elasticache.ErrCodeInvalidServerlessCacheStateFault)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitServerlessCacheDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandCacheUsageLimits(tfMap map[string]interface{}) *elasticache.CacheUsageLimits {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elasticache.CacheUsageLimits{}
+
+	if v, ok := tfMap["data_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataStorage = expandDataStorage(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ecpu_per_second"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ECPUPerSecond = expandECPUPerSecond(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDataStorage(tfMap map[string]interface{}) *elasticache.DataStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elasticache.DataStorage{}
+
+	if v, ok := tfMap["maximum"].(int); ok && v != 0 {
+		apiObject.Maximum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum"].(int); ok && v != 0 {
+		apiObject.Minimum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandECPUPerSecond(tfMap map[string]interface{}) *elasticache.ECPUPerSecond {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elasticache.ECPUPerSecond{}
+
+	if v, ok := tfMap["maximum"].(int); ok && v != 0 {
+		apiObject.Maximum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum"].(int); ok && v != 0 {
+		apiObject.Minimum = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenCacheUsageLimits(apiObject *elasticache.CacheUsageLimits) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataStorage; v != nil {
+		tfMap["data_storage"] = []interface{}{flattenDataStorage(v)}
+	}
+
+	if v := apiObject.ECPUPerSecond; v != nil {
+		tfMap["ecpu_per_second"] = []interface{}{flattenECPUPerSecond(v)}
+	}
+
+	return tfMap
+}
+
+func flattenDataStorage(apiObject *elasticache.DataStorage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Maximum; v != nil {
+		tfMap["maximum"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Minimum; v != nil {
+		tfMap["minimum"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Unit; v != nil {
+		tfMap["unit"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenECPUPerSecond(apiObject *elasticache.ECPUPerSecond) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Maximum; v != nil {
+		tfMap["maximum"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Minimum; v != nil {
+		tfMap["minimum"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenServerlessCacheEndpoint(apiObject *elasticache.Endpoint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"address": aws.StringValue(apiObject.Address),
+		"port":    aws.Int64Value(apiObject.Port),
+	}
+
+	return []interface{}{tfMap}
+}
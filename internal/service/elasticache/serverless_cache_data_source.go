@@ -0,0 +1,182 @@
+package elasticache
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_elasticache_serverless_cache")
+func DataSourceServerlessCache() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServerlessCacheRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_usage_limits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_storage": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"minimum": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ecpu_per_second": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"minimum": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"daily_snapshot_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": serverlessCacheEndpointSchema(),
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"full_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"reader_endpoint": serverlessCacheEndpointSchema(),
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"snapshot_retention_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"user_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServerlessCacheRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	cache, err := FindServerlessCacheByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(cache.ServerlessCacheName))
+	d.Set("arn", cache.ARN)
+	if cache.CacheUsageLimits != nil {
+		if err := d.Set("cache_usage_limits", []interface{}{flattenCacheUsageLimits(cache.CacheUsageLimits)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cache_usage_limits: %s", err)
+		}
+	} else {
+		d.Set("cache_usage_limits", nil)
+	}
+	d.Set("create_time", aws.TimeValue(cache.CreateTime).Format(time.RFC3339))
+	d.Set("daily_snapshot_time", cache.DailySnapshotTime)
+	d.Set("description", cache.Description)
+	if err := d.Set("endpoint", flattenServerlessCacheEndpoint(cache.Endpoint)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint: %s", err)
+	}
+	d.Set("engine", cache.Engine)
+	d.Set("full_engine_version", cache.FullEngineVersion)
+	d.Set("kms_key_id", cache.KmsKeyId)
+	d.Set("major_engine_version", cache.MajorEngineVersion)
+	d.Set("name", cache.ServerlessCacheName)
+	if err := d.Set("reader_endpoint", flattenServerlessCacheEndpoint(cache.ReaderEndpoint)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reader_endpoint: %s", err)
+	}
+	d.Set("security_group_ids", aws.StringValueSlice(cache.SecurityGroupIds))
+	d.Set("snapshot_retention_limit", cache.SnapshotRetentionLimit)
+	d.Set("status", cache.Status)
+	d.Set("subnet_ids", aws.StringValueSlice(cache.SubnetIds))
+	d.Set("user_group_id", cache.UserGroupId)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(cache.ARN))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
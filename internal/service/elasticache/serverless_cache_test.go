@@ -0,0 +1,393 @@
+package elasticache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccElastiCacheServerlessCache_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cache elasticache.ServerlessCache
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_basic(rName, "redis"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "elasticache", fmt.Sprintf("serverlesscache:%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint.0.address"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint.0.port"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "redis"),
+					resource.TestCheckResourceAttrSet(resourceName, "full_engine_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "major_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reader_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfelasticache.ServerlessCacheStatusAvailable),
+					resource.TestCheckResourceAttrSet(resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheServerlessCache_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cache elasticache.ServerlessCache
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_basic(rName, "redis"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelasticache.ResourceServerlessCache(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheServerlessCache_memcached(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cache elasticache.ServerlessCache
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_basic(rName, "memcached"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttr(resourceName, "engine", "memcached"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheServerlessCache_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cache elasticache.ServerlessCache
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_full(rName, "description 1", 10, 5000, "09:00", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.0.maximum", "10"),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.0.unit", elasticache.DataStorageUnitGb),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.ecpu_per_second.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.ecpu_per_second.0.maximum", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "daily_snapshot_time", "09:00"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServerlessCacheConfig_full(rName, "description 2", 20, 10000, "10:00", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.0.maximum", "20"),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.ecpu_per_second.0.maximum", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "daily_snapshot_time", "10:00"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheServerlessCache_userGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cache elasticache.ServerlessCache
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_userGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttrPair(resourceName, "user_group_id", "aws_elasticache_user_group.test", "user_group_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServerlessCacheConfig_basic(rName, "redis"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttr(resourceName, "user_group_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheServerlessCache_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cache elasticache.ServerlessCache
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServerlessCacheConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccServerlessCacheConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &cache),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServerlessCacheExists(ctx context.Context, resourceName string, v *elasticache.ServerlessCache) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache Serverless Cache ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn()
+
+		output, err := tfelasticache.FindServerlessCacheByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckServerlessCacheDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_elasticache_serverless_cache" {
+				continue
+			}
+
+			_, err := tfelasticache.FindServerlessCacheByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ElastiCache Serverless Cache %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccServerlessCacheConfig_basic(rName, engine string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine = %[2]q
+  name   = %[1]q
+}
+`, rName, engine)
+}
+
+func testAccServerlessCacheConfig_full(rName, description string, dataStorageMaximum, ecpuMaximum int, dailySnapshotTime string, snapshotRetentionLimit int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_elasticache_serverless_cache" "test" {
+  engine      = "redis"
+  name        = %[1]q
+  description = %[2]q
+
+  cache_usage_limits {
+    data_storage {
+      maximum = %[3]d
+      unit    = "GB"
+    }
+
+    ecpu_per_second {
+      maximum = %[4]d
+    }
+  }
+
+  daily_snapshot_time      = %[5]q
+  kms_key_id               = aws_kms_key.test.arn
+  major_engine_version     = "7"
+  security_group_ids       = [aws_security_group.test.id]
+  snapshot_retention_limit = %[6]d
+  subnet_ids               = aws_subnet.test[*].id
+}
+`, rName, description, dataStorageMaximum, ecpuMaximum, dailySnapshotTime, snapshotRetentionLimit))
+}
+
+func testAccServerlessCacheConfig_userGroup(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id       = %[1]q
+  user_name     = "default"
+  access_string = "on ~* +@all"
+  engine        = "REDIS"
+  passwords     = ["password123456789"]
+}
+
+resource "aws_elasticache_user_group" "test" {
+  engine        = "REDIS"
+  user_group_id = %[1]q
+  user_ids      = [aws_elasticache_user.test.user_id]
+}
+
+resource "aws_elasticache_serverless_cache" "test" {
+  engine        = "redis"
+  name          = %[1]q
+  user_group_id = aws_elasticache_user_group.test.user_group_id
+}
+`, rName)
+}
+
+func testAccServerlessCacheConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine = "redis"
+  name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccServerlessCacheConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine = "redis"
+  name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
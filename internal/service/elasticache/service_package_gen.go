@@ -11,72 +11,104 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_elasticache_cluster",
+			Factory:  DataSourceCluster,
+		},
+		{
+			TypeName: "aws_elasticache_replication_group",
+			Factory:  DataSourceReplicationGroup,
+		},
+		{
+			TypeName: "aws_elasticache_serverless_cache",
+			Factory:  DataSourceServerlessCache,
+		},
+		{
+			TypeName: "aws_elasticache_subnet_group",
+			Factory:  DataSourceSubnetGroup,
+		},
+		{
+			TypeName: "aws_elasticache_user",
+			Factory:  DataSourceUser,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "elasticache"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_elasticache_cluster",
+			Factory:  ResourceCluster,
+		},
+		{
+			TypeName: "aws_elasticache_global_replication_group",
+			Factory:  ResourceGlobalReplicationGroup,
+		},
+		{
+			TypeName: "aws_elasticache_parameter_group",
+			Factory:  ResourceParameterGroup,
+		},
+		{
+			TypeName: "aws_elasticache_replication_group",
+			Factory:  ResourceReplicationGroup,
+		},
+		{
+			TypeName: "aws_elasticache_security_group",
+			Factory:  ResourceSecurityGroup,
+		},
+		{
+			TypeName: "aws_elasticache_serverless_cache",
+			Factory:  ResourceServerlessCache,
+		},
+		{
+			TypeName: "aws_elasticache_subnet_group",
+			Factory:  ResourceSubnetGroup,
+		},
+		{
+			TypeName: "aws_elasticache_user",
+			Factory:  ResourceUser,
+		},
+		{
+			TypeName: "aws_elasticache_user_group",
+			Factory:  ResourceUserGroup,
+		},
+		{
+			TypeName: "aws_elasticache_user_group_association",
+			Factory:  ResourceUserGroupAssociation,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "elasticache"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
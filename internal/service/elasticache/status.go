@@ -147,3 +147,28 @@ func StatusUser(ctx context.Context, conn *elasticache.ElastiCache, userId strin
 		return user, aws.StringValue(user.Status), nil
 	}
 }
+
+const (
+	ServerlessCacheStatusAvailable    = "available"
+	ServerlessCacheStatusCreating     = "creating"
+	ServerlessCacheStatusCreateFailed = "create-failed"
+	ServerlessCacheStatusDeleting     = "deleting"
+	ServerlessCacheStatusModifying    = "modifying"
+)
+
+// statusServerlessCache fetches the Serverless Cache and its Status
+func statusServerlessCache(ctx context.Context, conn *elasticache.ElastiCache, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cache, err := FindServerlessCacheByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cache, aws.StringValue(cache.Status), nil
+	}
+}
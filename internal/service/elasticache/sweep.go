@@ -64,12 +64,18 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
+		Name: "aws_elasticache_serverless_cache",
+		F:    sweepServerlessCaches,
+	})
+
 	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
 			"aws_elasticache_cluster",
 			"aws_elasticache_replication_group",
+			"aws_elasticache_serverless_cache",
 		},
 	})
 }
@@ -312,6 +318,50 @@ func sweepCacheSecurityGroups(region string) error {
 	return nil
 }
 
+func sweepServerlessCaches(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).ElastiCacheConn()
+	input := &elasticache.DescribeServerlessCachesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeServerlessCachesPagesWithContext(ctx, input, func(page *elasticache.DescribeServerlessCachesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServerlessCaches {
+			r := ResourceServerlessCache()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ServerlessCacheName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ElastiCache Serverless Cache sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing ElastiCache Serverless Caches (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Serverless Caches (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepSubnetGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
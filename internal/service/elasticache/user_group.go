@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_elasticache_user_group")
 func ResourceUserGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserGroupCreate,
@@ -111,7 +112,7 @@ func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	log.Printf("[INFO] Waiting for ElastiCache User Group (%s) to be available", d.Id())
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating ElastiCache User Group: %s", err)
 	}
@@ -222,7 +223,7 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			}
 
 			log.Printf("[INFO] Waiting for ElastiCache User Group (%s) to be available", d.Id())
-			_, err = stateConf.WaitForStateContext(ctx)
+			_, err = tfresource.WaitForStateContext(ctx, stateConf)
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating ElastiCache User Group (%q): %s", d.Id(), err)
 			}
@@ -269,7 +270,7 @@ func resourceUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	log.Printf("[INFO] Waiting for ElastiCache User Group (%s) to be available", d.Id())
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserGroupNotFoundFault) || tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidUserGroupStateFault) {
 			return diags
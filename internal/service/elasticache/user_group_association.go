@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_elasticache_user_group_association")
 func ResourceUserGroupAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserGroupAssociationCreate,
@@ -76,7 +77,7 @@ func resourceUserGroupAssociationCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	log.Printf("[INFO] Waiting for ElastiCache User Group (%s) to be available", d.Id())
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating ElastiCache User Group Association (%q): %s", d.Id(), err)
 	}
@@ -155,7 +156,7 @@ func resourceUserGroupAssociationDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	log.Printf("[INFO] Waiting for ElastiCache User Group (%s) to be available", d.Id())
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache User Group Association delete (%q): %s", d.Id(), err)
 	}
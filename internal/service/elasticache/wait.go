@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -38,7 +39,7 @@ func WaitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Elasti
 		Delay:      replicationGroupAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
 		return v, err
 	}
@@ -60,7 +61,7 @@ func WaitReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCa
 		Delay:      replicationGroupDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
 		return v, err
 	}
@@ -82,7 +83,7 @@ func WaitReplicationGroupMemberClustersAvailable(ctx context.Context, conn *elas
 		Delay:      cacheClusterAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.([]*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -117,7 +118,7 @@ func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.ElastiCach
 		Delay:      cacheClusterAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -143,7 +144,7 @@ func WaitCacheClusterDeleted(ctx context.Context, conn *elasticache.ElastiCache,
 		Delay:      cacheClusterDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -174,7 +175,7 @@ func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.
 		Delay:      globalReplicationGroupAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return v, err
 	}
@@ -197,7 +198,7 @@ func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.El
 		Delay:      globalReplicationGroupDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return v, err
 	}
@@ -228,7 +229,7 @@ func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elastic
 		Delay:      globalReplicationGroupDisassociationDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
 		return v, err
 	}
@@ -244,7 +245,7 @@ func WaitUserActive(ctx context.Context, conn *elasticache.ElastiCache, userId s
 		Timeout: UserActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -258,7 +259,57 @@ func WaitUserDeleted(ctx context.Context, conn *elasticache.ElastiCache, userId
 		Timeout: UserDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
+
+const (
+	serverlessCacheAvailableMinTimeout = 10 * time.Second
+	serverlessCacheAvailableDelay      = 30 * time.Second
+
+	serverlessCacheDeletedMinTimeout = 10 * time.Second
+	serverlessCacheDeletedDelay      = 30 * time.Second
+)
+
+// waitServerlessCacheAvailable waits for a Serverless Cache to return Available
+func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ServerlessCacheStatusCreating, ServerlessCacheStatusModifying},
+		Target:     []string{ServerlessCacheStatusAvailable},
+		Refresh:    statusServerlessCache(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: serverlessCacheAvailableMinTimeout,
+		Delay:      serverlessCacheAvailableDelay,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// waitServerlessCacheDeleted waits for a Serverless Cache to be deleted
+func waitServerlessCacheDeleted(ctx context.Context, conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ServerlessCacheStatusAvailable,
+			ServerlessCacheStatusCreating,
+			ServerlessCacheStatusCreateFailed,
+			ServerlessCacheStatusDeleting,
+			ServerlessCacheStatusModifying,
+		},
+		Target:     []string{},
+		Refresh:    statusServerlessCache(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: serverlessCacheDeletedMinTimeout,
+		Delay:      serverlessCacheDeletedDelay,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
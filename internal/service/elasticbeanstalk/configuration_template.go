@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_elastic_beanstalk_configuration_template")
 func ResourceConfigurationTemplate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConfigurationTemplateCreate,
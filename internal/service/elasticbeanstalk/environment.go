@@ -73,6 +73,7 @@ var (
 	environmentCNAMERegex = regexp.MustCompile(`(^[^.]+)(.\w{2}-\w{4,9}-\d)?\.(elasticbeanstalk\.com|eb\.amazonaws\.com\.cn)$`)
 )
 
+// @SDKResource("aws_elastic_beanstalk_environment")
 func ResourceEnvironment() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -775,7 +776,7 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.ElasticBea
 		MinTimeout:   3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticbeanstalk.EnvironmentDescription); ok {
 		return output, err
@@ -795,7 +796,7 @@ func waitEnvironmentDeleted(ctx context.Context, conn *elasticbeanstalk.ElasticB
 		MinTimeout:   3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticbeanstalk.EnvironmentDescription); ok {
 		return output, err
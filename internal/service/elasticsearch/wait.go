@@ -27,7 +27,7 @@ func waitUpgradeSucceeded(ctx context.Context, conn *elasticsearch.Elasticsearch
 		Delay:      domainUpgradeSuccessDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticsearch.GetUpgradeStatusOutput); ok {
 		return output, err
@@ -139,7 +139,7 @@ func waitForDomainDelete(ctx context.Context, conn *elasticsearch.ElasticsearchS
 		ContinuousTargetOccurence: 3,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
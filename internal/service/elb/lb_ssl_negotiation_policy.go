@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_lb_ssl_negotiation_policy")
 func ResourceSSLNegotiationPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSSLNegotiationPolicyCreate,
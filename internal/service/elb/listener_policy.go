@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_load_balancer_listener_policy")
 func ResourceListenerPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceListenerPolicySet,
@@ -34,6 +34,8 @@ const (
 	loadBalancerTagPropagationTimeout = 2 * time.Minute
 )
 
+// @SDKResource("aws_alb")
+// @SDKResource("aws_lb")
 func ResourceLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoadBalancerCreate,
@@ -788,7 +790,7 @@ func waitLoadBalancerActive(ctx context.Context, conn *elbv2.ELBV2, arn string,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elbv2.LoadBalancer); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.State.Reason)))
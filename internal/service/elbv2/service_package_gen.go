@@ -11,72 +11,124 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_alb",
+			Factory:  DataSourceLoadBalancer,
+		},
+		{
+			TypeName: "aws_alb_listener",
+			Factory:  DataSourceListener,
+		},
+		{
+			TypeName: "aws_alb_target_group",
+			Factory:  DataSourceTargetGroup,
+		},
+		{
+			TypeName: "aws_lb",
+			Factory:  DataSourceLoadBalancer,
+		},
+		{
+			TypeName: "aws_lb_hosted_zone_id",
+			Factory:  DataSourceHostedZoneID,
+		},
+		{
+			TypeName: "aws_lb_listener",
+			Factory:  DataSourceListener,
+		},
+		{
+			TypeName: "aws_lb_target_group",
+			Factory:  DataSourceTargetGroup,
+		},
+		{
+			TypeName: "aws_lbs",
+			Factory:  DataSourceLoadBalancers,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "elbv2"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_alb",
+			Factory:  ResourceLoadBalancer,
+		},
+		{
+			TypeName: "aws_alb_listener",
+			Factory:  ResourceListener,
+		},
+		{
+			TypeName: "aws_alb_listener_certificate",
+			Factory:  ResourceListenerCertificate,
+		},
+		{
+			TypeName: "aws_alb_listener_rule",
+			Factory:  ResourceListenerRule,
+		},
+		{
+			TypeName: "aws_alb_target_group",
+			Factory:  ResourceTargetGroup,
+		},
+		{
+			TypeName: "aws_alb_target_group_attachment",
+			Factory:  ResourceTargetGroupAttachment,
+		},
+		{
+			TypeName: "aws_lb",
+			Factory:  ResourceLoadBalancer,
+		},
+		{
+			TypeName: "aws_lb_listener",
+			Factory:  ResourceListener,
+		},
+		{
+			TypeName: "aws_lb_listener_certificate",
+			Factory:  ResourceListenerCertificate,
+		},
+		{
+			TypeName: "aws_lb_listener_rule",
+			Factory:  ResourceListenerRule,
+		},
+		{
+			TypeName: "aws_lb_target_group",
+			Factory:  ResourceTargetGroup,
+		},
+		{
+			TypeName: "aws_lb_target_group_attachment",
+			Factory:  ResourceTargetGroupAttachment,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "elbv2"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -31,6 +31,7 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// @SDKResource("aws_emr_cluster")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -1330,7 +1331,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Delay:   10 * time.Second,
 		}
 
-		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Group (%s) modification: %s", d.Id(), instanceGroupID, err)
 		}
 	}
@@ -27,6 +27,7 @@ const (
 	instanceGroupUpdateTimeout = 30 * time.Minute
 )
 
+// @SDKResource("aws_emr_instance_group")
 func ResourceInstanceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceGroupCreate,
@@ -452,7 +453,7 @@ func waitForInstanceGroupStateRunning(ctx context.Context, conn *emr.EMR, cluste
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
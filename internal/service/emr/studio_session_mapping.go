@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_emr_studio_session_mapping")
 func ResourceStudioSessionMapping() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStudioSessionMappingCreate,
@@ -31,7 +31,7 @@ func waitClusterCreated(ctx context.Context, conn *emr.EMR, id string) (*emr.Clu
 		Delay:      ClusterCreatedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*emr.Cluster); ok {
 		if stateChangeReason := output.Status.StateChangeReason; stateChangeReason != nil {
@@ -54,7 +54,7 @@ func waitClusterDeleted(ctx context.Context, conn *emr.EMR, id string) (*emr.Clu
 		Delay:      ClusterDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*emr.Cluster); ok {
 		if stateChangeReason := output.Status.StateChangeReason; stateChangeReason != nil {
@@ -31,7 +31,7 @@ func waitApplicationCreated(ctx context.Context, conn *emrserverless.EMRServerle
 		Delay:      ApplicationCreatedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*emrserverless.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
@@ -54,7 +54,7 @@ func waitApplicationTerminated(ctx context.Context, conn *emrserverless.EMRServe
 		Delay:      ApplicationDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*emrserverless.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_cloudwatch_event_api_destination")
 func ResourceAPIDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAPIDestinationCreate,
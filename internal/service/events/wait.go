@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,7 +23,7 @@ func waitConnectionCreated(ctx context.Context, conn *eventbridge.EventBridge, i
 		Timeout: connectionCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		return v, err
@@ -39,7 +40,7 @@ func waitConnectionDeleted(ctx context.Context, conn *eventbridge.EventBridge, i
 		Timeout: connectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		return v, err
@@ -56,7 +57,7 @@ func waitConnectionUpdated(ctx context.Context, conn *eventbridge.EventBridge, i
 		Timeout: connectionUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		return v, err
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_evidently_segment")
 func ResourceSegment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSegmentCreate,
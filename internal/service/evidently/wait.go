@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitFeatureCreated(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, id string, timeout time.Duration) (*cloudwatchevidently.Feature, error) {
@@ -16,7 +17,7 @@ func waitFeatureCreated(ctx context.Context, conn *cloudwatchevidently.CloudWatc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
 		return output, err
@@ -33,7 +34,7 @@ func waitFeatureUpdated(ctx context.Context, conn *cloudwatchevidently.CloudWatc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
 		return output, err
@@ -50,7 +51,7 @@ func waitFeatureDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
 		return output, err
@@ -67,7 +68,7 @@ func waitLaunchCreated(ctx context.Context, conn *cloudwatchevidently.CloudWatch
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(context.Background())
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Launch); ok {
 		return output, err
@@ -84,7 +85,7 @@ func waitLaunchUpdated(ctx context.Context, conn *cloudwatchevidently.CloudWatch
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(context.Background())
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Launch); ok {
 		return output, err
@@ -101,7 +102,7 @@ func waitLaunchDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatch
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(context.Background())
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Launch); ok {
 		return output, err
@@ -118,7 +119,7 @@ func waitProjectCreated(ctx context.Context, conn *cloudwatchevidently.CloudWatc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Project); ok {
 		return output, err
@@ -135,7 +136,7 @@ func waitProjectUpdated(ctx context.Context, conn *cloudwatchevidently.CloudWatc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Project); ok {
 		return output, err
@@ -152,7 +153,7 @@ func waitProjectDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatchevidently.Project); ok {
 		return output, err
@@ -19,7 +19,7 @@ func waitDeliveryStreamCreated(ctx context.Context, conn *firehose.Firehose, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamDescription); ok {
 		if status, failureDescription := aws.StringValue(output.DeliveryStreamStatus), output.FailureDescription; status == firehose.DeliveryStreamStatusCreatingFailed && failureDescription != nil {
@@ -40,7 +40,7 @@ func waitDeliveryStreamDeleted(ctx context.Context, conn *firehose.Firehose, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamDescription); ok {
 		if status, failureDescription := aws.StringValue(output.DeliveryStreamStatus), output.FailureDescription; status == firehose.DeliveryStreamStatusDeletingFailed && failureDescription != nil {
@@ -61,7 +61,7 @@ func waitDeliveryStreamEncryptionEnabled(ctx context.Context, conn *firehose.Fir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamEncryptionConfiguration); ok {
 		if status, failureDescription := aws.StringValue(output.Status), output.FailureDescription; status == firehose.DeliveryStreamEncryptionStatusEnablingFailed && failureDescription != nil {
@@ -82,7 +82,7 @@ func waitDeliveryStreamEncryptionDisabled(ctx context.Context, conn *firehose.Fi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamEncryptionConfiguration); ok {
 		if status, failureDescription := aws.StringValue(output.Status), output.FailureDescription; status == firehose.DeliveryStreamEncryptionStatusDisablingFailed && failureDescription != nil {
@@ -25,7 +25,7 @@ func waitAdministrativeActionCompleted(ctx context.Context, conn *fsx.FSx, fsID,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.AdministrativeAction); ok {
 		if status, details := aws.StringValue(output.Status), output.FailureDetails; status == fsx.StatusFailed && details != nil {
@@ -46,7 +46,7 @@ func waitBackupAvailable(ctx context.Context, conn *fsx.FSx, id string) (*fsx.Ba
 		Timeout: backupAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -63,7 +63,7 @@ func waitBackupDeleted(ctx context.Context, conn *fsx.FSx, id string) (*fsx.Back
 		Timeout: backupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -81,7 +81,7 @@ func waitFileCacheCreated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileCache); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileCacheLifecycleFailed && details != nil {
@@ -101,7 +101,7 @@ func waitFileCacheUpdated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileCache); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileCacheLifecycleFailed && details != nil {
@@ -122,7 +122,7 @@ func waitFileCacheDeleted(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileCache); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileCacheLifecycleFailed && details != nil {
@@ -143,7 +143,7 @@ func waitFileSystemCreated(ctx context.Context, conn *fsx.FSx, id string, timeou
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileSystem); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -165,7 +165,7 @@ func waitFileSystemUpdated(ctx context.Context, conn *fsx.FSx, id string, timeou
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileSystem); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -187,7 +187,7 @@ func waitFileSystemDeleted(ctx context.Context, conn *fsx.FSx, id string, timeou
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileSystem); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -209,7 +209,7 @@ func waitDataRepositoryAssociationCreated(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -231,7 +231,7 @@ func waitDataRepositoryAssociationUpdated(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -253,7 +253,7 @@ func waitDataRepositoryAssociationDeleted(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -275,7 +275,7 @@ func waitStorageVirtualMachineCreated(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -297,7 +297,7 @@ func waitStorageVirtualMachineUpdated(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -319,7 +319,7 @@ func waitStorageVirtualMachineDeleted(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.StorageVirtualMachineLifecycleFailed && details != nil {
@@ -341,7 +341,7 @@ func waitVolumeCreated(ctx context.Context, conn *fsx.FSx, id string, timeout ti
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Volume); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.VolumeLifecycleFailed && details != nil {
@@ -363,7 +363,7 @@ func waitVolumeUpdated(ctx context.Context, conn *fsx.FSx, id string, timeout ti
 		Delay:   150 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Volume); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.VolumeLifecycleFailed && details != nil {
@@ -385,7 +385,7 @@ func waitVolumeDeleted(ctx context.Context, conn *fsx.FSx, id string, timeout ti
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Volume); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.VolumeLifecycleFailed && details != nil {
@@ -407,7 +407,7 @@ func waitSnapshotCreated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		return output, err
@@ -425,7 +425,7 @@ func waitSnapshotUpdated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   150 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		return output, err
@@ -443,7 +443,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		return output, err
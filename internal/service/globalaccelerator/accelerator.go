@@ -25,6 +25,7 @@ import (
 // https://docs.aws.amazon.com/Route53/latest/APIReference/API_AliasTarget.html).
 const route53ZoneID = "Z2BJ6XQ5FK7U4H"
 
+// @SDKResource("aws_globalaccelerator_accelerator")
 func ResourceAccelerator() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAcceleratorCreate,
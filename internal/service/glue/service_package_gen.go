@@ -11,72 +11,132 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_glue_catalog_table",
+			Factory:  DataSourceCatalogTable,
+		},
+		{
+			TypeName: "aws_glue_connection",
+			Factory:  DataSourceConnection,
+		},
+		{
+			TypeName: "aws_glue_data_catalog_encryption_settings",
+			Factory:  DataSourceDataCatalogEncryptionSettings,
+		},
+		{
+			TypeName: "aws_glue_script",
+			Factory:  DataSourceScript,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "glue"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_glue_catalog_database",
+			Factory:  ResourceCatalogDatabase,
+		},
+		{
+			TypeName: "aws_glue_catalog_table",
+			Factory:  ResourceCatalogTable,
+		},
+		{
+			TypeName: "aws_glue_classifier",
+			Factory:  ResourceClassifier,
+		},
+		{
+			TypeName: "aws_glue_connection",
+			Factory:  ResourceConnection,
+		},
+		{
+			TypeName: "aws_glue_crawler",
+			Factory:  ResourceCrawler,
+		},
+		{
+			TypeName: "aws_glue_data_catalog_encryption_settings",
+			Factory:  ResourceDataCatalogEncryptionSettings,
+		},
+		{
+			TypeName: "aws_glue_dev_endpoint",
+			Factory:  ResourceDevEndpoint,
+		},
+		{
+			TypeName: "aws_glue_job",
+			Factory:  ResourceJob,
+		},
+		{
+			TypeName: "aws_glue_ml_transform",
+			Factory:  ResourceMLTransform,
+		},
+		{
+			TypeName: "aws_glue_partition",
+			Factory:  ResourcePartition,
+		},
+		{
+			TypeName: "aws_glue_partition_index",
+			Factory:  ResourcePartitionIndex,
+		},
+		{
+			TypeName: "aws_glue_registry",
+			Factory:  ResourceRegistry,
+		},
+		{
+			TypeName: "aws_glue_resource_policy",
+			Factory:  ResourceResourcePolicy,
+		},
+		{
+			TypeName: "aws_glue_schema",
+			Factory:  ResourceSchema,
+		},
+		{
+			TypeName: "aws_glue_security_configuration",
+			Factory:  ResourceSecurityConfiguration,
+		},
+		{
+			TypeName: "aws_glue_trigger",
+			Factory:  ResourceTrigger,
+		},
+		{
+			TypeName: "aws_glue_user_defined_function",
+			Factory:  ResourceUserDefinedFunction,
+		},
+		{
+			TypeName: "aws_glue_workflow",
+			Factory:  ResourceWorkflow,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "glue"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
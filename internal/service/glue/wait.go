@@ -31,7 +31,7 @@ func waitMLTransformDeleted(ctx context.Context, conn *glue.Glue, transformId st
 		Timeout: mlTransformDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetMLTransformOutput); ok {
 		return output, err
@@ -49,7 +49,7 @@ func waitRegistryDeleted(ctx context.Context, conn *glue.Glue, registryID string
 		Timeout: registryDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetRegistryOutput); ok {
 		return output, err
@@ -67,7 +67,7 @@ func waitSchemaAvailable(ctx context.Context, conn *glue.Glue, registryID string
 		Timeout: schemaAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -85,7 +85,7 @@ func waitSchemaDeleted(ctx context.Context, conn *glue.Glue, registryID string)
 		Timeout: schemaDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -103,7 +103,7 @@ func waitSchemaVersionAvailable(ctx context.Context, conn *glue.Glue, registryID
 		Timeout: schemaVersionAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaVersionOutput); ok {
 		return output, err
@@ -128,7 +128,7 @@ func waitTriggerCreated(ctx context.Context, conn *glue.Glue, triggerName string
 		Timeout: triggerCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -146,7 +146,7 @@ func waitTriggerDeleted(ctx context.Context, conn *glue.Glue, triggerName string
 		Timeout: triggerDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -163,7 +163,7 @@ func waitDevEndpointCreated(ctx context.Context, conn *glue.Glue, name string) (
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -184,7 +184,7 @@ func waitDevEndpointDeleted(ctx context.Context, conn *glue.Glue, name string) (
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -205,7 +205,7 @@ func waitPartitionIndexCreated(ctx context.Context, conn *glue.Glue, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
@@ -222,7 +222,7 @@ func waitPartitionIndexDeleted(ctx context.Context, conn *glue.Glue, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
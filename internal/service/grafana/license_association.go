@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_grafana_license_association")
 func ResourceLicenseAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLicenseAssociationCreate,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitWorkspaceCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
@@ -16,7 +17,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *managedgrafana.ManagedGrafa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -33,7 +34,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *managedgrafana.ManagedGrafa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -50,7 +51,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *managedgrafana.ManagedGrafa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -67,7 +68,7 @@ func waitLicenseAssociationCreated(ctx context.Context, conn *managedgrafana.Man
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -84,7 +85,7 @@ func waitWorkspaceSAMLConfigurationCreated(ctx context.Context, conn *managedgra
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.SamlAuthentication); ok {
 		return output, err
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_guardduty_detector")
 func ResourceDetector() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDetectorCreate,
@@ -127,6 +128,40 @@ func ResourceDetector() *schema.Resource {
 				Default:  true,
 			},
 
+			// features is read-only; individual features are managed with aws_guardduty_detector_feature.
+			"features": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			// finding_publishing_frequency is marked as Computed:true since
 			// GuardDuty member accounts inherit setting from master account
 			"finding_publishing_frequency": {
@@ -216,6 +251,9 @@ func resourceDetectorRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.Set("enable", aws.StringValue(gdo.Status) == guardduty.DetectorStatusEnabled)
+	if err := d.Set("features", flattenDetectorFeatureConfigurationResults(gdo.Features)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting features: %s", err)
+	}
 	d.Set("finding_publishing_frequency", gdo.FindingPublishingFrequency)
 
 	tags := KeyValueTags(gdo.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -525,3 +563,25 @@ func flattenMalwareProtectionEBSVolumesConfigurationResult(apiObject *guardduty.
 
 	return tfMap
 }
+
+func flattenDetectorFeatureConfigurationResults(apiObjects []*guardduty.DetectorFeatureConfigurationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"additional_configuration": flattenDetectorAdditionalConfigurationResults(apiObject.AdditionalConfiguration),
+			"name":                     aws.StringValue(apiObject.Name),
+			"status":                   aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
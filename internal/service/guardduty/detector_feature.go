@@ -0,0 +1,192 @@
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_guardduty_detector_feature")
+func ResourceDetectorFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorFeaturePut,
+		ReadWithoutTimeout:   resourceDetectorFeatureRead,
+		UpdateWithoutTimeout: resourceDetectorFeaturePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.DetectorFeature_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDetectorFeaturePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn()
+
+	detectorID, name := d.Get("detector_id").(string), d.Get("name").(string)
+	feature := &guardduty.DetectorFeatureConfiguration{
+		Name:   aws.String(name),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		feature.AdditionalConfiguration = expandDetectorAdditionalConfigurations(v.([]interface{}))
+	}
+
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features:   []*guardduty.DetectorFeatureConfiguration{feature},
+	}
+
+	_, err := conn.UpdateDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Detector (%s) Feature (%s): %s", detectorID, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(DetectorFeatureCreateResourceID(detectorID, name))
+	}
+
+	return append(diags, resourceDetectorFeatureRead(ctx, d, meta)...)
+}
+
+func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn()
+
+	detectorID, name, err := DetectorFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	feature, err := FindDetectorFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Detector Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
+	}
+	d.Set("detector_id", detectorID)
+	d.Set("name", feature.Name)
+	d.Set("status", feature.Status)
+
+	return diags
+}
+
+const detectorFeatureResourceIDSeparator = ":"
+
+func DetectorFeatureCreateResourceID(detectorID, name string) string {
+	parts := []string{detectorID, name}
+	id := strings.Join(parts, detectorFeatureResourceIDSeparator)
+
+	return id
+}
+
+func DetectorFeatureParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, detectorFeatureResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTORID%[2]sFEATURENAME", id, detectorFeatureResourceIDSeparator)
+}
+
+func expandDetectorAdditionalConfigurations(tfList []interface{}) []*guardduty.DetectorAdditionalConfiguration {
+	var apiObjects []*guardduty.DetectorAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &guardduty.DetectorAdditionalConfiguration{
+			Name:   aws.String(tfMap["name"].(string)),
+			Status: aws.String(tfMap["status"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorAdditionalConfigurationResults(apiObjects []*guardduty.DetectorAdditionalConfigurationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"status": aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
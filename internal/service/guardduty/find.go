@@ -0,0 +1,107 @@
+package guardduty
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDetectorByID(ctx context.Context, conn *guardduty.GuardDuty, id string) (*guardduty.GetDetectorOutput, error) {
+	input := &guardduty.GetDetectorInput{
+		DetectorId: aws.String(id),
+	}
+
+	output, err := conn.GetDetectorWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDetectorFeatureByTwoPartKey(ctx context.Context, conn *guardduty.GuardDuty, detectorID, name string) (*guardduty.DetectorFeatureConfigurationResult, error) {
+	output, err := FindDetectorByID(ctx, conn, detectorID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feature := range output.Features {
+		if aws.StringValue(feature.Name) == name {
+			return feature, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(name)
+}
+
+func FindOrganizationConfigurationByID(ctx context.Context, conn *guardduty.GuardDuty, detectorID string) (*guardduty.DescribeOrganizationConfigurationOutput, error) {
+	input := &guardduty.DescribeOrganizationConfigurationInput{
+		DetectorId: aws.String(detectorID),
+	}
+	var output *guardduty.DescribeOrganizationConfigurationOutput
+
+	err := conn.DescribeOrganizationConfigurationPagesWithContext(ctx, input, func(page *guardduty.DescribeOrganizationConfigurationOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		// Only the features are paginated; the remaining fields are the same on every page.
+		if output == nil {
+			output = page
+		} else {
+			output.Features = append(output.Features, page.Features...)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindOrganizationFeatureByTwoPartKey(ctx context.Context, conn *guardduty.GuardDuty, detectorID, name string) (*guardduty.OrganizationFeatureConfigurationResult, error) {
+	output, err := FindOrganizationConfigurationByID(ctx, conn, detectorID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feature := range output.Features {
+		if aws.StringValue(feature.Name) == name {
+			return feature, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(name)
+}
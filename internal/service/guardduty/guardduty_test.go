@@ -21,6 +21,11 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"datasource_basic":                  testAccDetectorDataSource_basic,
 			"datasource_id":                     testAccDetectorDataSource_ID,
 		},
+		"DetectorFeature": {
+			"basic":                   testAccDetectorFeature_basic,
+			"additionalConfiguration": testAccDetectorFeature_additionalConfiguration,
+			"multiple":                testAccDetectorFeature_multiple,
+		},
 		"Filter": {
 			"basic":      testAccFilter_basic,
 			"update":     testAccFilter_update,
@@ -42,6 +47,11 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"s3Logs":            testAccOrganizationConfiguration_s3logs,
 			"kubernetes":        testAccOrganizationConfiguration_kubernetes,
 			"malwareProtection": testAccOrganizationConfiguration_malwareprotection,
+			"autoEnableMembers": testAccOrganizationConfiguration_autoEnableOrganizationMembers,
+		},
+		"OrganizationConfigurationFeature": {
+			"basic":                   testAccOrganizationConfigurationFeature_basic,
+			"additionalConfiguration": testAccOrganizationConfigurationFeature_additionalConfiguration,
 		},
 		"ThreatIntelSet": {
 			"basic": testAccThreatIntelSet_basic,
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_guardduty_organization_configuration")
 func ResourceOrganizationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOrganizationConfigurationUpdate,
@@ -27,8 +28,19 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"auto_enable": {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use auto_enable_organization_members instead",
+				ExactlyOneOf: []string{"auto_enable", "auto_enable_organization_members"},
+			},
+
+			"auto_enable_organization_members": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.AutoEnableMembers_Values(), false),
+				ExactlyOneOf: []string{"auto_enable", "auto_enable_organization_members"},
 			},
 
 			"datasources": {
@@ -128,12 +140,22 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 	detectorID := d.Get("detector_id").(string)
 
 	input := &guardduty.UpdateOrganizationConfigurationInput{
-		AutoEnable: aws.Bool(d.Get("auto_enable").(bool)),
 		DetectorId: aws.String(detectorID),
 	}
 
-	if v, ok := d.GetOk("datasources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.DataSources = expandOrganizationDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
+	// Both attributes are computed, so check the configuration to see which one is in use.
+	if d.GetRawConfig().GetAttr("auto_enable_organization_members").IsNull() {
+		input.AutoEnable = aws.Bool(d.Get("auto_enable").(bool))
+	} else {
+		input.AutoEnableOrganizationMembers = aws.String(d.Get("auto_enable_organization_members").(string))
+	}
+
+	// Only send datasources that are explicitly configured. The computed value mirrors the features managed by
+	// aws_guardduty_organization_configuration_feature and sending it back would overwrite their auto-enable settings.
+	if v := d.GetRawConfig().GetAttr("datasources"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if v, ok := d.GetOk("datasources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DataSources = expandOrganizationDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
+		}
 	}
 
 	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
@@ -172,6 +194,7 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 	}
 
 	d.Set("auto_enable", output.AutoEnable)
+	d.Set("auto_enable_organization_members", output.AutoEnableOrganizationMembers)
 
 	if output.DataSources != nil {
 		if err := d.Set("datasources", []interface{}{flattenOrganizationDataSourceConfigurationsResult(output.DataSources)}); err != nil {
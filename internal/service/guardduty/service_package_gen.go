@@ -11,72 +11,92 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_guardduty_detector",
+			Factory:  DataSourceDetector,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "guardduty"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_guardduty_detector",
+			Factory:  ResourceDetector,
+		},
+		{
+			TypeName: "aws_guardduty_detector_feature",
+			Factory:  ResourceDetectorFeature,
+		},
+		{
+			TypeName: "aws_guardduty_filter",
+			Factory:  ResourceFilter,
+		},
+		{
+			TypeName: "aws_guardduty_invite_accepter",
+			Factory:  ResourceInviteAccepter,
+		},
+		{
+			TypeName: "aws_guardduty_ipset",
+			Factory:  ResourceIPSet,
+		},
+		{
+			TypeName: "aws_guardduty_member",
+			Factory:  ResourceMember,
+		},
+		{
+			TypeName: "aws_guardduty_organization_admin_account",
+			Factory:  ResourceOrganizationAdminAccount,
+		},
+		{
+			TypeName: "aws_guardduty_organization_configuration",
+			Factory:  ResourceOrganizationConfiguration,
+		},
+		{
+			TypeName: "aws_guardduty_organization_configuration_feature",
+			Factory:  ResourceOrganizationConfigurationFeature,
+		},
+		{
+			TypeName: "aws_guardduty_publishing_destination",
+			Factory:  ResourcePublishingDestination,
+		},
+		{
+			TypeName: "aws_guardduty_threatintelset",
+			Factory:  ResourceThreatIntelSet,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "guardduty"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
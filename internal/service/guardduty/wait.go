@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -35,7 +36,7 @@ func waitAdminAccountEnabled(ctx context.Context, conn *guardduty.GuardDuty, adm
 		Timeout: adminAccountEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*guardduty.AdminAccount); ok {
 		return output, err
@@ -53,7 +54,7 @@ func waitAdminAccountNotFound(ctx context.Context, conn *guardduty.GuardDuty, ad
 		Timeout: adminAccountNotFoundTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*guardduty.AdminAccount); ok {
 		return output, err
@@ -71,7 +72,7 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Guard
 		Timeout: publishingDestinationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*guardduty.CreatePublishingDestinationOutput); ok {
 		return v, err
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_iam_account_alias")
 func ResourceAccountAlias() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountAliasCreate,
@@ -0,0 +1,175 @@
+package iam
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// policy_catalog.json is generated from the Service Authorization Reference by
+// internal/generate/iampolicycatalog. Global condition keys are not part of the
+// reference and are maintained by hand. Actions, resource types and condition keys
+// are only validated for services that list them.
+//
+//go:embed policy_catalog.json
+var policyCatalogData []byte
+
+type policyCatalogService struct {
+	Actions       []string            `json:"actions"`
+	ARNNamespaces []string            `json:"arn_namespaces"`
+	ConditionKeys []string            `json:"condition_keys"`
+	ResourceTypes map[string][]string `json:"resource_types"`
+
+	actions       map[string]struct{}
+	resourceTypes []*regexp.Regexp
+}
+
+type policyCatalog struct {
+	GlobalConditionKeys []string                         `json:"global_condition_keys"`
+	Services            map[string]*policyCatalogService `json:"services"`
+}
+
+var (
+	policyCatalogOnce     sync.Once
+	policyCatalogInstance *policyCatalog
+)
+
+func loadPolicyCatalog() *policyCatalog {
+	policyCatalogOnce.Do(func() {
+		catalog := &policyCatalog{}
+
+		if err := json.Unmarshal(policyCatalogData, catalog); err != nil {
+			log.Fatalf("reading IAM policy catalog: %s", err)
+		}
+
+		for prefix, service := range catalog.Services {
+			if len(service.ARNNamespaces) == 0 {
+				service.ARNNamespaces = []string{prefix}
+			}
+
+			service.actions = make(map[string]struct{}, len(service.Actions))
+			for _, action := range service.Actions {
+				service.actions[strings.ToLower(action)] = struct{}{}
+			}
+
+			for _, patterns := range service.ResourceTypes {
+				for _, pattern := range patterns {
+					service.resourceTypes = append(service.resourceTypes, policyCatalogResourceTypeRegexp(pattern))
+				}
+			}
+		}
+
+		policyCatalogInstance = catalog
+	})
+
+	return policyCatalogInstance
+}
+
+var policyCatalogVariableRegexp = regexp.MustCompile(`\$\{[^}]+\}`)
+
+// policyCatalogResourceTypeRegexp converts an ARN format such as
+// "arn:${Partition}:s3:::${BucketName}" to a regular expression.
+func policyCatalogResourceTypeRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+
+	sb.WriteString("^")
+
+	last := 0
+	for _, loc := range policyCatalogVariableRegexp.FindAllStringIndex(pattern, -1) {
+		sb.WriteString(regexp.QuoteMeta(pattern[last:loc[0]]))
+		sb.WriteString(".+")
+		last = loc[1]
+	}
+
+	sb.WriteString(regexp.QuoteMeta(pattern[last:]))
+	sb.WriteString("$")
+
+	return regexp.MustCompile(sb.String())
+}
+
+func (c *policyCatalog) service(prefix string) (*policyCatalogService, bool) {
+	service, ok := c.Services[strings.ToLower(prefix)]
+
+	return service, ok
+}
+
+// hasActions returns whether the catalog lists the service's actions, so that they can be validated.
+func (s *policyCatalogService) hasActions() bool {
+	return len(s.actions) > 0
+}
+
+// matchesAction returns whether the action name, which may contain wildcards,
+// matches any of the service's actions. Services without an action list match any name;
+// see hasActions.
+func (s *policyCatalogService) matchesAction(name string) bool {
+	if !s.hasActions() {
+		return true
+	}
+
+	name = strings.ToLower(name)
+
+	if !strings.ContainsAny(name, "*?") {
+		_, ok := s.actions[name]
+		return ok
+	}
+
+	re := regexp.MustCompile("^" + strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(name)) + "$")
+
+	for action := range s.actions {
+		if re.MatchString(action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesResourceType returns whether the ARN matches any of the service's resource types.
+// Services without resource types match any ARN.
+func (s *policyCatalogService) matchesResourceType(arn string) bool {
+	if len(s.resourceTypes) == 0 {
+		return true
+	}
+
+	for _, re := range s.resourceTypes {
+		if re.MatchString(arn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasConditionKey returns whether the key is one of the keys in the list.
+// Keys ending with "/" or ":" are prefixes, e.g. "aws:RequestTag/", as are keys
+// ending with a variable, e.g. "kms:EncryptionContext:${EncryptionContextKey}".
+// Condition keys are case-insensitive.
+func hasConditionKey(keys []string, key string) bool {
+	key = strings.ToLower(key)
+
+	for _, v := range keys {
+		v = strings.ToLower(v)
+		prefix := strings.HasSuffix(v, "/") || strings.HasSuffix(v, ":")
+
+		if i := strings.IndexAny(v, "$<"); i >= 0 {
+			v, prefix = v[:i], true
+		}
+
+		if prefix {
+			if strings.HasPrefix(key, v) && len(key) > len(v) {
+				return true
+			}
+
+			continue
+		}
+
+		if key == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,6 +19,7 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+// @SDKDataSource("aws_iam_policy_document")
 func DataSourcePolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -35,6 +37,14 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minified_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"override_json": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -114,6 +124,12 @@ func DataSourcePolicyDocument() *schema.Resource {
 					},
 				},
 			},
+			"validation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      policyDocumentValidationModeOff,
+				ValidateFunc: validation.StringInSlice(policyDocumentValidationMode_Values(), false),
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -172,6 +188,8 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	// process the current document
+	// statementIndexes maps configured statements to their index for diagnostics
+	statementIndexes := make(map[*IAMPolicyStatement]int)
 	doc := &IAMPolicyDoc{
 		Version: d.Get("version").(string),
 	}
@@ -252,6 +270,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 			}
 
 			stmts[i] = stmt
+			statementIndexes[stmt] = i
 		}
 
 		doc.Statements = stmts
@@ -292,7 +311,72 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
+	mode := d.Get("validation_mode").(string)
+	minify := d.Get("minify").(bool)
+
+	// The minified document is only built when it is requested, or its size is validated.
+	var minifiedJSONDoc []byte
+	if minify || mode != policyDocumentValidationModeOff {
+		minifiedDoc, err := minifyPolicyDocument(mergedDoc)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: minifying: %s", err)
+		}
+		minifiedJSONDoc, err = json.Marshal(minifiedDoc)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting minified JSON: %s", err)
+		}
+	}
+
+	if mode != policyDocumentValidationModeOff {
+		findings := lintPolicyDocument(mergedDoc)
+
+		if n := len(minifiedJSONDoc); n > policyDocumentMaxManagedPolicySize {
+			findings = append(findings, &policyDocumentFinding{
+				statement: -1,
+				message:   fmt.Sprintf("minified policy document is %d characters, exceeding the managed policy limit of %d characters", n, policyDocumentMaxManagedPolicySize),
+			})
+		}
+
+		severity := diag.Warning
+		if mode == policyDocumentValidationModeError {
+			severity = diag.Error
+		}
+
+		for _, finding := range findings {
+			diagnostic := diag.Diagnostic{
+				Severity: severity,
+				Summary:  "Invalid IAM Policy Document",
+				Detail:   finding.String(),
+			}
+
+			if finding.unvalidated {
+				diagnostic.Severity = diag.Warning
+				diagnostic.Summary = "Incomplete IAM Policy Document Validation"
+			}
+
+			if finding.statement >= 0 {
+				if i, ok := statementIndexes[mergedDoc.Statements[finding.statement]]; ok {
+					diagnostic.AttributePath = cty.GetAttrPath("statement").IndexInt(i)
+					if finding.attribute != "" {
+						diagnostic.AttributePath = diagnostic.AttributePath.GetAttr(finding.attribute)
+					}
+				}
+			}
+
+			diags = append(diags, diagnostic)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	d.Set("json", jsonString)
+	if minify {
+		d.Set("minified_json", string(minifiedJSONDoc))
+	} else {
+		d.Set("minified_json", nil)
+	}
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
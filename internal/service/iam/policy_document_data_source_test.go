@@ -241,6 +241,34 @@ func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_validationMode(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validationMode("error", "s3:GetObjct"),
+				ExpectError: regexp.MustCompile(`statement 0 \(Sid "ReadObjects"\): actions: unknown action "s3:GetObjct"`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_validationMode("warn", "s3:GetObjct"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_validationMode("error", "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":["s3:GetObject","s3:GetObjectVersion"],"Resource":"arn:aws:s3:::example/*"}]}`),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_sourcePolicyValidJSON(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -1543,3 +1571,24 @@ data "aws_iam_policy_document" "test" {
   source_json = "{"
 }
 `
+
+func testAccPolicyDocumentDataSourceConfig_validationMode(mode, action string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  minify          = true
+  validation_mode = %[1]q
+
+  statement {
+    sid       = "ReadObjects"
+    actions   = [%[2]q]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+
+  statement {
+    sid       = "ReadObjectVersions"
+    actions   = ["s3:GetObjectVersion"]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+}
+`, mode, action)
+}
@@ -0,0 +1,366 @@
+package iam
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	policyDocumentValidationModeError = "error"
+	policyDocumentValidationModeOff   = "off"
+	policyDocumentValidationModeWarn  = "warn"
+)
+
+func policyDocumentValidationMode_Values() []string {
+	return []string{
+		policyDocumentValidationModeError,
+		policyDocumentValidationModeOff,
+		policyDocumentValidationModeWarn,
+	}
+}
+
+// policyDocumentFinding is a problem found in a policy document.
+type policyDocumentFinding struct {
+	// Index of the statement in the document, or -1 for the document itself.
+	statement int
+	sid       string
+	// Name of the aws_iam_policy_document statement argument, e.g. "actions".
+	attribute string
+	message   string
+	// Whether the finding reports values that could not be validated, rather than invalid values.
+	// Such findings are warnings whatever the validation mode.
+	unvalidated bool
+}
+
+func (f *policyDocumentFinding) String() string {
+	if f.statement < 0 {
+		return f.message
+	}
+
+	statement := fmt.Sprintf("statement %d", f.statement)
+	if f.sid != "" {
+		statement = fmt.Sprintf("statement %d (Sid %q)", f.statement, f.sid)
+	}
+
+	if f.attribute == "" {
+		return fmt.Sprintf("%s: %s", statement, f.message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", statement, f.attribute, f.message)
+}
+
+var (
+	policyActionRegexp = regexp.MustCompile(`^([a-zA-Z0-9-]+):([a-zA-Z0-9*?]+)$`)
+	policyAccountID    = regexp.MustCompile(`^\d{12}$`)
+
+	policyConditionOperators = []string{
+		"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals", "DateGreaterThan", "DateGreaterThanEquals", "DateLessThan", "DateLessThanEquals", "DateNotEquals",
+		"IpAddress", "NotIpAddress",
+		"Null",
+		"NumericEquals", "NumericGreaterThan", "NumericGreaterThanEquals", "NumericLessThan", "NumericLessThanEquals", "NumericNotEquals",
+		"StringEquals", "StringEqualsIgnoreCase", "StringLike", "StringNotEquals", "StringNotEqualsIgnoreCase", "StringNotLike",
+	}
+
+	policyPrincipalTypes = []string{"*", "AWS", "CanonicalUser", "Federated", "Service"}
+)
+
+// lintPolicyDocument checks a policy document against the bundled catalog and the IAM policy grammar.
+func lintPolicyDocument(doc *IAMPolicyDoc) []*policyDocumentFinding {
+	catalog := loadPolicyCatalog()
+
+	var findings []*policyDocumentFinding
+
+	for i, statement := range doc.Statements {
+		add := func(attribute, format string, a ...interface{}) {
+			findings = append(findings, &policyDocumentFinding{
+				statement: i,
+				sid:       statement.Sid,
+				attribute: attribute,
+				message:   fmt.Sprintf(format, a...),
+			})
+		}
+
+		if statement.Effect != "Allow" && statement.Effect != "Deny" {
+			add("effect", "must be Allow or Deny, got %q", statement.Effect)
+		}
+
+		actions := policyStatementStrings(statement.Actions)
+		notActions := policyStatementStrings(statement.NotActions)
+
+		switch {
+		case len(actions) > 0 && len(notActions) > 0:
+			add("not_actions", "conflicts with actions")
+		case len(actions) == 0 && len(notActions) == 0:
+			add("actions", "one of actions or not_actions must be specified")
+		}
+
+		if len(policyStatementStrings(statement.Resources)) > 0 && len(policyStatementStrings(statement.NotResources)) > 0 {
+			add("not_resources", "conflicts with resources")
+		}
+
+		if len(statement.Principals) > 0 && len(statement.NotPrincipals) > 0 {
+			add("not_principals", "conflicts with principals")
+		}
+
+		if len(statement.NotPrincipals) > 0 && statement.Effect == "Allow" {
+			add("not_principals", "must only be used with effect Deny")
+		}
+
+		for _, attribute := range []string{"actions", "not_actions"} {
+			values := actions
+			if attribute == "not_actions" {
+				values = notActions
+			}
+
+			for _, action := range values {
+				if message := lintPolicyAction(catalog, action); message != "" {
+					add(attribute, "%s", message)
+				}
+			}
+
+			if prefixes := unvalidatedPolicyActionPrefixes(catalog, values); len(prefixes) > 0 {
+				findings = append(findings, &policyDocumentFinding{
+					statement:   i,
+					sid:         statement.Sid,
+					attribute:   attribute,
+					message:     fmt.Sprintf("actions of %s cannot be validated: the bundled catalog has no action list for them", strings.Join(prefixes, ", ")),
+					unvalidated: true,
+				})
+			}
+		}
+
+		for _, attribute := range []string{"resources", "not_resources"} {
+			values := policyStatementStrings(statement.Resources)
+			if attribute == "not_resources" {
+				values = policyStatementStrings(statement.NotResources)
+			}
+
+			for _, resource := range values {
+				if message := lintPolicyResource(catalog, actions, resource); message != "" {
+					add(attribute, "%s", message)
+				}
+			}
+		}
+
+		for _, attribute := range []string{"principals", "not_principals"} {
+			principals := statement.Principals
+			if attribute == "not_principals" {
+				principals = statement.NotPrincipals
+			}
+
+			for _, principal := range principals {
+				for _, message := range lintPolicyPrincipal(principal) {
+					add(attribute, "%s", message)
+				}
+			}
+		}
+
+		for _, condition := range statement.Conditions {
+			for _, message := range lintPolicyCondition(catalog, condition) {
+				add("condition", "%s", message)
+			}
+		}
+	}
+
+	return findings
+}
+
+func lintPolicyAction(catalog *policyCatalog, action string) string {
+	if action == "*" {
+		return ""
+	}
+
+	m := policyActionRegexp.FindStringSubmatch(action)
+
+	if m == nil {
+		return fmt.Sprintf("invalid action %q, expected SERVICE:ACTION", action)
+	}
+
+	service, ok := catalog.service(m[1])
+
+	if !ok {
+		return fmt.Sprintf("unknown service prefix %q in action %q", m[1], action)
+	}
+
+	if !service.matchesAction(m[2]) {
+		if strings.ContainsAny(m[2], "*?") {
+			return fmt.Sprintf("action %q does not match any %s action", action, strings.ToLower(m[1]))
+		}
+
+		return fmt.Sprintf("unknown action %q", action)
+	}
+
+	return ""
+}
+
+// unvalidatedPolicyActionPrefixes returns the sorted service prefixes of the actions
+// whose service is in the catalog without an action list.
+func unvalidatedPolicyActionPrefixes(catalog *policyCatalog, actions []string) []string {
+	var prefixes []string
+
+	for _, action := range actions {
+		m := policyActionRegexp.FindStringSubmatch(action)
+
+		if m == nil {
+			continue
+		}
+
+		prefix := strings.ToLower(m[1])
+
+		if service, ok := catalog.service(prefix); ok && !service.hasActions() && !slices.Contains(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	sort.Strings(prefixes)
+
+	return prefixes
+}
+
+func lintPolicyResource(catalog *policyCatalog, actions []string, resource string) string {
+	if resource == "*" {
+		return ""
+	}
+
+	parts := strings.SplitN(resource, ":", 6)
+
+	if len(parts) != 6 || parts[0] != "arn" {
+		return fmt.Sprintf("invalid resource %q, expected an ARN or *", resource)
+	}
+
+	// Resources containing policy variables can only be checked for their format.
+	if strings.Contains(resource, "${") {
+		return ""
+	}
+
+	// The services of the statement's actions determine the valid resources.
+	var services []*policyCatalogService
+	var prefixes []string
+
+	for _, action := range actions {
+		m := policyActionRegexp.FindStringSubmatch(action)
+
+		if m == nil {
+			return ""
+		}
+
+		service, ok := catalog.service(m[1])
+
+		if !ok {
+			return ""
+		}
+
+		prefix := strings.ToLower(m[1])
+		if !slices.Contains(prefixes, prefix) {
+			services = append(services, service)
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	if len(services) == 0 {
+		return ""
+	}
+
+	sort.Strings(prefixes)
+
+	namespaceOK := false
+	for _, service := range services {
+		if slices.Contains(service.ARNNamespaces, parts[2]) {
+			namespaceOK = true
+			break
+		}
+	}
+
+	if !namespaceOK {
+		return fmt.Sprintf("resource %q is not a resource of %s", resource, strings.Join(prefixes, ", "))
+	}
+
+	if strings.ContainsAny(resource, "*?") {
+		return ""
+	}
+
+	for _, service := range services {
+		if service.matchesResourceType(resource) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("resource %q does not match any resource type of %s", resource, strings.Join(prefixes, ", "))
+}
+
+func lintPolicyPrincipal(principal IAMPolicyStatementPrincipal) []string {
+	var messages []string
+
+	if !slices.Contains(policyPrincipalTypes, principal.Type) {
+		messages = append(messages, fmt.Sprintf("unknown principal type %q, expected one of %s", principal.Type, strings.Join(policyPrincipalTypes, ", ")))
+		return messages
+	}
+
+	if principal.Type != "AWS" {
+		return messages
+	}
+
+	for _, identifier := range policyStatementStrings(principal.Identifiers) {
+		if identifier == "*" || policyAccountID.MatchString(identifier) || strings.HasPrefix(identifier, "arn:") || strings.Contains(identifier, "${") {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("invalid AWS principal %q, expected an account ID, ARN or *", identifier))
+	}
+
+	return messages
+}
+
+func lintPolicyCondition(catalog *policyCatalog, condition IAMPolicyStatementCondition) []string {
+	var messages []string
+
+	operator := condition.Test
+	operator = strings.TrimPrefix(operator, "ForAllValues:")
+	operator = strings.TrimPrefix(operator, "ForAnyValue:")
+
+	if operator != "Null" {
+		operator = strings.TrimSuffix(operator, "IfExists")
+	}
+
+	if !slices.Contains(policyConditionOperators, operator) {
+		messages = append(messages, fmt.Sprintf("unknown condition operator %q", condition.Test))
+	}
+
+	if operator == "Bool" || operator == "Null" {
+		for _, v := range policyStatementStrings(condition.Values) {
+			if v != "true" && v != "false" && !strings.Contains(v, "${") {
+				messages = append(messages, fmt.Sprintf("%s condition on %q requires true or false, got %q", operator, condition.Variable, v))
+			}
+		}
+	}
+
+	prefix, _, ok := strings.Cut(condition.Variable, ":")
+
+	if !ok {
+		messages = append(messages, fmt.Sprintf("invalid condition key %q, expected PREFIX:KEY", condition.Variable))
+		return messages
+	}
+
+	if strings.EqualFold(prefix, "aws") {
+		if !hasConditionKey(catalog.GlobalConditionKeys, condition.Variable) {
+			messages = append(messages, fmt.Sprintf("unknown global condition key %q", condition.Variable))
+		}
+
+		return messages
+	}
+
+	if service, ok := catalog.service(prefix); ok && len(service.ConditionKeys) > 0 {
+		if !hasConditionKey(service.ConditionKeys, condition.Variable) {
+			messages = append(messages, fmt.Sprintf("unknown condition key %q", condition.Variable))
+		}
+	}
+
+	return messages
+}
@@ -0,0 +1,224 @@
+package iam
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestLintPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		statement *IAMPolicyStatement
+		expected  []string
+	}{
+		{
+			name: "valid",
+			statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObject", "s3:List*", "sqs:SendMessage"},
+				Resources: []string{"arn:aws:s3:::example/*", "*"},
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEqualsIfExists", Variable: "aws:PrincipalTag/team", Values: "ops"},
+					{Test: "ForAnyValue:StringLike", Variable: "kms:EncryptionContext:app", Values: []string{"web"}},
+					{Test: "Bool", Variable: "aws:SecureTransport", Values: "true"},
+				},
+			},
+		},
+		{
+			name: "misspelled action",
+			statement: &IAMPolicyStatement{
+				Sid:       "Read",
+				Effect:    "Allow",
+				Actions:   "s3:GetObjct",
+				Resources: "arn:aws:s3:::example/*",
+			},
+			expected: []string{`statement 0 (Sid "Read"): actions: unknown action "s3:GetObjct"`},
+		},
+		{
+			name: "service without action list",
+			statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"iam:GetRol", "ec2:DescribeInstances", "iam:PassRole", "s3:GetObject"},
+				Resources: "*",
+			},
+			expected: []string{`actions: actions of ec2, iam cannot be validated`},
+		},
+		{
+			name: "unknown service",
+			statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   "s4:GetObject",
+				Resources: "*",
+			},
+			expected: []string{`actions: unknown service prefix "s4"`},
+		},
+		{
+			name: "wildcard matches nothing",
+			statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   "sqs:Fetch*",
+				Resources: "*",
+			},
+			expected: []string{`actions: action "sqs:Fetch*" does not match any sqs action`},
+		},
+		{
+			name: "invalid condition operator",
+			statement: &IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    "sqs:SendMessage",
+				Resources:  "*",
+				Conditions: IAMPolicyStatementConditionSet{{Test: "StringEqual", Variable: "aws:SourceArn", Values: "x"}},
+			},
+			expected: []string{`condition: unknown condition operator "StringEqual"`},
+		},
+		{
+			name: "unknown global condition key",
+			statement: &IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    "sqs:SendMessage",
+				Resources:  "*",
+				Conditions: IAMPolicyStatementConditionSet{{Test: "StringEquals", Variable: "aws:SourceARNs", Values: "x"}},
+			},
+			expected: []string{`condition: unknown global condition key "aws:SourceARNs"`},
+		},
+		{
+			name: "not principal with allow",
+			statement: &IAMPolicyStatement{
+				Effect:        "Allow",
+				Actions:       "s3:GetObject",
+				Resources:     "arn:aws:s3:::example/*",
+				NotPrincipals: IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: "123456789012"}},
+			},
+			expected: []string{"not_principals: must only be used with effect Deny"},
+		},
+		{
+			name: "resource for wrong service",
+			statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"sqs:SendMessage"},
+				Resources: "arn:aws:sns:us-east-1:123456789012:topic",
+			},
+			expected: []string{`resources: resource "arn:aws:sns:us-east-1:123456789012:topic" is not a resource of sqs`},
+		},
+		{
+			name: "resource of wrong type",
+			statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"kms:Decrypt"},
+				Resources: "arn:aws:kms:us-east-1:123456789012:secret/example",
+			},
+			expected: []string{"does not match any resource type of kms"},
+		},
+		{
+			name: "invalid resource and principal",
+			statement: &IAMPolicyStatement{
+				Effect:     "Deny",
+				Actions:    "s3:GetObject",
+				Resources:  "example-bucket",
+				Principals: IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: []string{"example-user"}}},
+			},
+			expected: []string{
+				`resources: invalid resource "example-bucket"`,
+				`principals: invalid AWS principal "example-user"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings := lintPolicyDocument(&IAMPolicyDoc{
+				Version:    "2012-10-17",
+				Statements: []*IAMPolicyStatement{testCase.statement},
+			})
+
+			if got, want := len(findings), len(testCase.expected); got != want {
+				t.Fatalf("expected %d findings, got %d: %v", want, got, findings)
+			}
+
+			for i, expected := range testCase.expected {
+				if got := findings[i].String(); !strings.Contains(got, expected) {
+					t.Errorf("expected finding %d to contain %q, got %q", i, expected, got)
+				}
+
+				if got, want := findings[i].unvalidated, strings.Contains(expected, "cannot be validated"); got != want {
+					t.Errorf("expected finding %d unvalidated %t, got %t", i, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMinifyPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{Sid: "A", Effect: "Allow", Actions: []string{"s3:PutObject", "s3:GetObject"}, Resources: "arn:aws:s3:::a/*"},
+			{Sid: "B", Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::a/*"},
+			{Sid: "C", Effect: "Allow", Actions: []interface{}{"s3:GetObject", "s3:PutObject"}, Resources: "arn:aws:s3:::b/*"},
+			{Sid: "D", Effect: "Deny", Actions: "s3:DeleteObject", Resources: "*"},
+		},
+	}
+
+	minified, err := minifyPolicyDocument(doc)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := json.Marshal(minified)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]},{"Sid":"D","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`
+
+	if string(got) != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	// The original document is unchanged.
+	if doc.Statements[0].Sid != "A" {
+		t.Errorf("expected original statement Sid to be unchanged, got %q", doc.Statements[0].Sid)
+	}
+}
+
+func TestHasConditionKey(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"aws:RequestTag/", "kms:EncryptionContext:${EncryptionContextKey}", "s3:ExistingObjectTag/<key>", "kms:ViaService"}
+
+	testCases := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "aws:RequestTag/team", expected: true},
+		{key: "aws:RequestTag/", expected: false},
+		{key: "kms:EncryptionContext:app", expected: true},
+		{key: "kms:encryptioncontext:app", expected: true},
+		{key: "kms:EncryptionContext:", expected: false},
+		{key: "s3:ExistingObjectTag/team", expected: true},
+		{key: "kms:ViaService", expected: true},
+		{key: "kms:ViaServices", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.key, func(t *testing.T) {
+			t.Parallel()
+
+			if got := hasConditionKey(keys, testCase.key); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
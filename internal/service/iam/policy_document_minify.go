@@ -0,0 +1,133 @@
+package iam
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Maximum number of non-whitespace characters in a managed policy document.
+const policyDocumentMaxManagedPolicySize = 6144
+
+// minifyPolicyDocument returns an equivalent document with sorted and de-duplicated
+// actions and resources, and statements that differ only in their actions or only in
+// their resources merged. Merged statements lose their Sids.
+func minifyPolicyDocument(doc *IAMPolicyDoc) (*IAMPolicyDoc, error) {
+	statements := make([]*IAMPolicyStatement, 0, len(doc.Statements))
+
+	for _, v := range doc.Statements {
+		statement := *v
+		statement.Actions = policyStatementStringsValue(policyStatementStrings(v.Actions))
+		statement.NotActions = policyStatementStringsValue(policyStatementStrings(v.NotActions))
+		statement.Resources = policyStatementStringsValue(policyStatementStrings(v.Resources))
+		statement.NotResources = policyStatementStringsValue(policyStatementStrings(v.NotResources))
+		statements = append(statements, &statement)
+	}
+
+	statements, err := mergePolicyStatements(statements,
+		func(s *IAMPolicyStatement) interface{} { return s.Actions },
+		func(s *IAMPolicyStatement, v interface{}) { s.Actions = v },
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err = mergePolicyStatements(statements,
+		func(s *IAMPolicyStatement) interface{} { return s.Resources },
+		func(s *IAMPolicyStatement, v interface{}) { s.Resources = v },
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &IAMPolicyDoc{
+		Version:    doc.Version,
+		Id:         doc.Id,
+		Statements: statements,
+	}, nil
+}
+
+// mergePolicyStatements merges statements that are identical apart from their Sid and the field
+// accessed by get and set. Statements without a value for the field are never merged.
+func mergePolicyStatements(statements []*IAMPolicyStatement, get func(*IAMPolicyStatement) interface{}, set func(*IAMPolicyStatement, interface{})) ([]*IAMPolicyStatement, error) {
+	var merged []*IAMPolicyStatement
+	groups := make(map[string]*IAMPolicyStatement)
+
+	for _, statement := range statements {
+		if get(statement) == nil {
+			merged = append(merged, statement)
+			continue
+		}
+
+		k := *statement
+		k.Sid = ""
+		set(&k, nil)
+
+		b, err := json.Marshal(&k)
+
+		if err != nil {
+			return nil, err
+		}
+
+		key := string(b)
+
+		if existing, ok := groups[key]; ok {
+			existing.Sid = ""
+			set(existing, policyStatementStringsValue(append(policyStatementStrings(get(existing)), policyStatementStrings(get(statement))...)))
+			continue
+		}
+
+		groups[key] = statement
+		merged = append(merged, statement)
+	}
+
+	return merged, nil
+}
+
+// policyStatementStrings returns the values of a statement element, which is
+// either a string or a list of strings.
+func policyStatementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var out []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+
+	return nil
+}
+
+// policyStatementStringsValue returns the sorted, de-duplicated values as a statement element.
+func policyStatementStringsValue(values []string) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(values))
+	var out []string
+
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+
+	sort.Strings(out)
+
+	if len(out) == 1 {
+		return out[0]
+	}
+
+	return out
+}
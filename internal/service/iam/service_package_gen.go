@@ -11,72 +11,208 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_iam_account_alias",
+			Factory:  DataSourceAccountAlias,
+		},
+		{
+			TypeName: "aws_iam_group",
+			Factory:  DataSourceGroup,
+		},
+		{
+			TypeName: "aws_iam_instance_profile",
+			Factory:  DataSourceInstanceProfile,
+		},
+		{
+			TypeName: "aws_iam_instance_profiles",
+			Factory:  DataSourceInstanceProfiles,
+		},
+		{
+			TypeName: "aws_iam_openid_connect_provider",
+			Factory:  DataSourceOpenIDConnectProvider,
+		},
+		{
+			TypeName: "aws_iam_policy",
+			Factory:  DataSourcePolicy,
+		},
+		{
+			TypeName: "aws_iam_policy_document",
+			Factory:  DataSourcePolicyDocument,
+		},
+		{
+			TypeName: "aws_iam_role",
+			Factory:  DataSourceRole,
+		},
+		{
+			TypeName: "aws_iam_roles",
+			Factory:  DataSourceRoles,
+		},
+		{
+			TypeName: "aws_iam_saml_provider",
+			Factory:  DataSourceSAMLProvider,
+		},
+		{
+			TypeName: "aws_iam_server_certificate",
+			Factory:  DataSourceServerCertificate,
+		},
+		{
+			TypeName: "aws_iam_session_context",
+			Factory:  DataSourceSessionContext,
+		},
+		{
+			TypeName: "aws_iam_user",
+			Factory:  DataSourceUser,
+		},
+		{
+			TypeName: "aws_iam_user_ssh_key",
+			Factory:  DataSourceUserSSHKey,
+		},
+		{
+			TypeName: "aws_iam_users",
+			Factory:  DataSourceUsers,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "iam"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_iam_access_key",
+			Factory:  ResourceAccessKey,
+		},
+		{
+			TypeName: "aws_iam_account_alias",
+			Factory:  ResourceAccountAlias,
+		},
+		{
+			TypeName: "aws_iam_account_password_policy",
+			Factory:  ResourceAccountPasswordPolicy,
+		},
+		{
+			TypeName: "aws_iam_group",
+			Factory:  ResourceGroup,
+		},
+		{
+			TypeName: "aws_iam_group_membership",
+			Factory:  ResourceGroupMembership,
+		},
+		{
+			TypeName: "aws_iam_group_policy",
+			Factory:  ResourceGroupPolicy,
+		},
+		{
+			TypeName: "aws_iam_group_policy_attachment",
+			Factory:  ResourceGroupPolicyAttachment,
+		},
+		{
+			TypeName: "aws_iam_instance_profile",
+			Factory:  ResourceInstanceProfile,
+		},
+		{
+			TypeName: "aws_iam_openid_connect_provider",
+			Factory:  ResourceOpenIDConnectProvider,
+		},
+		{
+			TypeName: "aws_iam_policy",
+			Factory:  ResourcePolicy,
+		},
+		{
+			TypeName: "aws_iam_policy_attachment",
+			Factory:  ResourcePolicyAttachment,
+		},
+		{
+			TypeName: "aws_iam_role",
+			Factory:  ResourceRole,
+		},
+		{
+			TypeName: "aws_iam_role_policy",
+			Factory:  ResourceRolePolicy,
+		},
+		{
+			TypeName: "aws_iam_role_policy_attachment",
+			Factory:  ResourceRolePolicyAttachment,
+		},
+		{
+			TypeName: "aws_iam_saml_provider",
+			Factory:  ResourceSAMLProvider,
+		},
+		{
+			TypeName: "aws_iam_server_certificate",
+			Factory:  ResourceServerCertificate,
+		},
+		{
+			TypeName: "aws_iam_service_linked_role",
+			Factory:  ResourceServiceLinkedRole,
+		},
+		{
+			TypeName: "aws_iam_service_specific_credential",
+			Factory:  ResourceServiceSpecificCredential,
+		},
+		{
+			TypeName: "aws_iam_signing_certificate",
+			Factory:  ResourceSigningCertificate,
+		},
+		{
+			TypeName: "aws_iam_user",
+			Factory:  ResourceUser,
+		},
+		{
+			TypeName: "aws_iam_user_group_membership",
+			Factory:  ResourceUserGroupMembership,
+		},
+		{
+			TypeName: "aws_iam_user_login_profile",
+			Factory:  ResourceUserLoginProfile,
+		},
+		{
+			TypeName: "aws_iam_user_policy",
+			Factory:  ResourceUserPolicy,
+		},
+		{
+			TypeName: "aws_iam_user_policy_attachment",
+			Factory:  ResourceUserPolicyAttachment,
+		},
+		{
+			TypeName: "aws_iam_user_ssh_key",
+			Factory:  ResourceUserSSHKey,
+		},
+		{
+			TypeName: "aws_iam_virtual_mfa_device",
+			Factory:  ResourceVirtualMFADevice,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "iam"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
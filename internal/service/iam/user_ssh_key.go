@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_iam_user_ssh_key")
 func ResourceUserSSHKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserSSHKeyCreate,
@@ -11,72 +11,124 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_imagebuilder_component",
+			Factory:  DataSourceComponent,
+		},
+		{
+			TypeName: "aws_imagebuilder_components",
+			Factory:  DataSourceComponents,
+		},
+		{
+			TypeName: "aws_imagebuilder_container_recipe",
+			Factory:  DataSourceContainerRecipe,
+		},
+		{
+			TypeName: "aws_imagebuilder_container_recipes",
+			Factory:  DataSourceContainerRecipes,
+		},
+		{
+			TypeName: "aws_imagebuilder_distribution_configuration",
+			Factory:  DataSourceDistributionConfiguration,
+		},
+		{
+			TypeName: "aws_imagebuilder_distribution_configurations",
+			Factory:  DataSourceDistributionConfigurations,
+		},
+		{
+			TypeName: "aws_imagebuilder_image",
+			Factory:  DataSourceImage,
+		},
+		{
+			TypeName: "aws_imagebuilder_image_pipeline",
+			Factory:  DataSourceImagePipeline,
+		},
+		{
+			TypeName: "aws_imagebuilder_image_pipelines",
+			Factory:  DataSourceImagePipelines,
+		},
+		{
+			TypeName: "aws_imagebuilder_image_recipe",
+			Factory:  DataSourceImageRecipe,
+		},
+		{
+			TypeName: "aws_imagebuilder_image_recipes",
+			Factory:  DataSourceImageRecipes,
+		},
+		{
+			TypeName: "aws_imagebuilder_infrastructure_configuration",
+			Factory:  DataSourceInfrastructureConfiguration,
+		},
+		{
+			TypeName: "aws_imagebuilder_infrastructure_configurations",
+			Factory:  DataSourceInfrastructureConfigurations,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "imagebuilder"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_imagebuilder_component",
+			Factory:  ResourceComponent,
+		},
+		{
+			TypeName: "aws_imagebuilder_container_recipe",
+			Factory:  ResourceContainerRecipe,
+		},
+		{
+			TypeName: "aws_imagebuilder_distribution_configuration",
+			Factory:  ResourceDistributionConfiguration,
+		},
+		{
+			TypeName: "aws_imagebuilder_image",
+			Factory:  ResourceImage,
+		},
+		{
+			TypeName: "aws_imagebuilder_image_pipeline",
+			Factory:  ResourceImagePipeline,
+		},
+		{
+			TypeName: "aws_imagebuilder_image_recipe",
+			Factory:  ResourceImageRecipe,
+		},
+		{
+			TypeName: "aws_imagebuilder_infrastructure_configuration",
+			Factory:  ResourceInfrastructureConfiguration,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "imagebuilder"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
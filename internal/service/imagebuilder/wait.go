@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // waitImageStatusAvailable waits for an Image to return Available
@@ -24,7 +25,7 @@ func waitImageStatusAvailable(ctx context.Context, conn *imagebuilder.Imagebuild
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*imagebuilder.Image); ok {
 		return v, err
@@ -11,72 +11,108 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_iot_endpoint",
+			Factory:  DataSourceEndpoint,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "iot"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_iot_authorizer",
+			Factory:  ResourceAuthorizer,
+		},
+		{
+			TypeName: "aws_iot_certificate",
+			Factory:  ResourceCertificate,
+		},
+		{
+			TypeName: "aws_iot_indexing_configuration",
+			Factory:  ResourceIndexingConfiguration,
+		},
+		{
+			TypeName: "aws_iot_logging_options",
+			Factory:  ResourceLoggingOptions,
+		},
+		{
+			TypeName: "aws_iot_policy",
+			Factory:  ResourcePolicy,
+		},
+		{
+			TypeName: "aws_iot_policy_attachment",
+			Factory:  ResourcePolicyAttachment,
+		},
+		{
+			TypeName: "aws_iot_provisioning_template",
+			Factory:  ResourceProvisioningTemplate,
+		},
+		{
+			TypeName: "aws_iot_role_alias",
+			Factory:  ResourceRoleAlias,
+		},
+		{
+			TypeName: "aws_iot_thing",
+			Factory:  ResourceThing,
+		},
+		{
+			TypeName: "aws_iot_thing_group",
+			Factory:  ResourceThingGroup,
+		},
+		{
+			TypeName: "aws_iot_thing_group_membership",
+			Factory:  ResourceThingGroupMembership,
+		},
+		{
+			TypeName: "aws_iot_thing_principal_attachment",
+			Factory:  ResourceThingPrincipalAttachment,
+		},
+		{
+			TypeName: "aws_iot_thing_type",
+			Factory:  ResourceThingType,
+		},
+		{
+			TypeName: "aws_iot_topic_rule",
+			Factory:  ResourceTopicRule,
+		},
+		{
+			TypeName: "aws_iot_topic_rule_destination",
+			Factory:  ResourceTopicRuleDestination,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "iot"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
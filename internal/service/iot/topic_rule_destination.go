@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_iot_topic_rule_destination")
 func ResourceTopicRuleDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicRuleDestinationCreate,
@@ -288,7 +289,7 @@ func waitTopicRuleDestinationCreated(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -307,7 +308,7 @@ func waitTopicRuleDestinationDeleted(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -326,7 +327,7 @@ func waitTopicRuleDestinationDisabled(ctx context.Context, conn *iot.IoT, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -345,7 +346,7 @@ func waitTopicRuleDestinationEnabled(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ivs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitPlaybackKeyPairCreated(ctx context.Context, conn *ivs.IVS, id string, timeout time.Duration) (*ivs.PlaybackKeyPair, error) {
@@ -18,7 +19,7 @@ func waitPlaybackKeyPairCreated(ctx context.Context, conn *ivs.IVS, id string, t
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.PlaybackKeyPair); ok {
 		return out, err
 	}
@@ -34,7 +35,7 @@ func waitPlaybackKeyPairDeleted(ctx context.Context, conn *ivs.IVS, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.PlaybackKeyPair); ok {
 		return out, err
 	}
@@ -52,7 +53,7 @@ func waitRecordingConfigurationCreated(ctx context.Context, conn *ivs.IVS, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.RecordingConfiguration); ok {
 		return out, err
 	}
@@ -68,7 +69,7 @@ func waitRecordingConfigurationDeleted(ctx context.Context, conn *ivs.IVS, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.RecordingConfiguration); ok {
 		return out, err
 	}
@@ -86,7 +87,7 @@ func waitChannelCreated(ctx context.Context, conn *ivs.IVS, id string, timeout t
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.Channel); ok {
 		return out, err
 	}
@@ -104,7 +105,7 @@ func waitChannelUpdated(ctx context.Context, conn *ivs.IVS, id string, timeout t
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.Channel); ok {
 		return out, err
 	}
@@ -120,7 +121,7 @@ func waitChannelDeleted(ctx context.Context, conn *ivs.IVS, id string, timeout t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.Channel); ok {
 		return out, err
 	}
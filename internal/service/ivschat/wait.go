@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ivschat/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitLoggingConfigurationCreated(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
@@ -20,7 +21,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *ivschat.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
 		return out, err
 	}
@@ -38,7 +39,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *ivschat.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
 		return out, err
 	}
@@ -54,7 +55,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *ivschat.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
 		return out, err
 	}
@@ -72,7 +73,7 @@ func waitRoomUpdated(ctx context.Context, conn *ivschat.Client, id string, timeo
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetRoomOutput); ok {
 		return out, err
 	}
@@ -88,7 +89,7 @@ func waitRoomDeleted(ctx context.Context, conn *ivschat.Client, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetRoomOutput); ok {
 		return out, err
 	}
@@ -106,7 +107,7 @@ func waitRoomCreated(ctx context.Context, conn *ivschat.Client, id string, timeo
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetRoomOutput); ok {
 		return out, err
 	}
@@ -0,0 +1,157 @@
+package kafka_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafka"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkafka "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKafkaClusterPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kafka.GetClusterPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_cluster_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterPolicyConfig_basic(rName, "kafka:Describe*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", "aws_msk_cluster.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"kafka:Describe\*"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterPolicyConfig_basic(rName, "kafka:Get*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterPolicyExists(ctx, resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"kafka:Get\*"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKafkaClusterPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kafka.GetClusterPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_cluster_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterPolicyConfig_basic(rName, "kafka:Describe*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterPolicyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkafka.ResourceClusterPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckClusterPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_msk_cluster_policy" {
+				continue
+			}
+
+			_, err := tfkafka.FindClusterPolicyByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MSK Cluster Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClusterPolicyExists(ctx context.Context, n string, v *kafka.GetClusterPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK Cluster Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn()
+
+		output, err := tfkafka.FindClusterPolicyByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccClusterPolicyConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_brokerNodeGroupInfoVPCConnectivity(rName, true), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_msk_cluster_policy" "test" {
+  cluster_arn = aws_msk_cluster.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "testMskClusterPolicy"
+      Effect = "Allow"
+      Principal = {
+        "AWS" = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action = [
+        %[1]q,
+        "kafka:CreateVpcConnection",
+      ]
+      Resource = aws_msk_cluster.test.arn
+    }]
+  })
+}
+`, action))
+}
@@ -56,3 +56,19 @@ func statusConfigurationState(ctx context.Context, conn *kafka.Kafka, arn string
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func statusVPCConnection(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCConnectionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -20,6 +20,7 @@ func init() {
 		F:    sweepClusters,
 		Dependencies: []string{
 			"aws_mskconnect_connector",
+			"aws_msk_vpc_connection",
 		},
 	})
 
@@ -30,6 +31,11 @@ func init() {
 			"aws_msk_cluster",
 		},
 	})
+
+	resource.AddTestSweepers("aws_msk_vpc_connection", &resource.Sweeper{
+		Name: "aws_msk_vpc_connection",
+		F:    sweepVPCConnections,
+	})
 }
 
 func sweepClusters(region string) error {
@@ -119,3 +125,48 @@ func sweepConfigurations(region string) error {
 
 	return nil
 }
+
+func sweepVPCConnections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).KafkaConn()
+
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	input := &kafka.ListVpcConnectionsInput{}
+	err = conn.ListVpcConnectionsPagesWithContext(ctx, input, func(page *kafka.ListVpcConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VpcConnections {
+			r := ResourceVPCConnection()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VpcConnectionArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MSK VPC Connection sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MSK VPC Connections (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MSK VPC Connections (%s): %w", region, err)
+	}
+
+	return nil
+}
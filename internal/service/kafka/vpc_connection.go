@@ -0,0 +1,187 @@
+package kafka
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_msk_vpc_connection")
+func ResourceVPCConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCConnectionCreate,
+		ReadWithoutTimeout:   resourceVPCConnectionRead,
+		UpdateWithoutTimeout: resourceVPCConnectionUpdate,
+		DeleteWithoutTimeout: resourceVPCConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(VPCConnectionAuthentication_Values(), false),
+			},
+			"client_subnets": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPCConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &kafka.CreateVpcConnectionInput{
+		Authentication:   aws.String(d.Get("authentication").(string)),
+		ClientSubnets:    flex.ExpandStringSet(d.Get("client_subnets").(*schema.Set)),
+		SecurityGroups:   flex.ExpandStringSet(d.Get("security_groups").(*schema.Set)),
+		Tags:             Tags(tags.IgnoreAWS()),
+		TargetClusterArn: aws.String(d.Get("target_cluster_arn").(string)),
+		VpcId:            aws.String(d.Get("vpc_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating MSK VPC Connection: %s", input)
+	output, err := conn.CreateVpcConnectionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MSK VPC Connection: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VpcConnectionArn))
+
+	if _, err := waitVPCConnectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MSK VPC Connection (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVPCConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindVPCConnectionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK VPC Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MSK VPC Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.VpcConnectionArn)
+	d.Set("authentication", output.Authentication)
+	d.Set("client_subnets", aws.StringValueSlice(output.Subnets))
+	d.Set("security_groups", aws.StringValueSlice(output.SecurityGroups))
+	d.Set("target_cluster_arn", output.TargetClusterArn)
+	d.Set("vpc_id", output.VpcId)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVPCConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating MSK VPC Connection (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVPCConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn()
+
+	log.Printf("[DEBUG] Deleting MSK VPC Connection: %s", d.Id())
+	_, err := conn.DeleteVpcConnectionWithContext(ctx, &kafka.DeleteVpcConnectionInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MSK VPC Connection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCConnectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for MSK VPC Connection (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
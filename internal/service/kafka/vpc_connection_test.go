@@ -0,0 +1,255 @@
+package kafka_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafka"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkafka "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKafkaVPCConnection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kafka.DescribeVpcConnectionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_vpc_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConnectionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCConnectionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kafka", regexp.MustCompile(`vpc-connection/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication", "SASL_IAM"),
+					resource.TestCheckResourceAttr(resourceName, "client_subnets.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_cluster_arn", "aws_msk_cluster.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.client", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaVPCConnection_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kafka.DescribeVpcConnectionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_vpc_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConnectionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCConnectionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkafka.ResourceVPCConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaVPCConnection_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kafka.DescribeVpcConnectionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_vpc_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConnectionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCConnectionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCConnectionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCConnectionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_msk_vpc_connection" {
+				continue
+			}
+
+			_, err := tfkafka.FindVPCConnectionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MSK VPC Connection %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCConnectionExists(ctx context.Context, n string, v *kafka.DescribeVpcConnectionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK VPC Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn()
+
+		output, err := tfkafka.FindVPCConnectionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVPCConnectionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccClusterPolicyConfig_basic(rName, "kafka:Describe*"), fmt.Sprintf(`
+resource "aws_vpc" "client" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "client" {
+  count = 3
+
+  vpc_id            = aws_vpc.client.id
+  cidr_block        = cidrsubnet(aws_vpc.client.cidr_block, 8, count.index)
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "client" {
+  vpc_id = aws_vpc.client.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCConnectionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCConnectionConfig_base(rName), `
+resource "aws_msk_vpc_connection" "test" {
+  authentication     = "SASL_IAM"
+  client_subnets     = aws_subnet.client[*].id
+  security_groups    = [aws_security_group.client.id]
+  target_cluster_arn = aws_msk_cluster.test.arn
+  vpc_id             = aws_vpc.client.id
+
+  depends_on = [aws_msk_cluster_policy.test]
+}
+`)
+}
+
+func testAccVPCConnectionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccVPCConnectionConfig_base(rName), fmt.Sprintf(`
+resource "aws_msk_vpc_connection" "test" {
+  authentication     = "SASL_IAM"
+  client_subnets     = aws_subnet.client[*].id
+  security_groups    = [aws_security_group.client.id]
+  target_cluster_arn = aws_msk_cluster.test.arn
+  vpc_id             = aws_vpc.client.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_msk_cluster_policy.test]
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccVPCConnectionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccVPCConnectionConfig_base(rName), fmt.Sprintf(`
+resource "aws_msk_vpc_connection" "test" {
+  authentication     = "SASL_IAM"
+  client_subnets     = aws_subnet.client[*].id
+  security_groups    = [aws_security_group.client.id]
+  target_cluster_arn = aws_msk_cluster.test.arn
+  vpc_id             = aws_vpc.client.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_msk_cluster_policy.test]
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
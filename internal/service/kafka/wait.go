@@ -23,7 +23,7 @@ func waitClusterCreated(ctx context.Context, conn *kafka.Kafka, arn string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafka.ClusterInfo); ok {
 		if state, stateInfo := aws.StringValue(output.State), output.StateInfo; state == kafka.ClusterStateFailed && stateInfo != nil {
@@ -44,7 +44,7 @@ func waitClusterDeleted(ctx context.Context, conn *kafka.Kafka, arn string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafka.ClusterInfo); ok {
 		if state, stateInfo := aws.StringValue(output.State), output.StateInfo; state == kafka.ClusterStateFailed && stateInfo != nil {
@@ -65,7 +65,7 @@ func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Kafka, arn s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafka.ClusterOperationInfo); ok {
 		if state, errorInfo := aws.StringValue(output.OperationState), output.ErrorInfo; state == ClusterOperationStateUpdateFailed && errorInfo != nil {
@@ -78,6 +78,40 @@ func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Kafka, arn s
 	return nil, err
 }
 
+func waitVPCConnectionCreated(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeVpcConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.VpcConnectionStateCreating},
+		Target:  []string{kafka.VpcConnectionStateAvailable},
+		Refresh: statusVPCConnection(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*kafka.DescribeVpcConnectionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCConnectionDeleted(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeVpcConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.VpcConnectionStateAvailable, kafka.VpcConnectionStateInactive, kafka.VpcConnectionStateDeactivating, kafka.VpcConnectionStateDeleting},
+		Target:  []string{},
+		Refresh: statusVPCConnection(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(*kafka.DescribeVpcConnectionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitConfigurationDeleted(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.DescribeConfigurationOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kafka.ConfigurationStateDeleting},
@@ -86,7 +120,7 @@ func waitConfigurationDeleted(ctx context.Context, conn *kafka.Kafka, arn string
 		Timeout: configurationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafka.DescribeConfigurationOutput); ok {
 		return output, err
@@ -33,6 +33,7 @@ const (
 	validationExceptionMessageDataSourceSecrets = "Secrets Manager throws the exception"
 )
 
+// @SDKResource("aws_kendra_data_source")
 func ResourceDataSource() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDataSourceCreate,
@@ -854,7 +855,7 @@ func waitDataSourceCreated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
 		if output.Status == types.DataSourceStatusFailed {
@@ -876,7 +877,7 @@ func waitDataSourceUpdated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
 		if output.Status == types.DataSourceStatusFailed {
@@ -896,7 +897,7 @@ func waitDataSourceDeleted(ctx context.Context, conn *kendra.Client, id, indexId
 		Refresh: statusDataSource(ctx, conn, id, indexId),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
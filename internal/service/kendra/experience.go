@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_kendra_experience")
 func ResourceExperience() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceExperienceCreate,
@@ -339,7 +340,7 @@ func waitExperienceCreated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
 		if out.Status == types.ExperienceStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -359,7 +360,7 @@ func waitExperienceUpdated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
 		if out.Status == types.ExperienceStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -377,7 +378,7 @@ func waitExperienceDeleted(ctx context.Context, conn *kendra.Client, id, indexId
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
 		if out.Status == types.ExperienceStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -33,6 +33,7 @@ const (
 	validationExceptionMessage = "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity"
 )
 
+// @SDKResource("aws_kendra_index")
 func ResourceIndex() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIndexCreate,
@@ -683,7 +684,7 @@ func waitIndexCreated(ctx context.Context, conn *kendra.Client, id string, timeo
 		Refresh: statusIndex(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
 		if output.Status == types.IndexStatusFailed {
@@ -703,7 +704,7 @@ func waitIndexUpdated(ctx context.Context, conn *kendra.Client, id string, timeo
 		Refresh: statusIndex(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
 		if output.Status == types.IndexStatusFailed {
@@ -723,7 +724,7 @@ func waitIndexDeleted(ctx context.Context, conn *kendra.Client, id string, timeo
 		Refresh: statusIndex(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
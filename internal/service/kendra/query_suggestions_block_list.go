@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_kendra_query_suggestions_block_list")
 func ResourceQuerySuggestionsBlockList() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQuerySuggestionsBlockListCreate,
@@ -337,7 +338,7 @@ func waitQuerySuggestionsBlockListCreated(ctx context.Context, conn *kendra.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
 		if out.Status == types.QuerySuggestionsBlockListStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -358,7 +359,7 @@ func waitQuerySuggestionsBlockListUpdated(ctx context.Context, conn *kendra.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
 		if out.Status == types.QuerySuggestionsBlockListStatusActiveButUpdateFailed || out.Status == types.QuerySuggestionsBlockListStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -377,7 +378,7 @@ func waitQuerySuggestionsBlockListDeleted(ctx context.Context, conn *kendra.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
 		if out.Status == types.QuerySuggestionsBlockListStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_kinesis_stream")
 func ResourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
@@ -614,7 +615,7 @@ func waitStreamCreated(ctx context.Context, conn *kinesis.Kinesis, name string,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kinesis.StreamDescriptionSummary); ok {
 		return output, err
@@ -633,7 +634,7 @@ func waitStreamDeleted(ctx context.Context, conn *kinesis.Kinesis, name string,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kinesis.StreamDescriptionSummary); ok {
 		return output, err
@@ -652,7 +653,7 @@ func waitStreamUpdated(ctx context.Context, conn *kinesis.Kinesis, name string,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kinesis.StreamDescriptionSummary); ok {
 		return output, err
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_kinesis_stream_consumer")
 func ResourceStreamConsumer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamConsumerCreate,
@@ -179,7 +180,7 @@ func waitStreamConsumerCreated(ctx context.Context, conn *kinesis.Kinesis, arn s
 		Timeout: streamConsumerCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kinesis.ConsumerDescription); ok {
 		return output, err
@@ -196,7 +197,7 @@ func waitStreamConsumerDeleted(ctx context.Context, conn *kinesis.Kinesis, arn s
 		Timeout: streamConsumerDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kinesis.ConsumerDescription); ok {
 		return output, err
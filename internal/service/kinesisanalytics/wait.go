@@ -26,7 +26,7 @@ func waitApplicationDeleted(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -44,7 +44,7 @@ func waitApplicationStarted(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationStartedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -62,7 +62,7 @@ func waitApplicationStopped(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -80,7 +80,7 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -19,7 +19,7 @@ func waitApplicationDeleted(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -37,7 +37,7 @@ func waitApplicationStarted(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -55,7 +55,7 @@ func waitApplicationStopped(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -73,7 +73,7 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -139,7 +139,7 @@ func waitSnapshotCreated(ctx context.Context, conn *kinesisanalyticsv2.KinesisAn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
 		return v, err
@@ -157,7 +157,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *kinesisanalyticsv2.KinesisAn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
 		return v, err
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_kinesis_video_stream")
 func ResourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
@@ -138,7 +140,7 @@ func resourceStreamCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		MinTimeout: 3 * time.Second,
 	}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err = tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for creating Kinesis Video Stream (%s): %s", d.Id(), err)
 	}
 
@@ -234,7 +236,7 @@ func resourceStreamUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		MinTimeout: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for updating Kinesis Video Stream (%s): %s", d.Id(), err)
 	}
 
@@ -264,7 +266,7 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		MinTimeout: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for deleting Kinesis Video Stream (%s): %s", d.Id(), err)
 	}
 
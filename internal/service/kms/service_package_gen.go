@@ -11,72 +11,104 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_kms_alias",
+			Factory:  DataSourceAlias,
+		},
+		{
+			TypeName: "aws_kms_ciphertext",
+			Factory:  DataSourceCiphertext,
+		},
+		{
+			TypeName: "aws_kms_custom_key_store",
+			Factory:  DataSourceCustomKeyStore,
+		},
+		{
+			TypeName: "aws_kms_key",
+			Factory:  DataSourceKey,
+		},
+		{
+			TypeName: "aws_kms_public_key",
+			Factory:  DataSourcePublicKey,
+		},
+		{
+			TypeName: "aws_kms_secret",
+			Factory:  DataSourceSecret,
+		},
+		{
+			TypeName: "aws_kms_secrets",
+			Factory:  DataSourceSecrets,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "kms"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_kms_alias",
+			Factory:  ResourceAlias,
+		},
+		{
+			TypeName: "aws_kms_ciphertext",
+			Factory:  ResourceCiphertext,
+		},
+		{
+			TypeName: "aws_kms_custom_key_store",
+			Factory:  ResourceCustomKeyStore,
+		},
+		{
+			TypeName: "aws_kms_external_key",
+			Factory:  ResourceExternalKey,
+		},
+		{
+			TypeName: "aws_kms_grant",
+			Factory:  ResourceGrant,
+		},
+		{
+			TypeName: "aws_kms_key",
+			Factory:  ResourceKey,
+		},
+		{
+			TypeName: "aws_kms_replica_external_key",
+			Factory:  ResourceReplicaExternalKey,
+		},
+		{
+			TypeName: "aws_kms_replica_key",
+			Factory:  ResourceReplicaKey,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "kms"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -27,6 +27,7 @@ const (
 	ResNameLFTags = "Resource LF Tags"
 )
 
+// @SDKResource("aws_lakeformation_resource_lf_tags")
 func ResourceResourceLFTags() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceLFTagsCreate,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/lakeformation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -26,7 +27,7 @@ func waitPermissionsReady(ctx context.Context, conn *lakeformation.LakeFormation
 		Timeout: permissionsReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*lakeformation.PrincipalResourcePermissions); ok {
 		return output, err
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_lambda_event_source_mapping")
 func ResourceEventSourceMapping() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEventSourceMappingCreate,
@@ -1164,7 +1165,7 @@ func waitEventSourceMappingCreate(ctx context.Context, conn *lambda.Lambda, id s
 		Timeout: eventSourceMappingCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
@@ -1183,7 +1184,7 @@ func waitEventSourceMappingDelete(ctx context.Context, conn *lambda.Lambda, id s
 		Timeout: eventSourceMappingDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
@@ -1202,7 +1203,7 @@ func waitEventSourceMappingUpdate(ctx context.Context, conn *lambda.Lambda, id s
 		Timeout: eventSourceMappingUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
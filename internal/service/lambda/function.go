@@ -34,6 +34,7 @@ const (
 	mutexKey              = `aws_lambda_function`
 )
 
+// @SDKResource("aws_lambda_function")
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionCreate,
@@ -1153,7 +1154,7 @@ func waitFunctionCreated(ctx context.Context, conn *lambda.Lambda, name string,
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.FunctionConfiguration); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.StateReasonCode), aws.StringValue(output.StateReason)))
@@ -1173,7 +1174,7 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Lambda, functionName
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.FunctionConfiguration); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.LastUpdateStatusReasonCode), aws.StringValue(output.LastUpdateStatusReason)))
@@ -16,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_lambda_provisioned_concurrency_config")
 func ResourceProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisionedConcurrencyConfigCreate,
@@ -214,7 +216,7 @@ func waitForProvisionedConcurrencyConfigStatusReady(ctx context.Context, conn *l
 		Delay:   5 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -11,72 +11,116 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_lambda_alias",
+			Factory:  DataSourceAlias,
+		},
+		{
+			TypeName: "aws_lambda_code_signing_config",
+			Factory:  DataSourceCodeSigningConfig,
+		},
+		{
+			TypeName: "aws_lambda_function",
+			Factory:  DataSourceFunction,
+		},
+		{
+			TypeName: "aws_lambda_function_url",
+			Factory:  DataSourceFunctionURL,
+		},
+		{
+			TypeName: "aws_lambda_functions",
+			Factory:  DataSourceFunctions,
+		},
+		{
+			TypeName: "aws_lambda_invocation",
+			Factory:  DataSourceInvocation,
+		},
+		{
+			TypeName: "aws_lambda_layer_version",
+			Factory:  DataSourceLayerVersion,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "lambda"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_lambda_alias",
+			Factory:  ResourceAlias,
+		},
+		{
+			TypeName: "aws_lambda_code_signing_config",
+			Factory:  ResourceCodeSigningConfig,
+		},
+		{
+			TypeName: "aws_lambda_event_source_mapping",
+			Factory:  ResourceEventSourceMapping,
+		},
+		{
+			TypeName: "aws_lambda_function",
+			Factory:  ResourceFunction,
+		},
+		{
+			TypeName: "aws_lambda_function_event_invoke_config",
+			Factory:  ResourceFunctionEventInvokeConfig,
+		},
+		{
+			TypeName: "aws_lambda_function_url",
+			Factory:  ResourceFunctionURL,
+		},
+		{
+			TypeName: "aws_lambda_invocation",
+			Factory:  ResourceInvocation,
+		},
+		{
+			TypeName: "aws_lambda_layer_version",
+			Factory:  ResourceLayerVersion,
+		},
+		{
+			TypeName: "aws_lambda_layer_version_permission",
+			Factory:  ResourceLayerVersionPermission,
+		},
+		{
+			TypeName: "aws_lambda_permission",
+			Factory:  ResourcePermission,
+		},
+		{
+			TypeName: "aws_lambda_provisioned_concurrency_config",
+			Factory:  ResourceProvisionedConcurrencyConfig,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "lambda"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
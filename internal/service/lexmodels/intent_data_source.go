@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKDataSource("aws_lex_intent")
 func DataSourceIntent() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceIntentRead,
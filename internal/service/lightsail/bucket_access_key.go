@@ -23,6 +23,7 @@ const (
 	BucketAccessKeyIdPartsCount = 2
 )
 
+// @SDKResource("aws_lightsail_bucket_access_key")
 func ResourceBucketAccessKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketAccessKeyCreate,
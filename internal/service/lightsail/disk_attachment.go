@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_lightsail_disk_attachment")
 func ResourceDiskAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDiskAttachmentCreate,
@@ -11,72 +11,127 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "lightsail"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_lightsail_bucket",
+			Factory:  ResourceBucket,
+		},
+		{
+			TypeName: "aws_lightsail_bucket_access_key",
+			Factory:  ResourceBucketAccessKey,
+		},
+		{
+			TypeName: "aws_lightsail_certificate",
+			Factory:  ResourceCertificate,
+		},
+		{
+			TypeName: "aws_lightsail_container_service",
+			Factory:  ResourceContainerService,
+		},
+		{
+			TypeName: "aws_lightsail_container_service_deployment_version",
+			Factory:  ResourceContainerServiceDeploymentVersion,
+		},
+		{
+			TypeName: "aws_lightsail_database",
+			Factory:  ResourceDatabase,
+		},
+		{
+			TypeName: "aws_lightsail_disk",
+			Factory:  ResourceDisk,
+		},
+		{
+			TypeName: "aws_lightsail_disk_attachment",
+			Factory:  ResourceDiskAttachment,
+		},
+		{
+			TypeName: "aws_lightsail_domain",
+			Factory:  ResourceDomain,
+		},
+		{
+			TypeName: "aws_lightsail_domain_entry",
+			Factory:  ResourceDomainEntry,
+		},
+		{
+			TypeName: "aws_lightsail_instance",
+			Factory:  ResourceInstance,
+		},
+		{
+			TypeName: "aws_lightsail_instance_public_ports",
+			Factory:  ResourceInstancePublicPorts,
+		},
+		{
+			TypeName: "aws_lightsail_key_pair",
+			Factory:  ResourceKeyPair,
+		},
+		{
+			TypeName: "aws_lightsail_lb",
+			Factory:  ResourceLoadBalancer,
+		},
+		{
+			TypeName: "aws_lightsail_lb_attachment",
+			Factory:  ResourceLoadBalancerAttachment,
+		},
+		{
+			TypeName: "aws_lightsail_lb_certificate",
+			Factory:  ResourceLoadBalancerCertificate,
+		},
+		{
+			TypeName: "aws_lightsail_lb_certificate_attachment",
+			Factory:  ResourceLoadBalancerCertificateAttachment,
+		},
+		{
+			TypeName: "aws_lightsail_lb_https_redirection_policy",
+			Factory:  ResourceLoadBalancerHTTPSRedirectionPolicy,
+		},
+		{
+			TypeName: "aws_lightsail_lb_stickiness_policy",
+			Factory:  ResourceLoadBalancerStickinessPolicy,
+		},
+		{
+			TypeName: "aws_lightsail_static_ip",
+			Factory:  ResourceStaticIP,
+		},
+		{
+			TypeName: "aws_lightsail_static_ip_attachment",
+			Factory:  ResourceStaticIPAttachment,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "lightsail"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
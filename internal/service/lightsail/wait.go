@@ -45,7 +45,7 @@ func waitOperation(ctx context.Context, conn *lightsail.Lightsail, oid *string)
 		MinTimeout: OperationMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*lightsail.GetOperationOutput); ok {
 		return err
@@ -65,7 +65,7 @@ func waitDatabaseModified(ctx context.Context, conn *lightsail.Lightsail, db *st
 		MinTimeout: DatabaseMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
 		return output, err
@@ -86,7 +86,7 @@ func waitDatabaseBackupRetentionModified(ctx context.Context, conn *lightsail.Li
 		MinTimeout: DatabaseMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
 		return err
@@ -105,7 +105,7 @@ func waitDatabasePubliclyAccessibleModified(ctx context.Context, conn *lightsail
 		MinTimeout: DatabaseMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
 		return err
@@ -124,7 +124,7 @@ func waitContainerServiceCreated(ctx context.Context, conn *lightsail.Lightsail,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -147,7 +147,7 @@ func waitContainerServiceDisabled(ctx context.Context, conn *lightsail.Lightsail
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -170,7 +170,7 @@ func waitContainerServiceUpdated(ctx context.Context, conn *lightsail.Lightsail,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -193,7 +193,7 @@ func waitContainerServiceDeleted(ctx context.Context, conn *lightsail.Lightsail,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -216,7 +216,7 @@ func waitContainerServiceDeploymentVersionActive(ctx context.Context, conn *ligh
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lightsail.ContainerServiceDeployment); ok {
 		if aws.StringValue(output.State) == lightsail.ContainerServiceDeploymentStateFailed {
@@ -239,7 +239,7 @@ func waitInstanceStateWithContext(ctx context.Context, conn *lightsail.Lightsail
 		MinTimeout: OperationMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*lightsail.GetInstanceStateOutput); ok {
 		return out, err
@@ -259,7 +259,7 @@ func waitOperationWithContext(ctx context.Context, conn *lightsail.Lightsail, oi
 		MinTimeout: OperationMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*lightsail.GetOperationOutput); ok {
 		return err
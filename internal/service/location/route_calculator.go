@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_location_route_calculator")
 func ResourceRouteCalculator() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRouteCalculatorCreate,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_location_tracker")
 func ResourceTracker() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrackerCreate,
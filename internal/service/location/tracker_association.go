@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_location_tracker_association")
 func ResourceTrackerAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrackerAssociationCreate,
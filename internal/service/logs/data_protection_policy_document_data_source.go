@@ -12,10 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
-func init() {
-	_sp.registerSDKDataSourceFactory("aws_cloudwatch_log_data_protection_policy_document", dataSourceDataProtectionPolicyDocument)
-}
-
+// @SDKDataSource("aws_cloudwatch_log_data_protection_policy_document")
 func dataSourceDataProtectionPolicyDocument() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDataProtectionPolicyDocumentRead,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,7 +23,7 @@ func waitMemberInvited(ctx context.Context, conn *macie2.Macie2, adminAccountID
 		Timeout: memberInvitedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*macie2.Member); ok {
 		return output, err
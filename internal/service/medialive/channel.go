@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_medialive_channel")
 func ResourceChannel() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceChannelCreate,
@@ -1025,7 +1026,7 @@ func waitChannelCreated(ctx context.Context, conn *medialive.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1043,7 +1044,7 @@ func waitChannelUpdated(ctx context.Context, conn *medialive.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1059,7 +1060,7 @@ func waitChannelDeleted(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1075,7 +1076,7 @@ func waitChannelStarted(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1091,7 +1092,7 @@ func waitChannelStopped(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
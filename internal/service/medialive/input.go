@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_medialive_input")
 func ResourceInput() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInputCreate,
@@ -405,7 +406,7 @@ func waitInputCreated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -424,7 +425,7 @@ func waitInputUpdated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -440,7 +441,7 @@ func waitInputDeleted(ctx context.Context, conn *medialive.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_medialive_input_security_group")
 func ResourceInputSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInputSecurityGroupCreate,
@@ -215,7 +216,7 @@ func waitInputSecurityGroupCreated(ctx context.Context, conn *medialive.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
@@ -233,7 +234,7 @@ func waitInputSecurityGroupUpdated(ctx context.Context, conn *medialive.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
@@ -249,7 +250,7 @@ func waitInputSecurityGroupDeleted(ctx context.Context, conn *medialive.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
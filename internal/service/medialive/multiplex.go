@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_medialive_multiplex")
 func ResourceMultiplex() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMultiplexCreate,
@@ -299,7 +300,7 @@ func waitMultiplexCreated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -318,7 +319,7 @@ func waitMultiplexUpdated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -334,7 +335,7 @@ func waitMultiplexDeleted(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -350,7 +351,7 @@ func waitMultiplexRunning(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -366,7 +367,7 @@ func waitMultiplexStopped(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
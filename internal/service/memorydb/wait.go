@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -35,7 +36,7 @@ func waitACLActive(ctx context.Context, conn *memorydb.MemoryDB, aclId string) e
 		Timeout: aclActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -49,7 +50,7 @@ func waitACLDeleted(ctx context.Context, conn *memorydb.MemoryDB, aclId string)
 		Timeout: aclDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -63,7 +64,7 @@ func waitClusterAvailable(ctx context.Context, conn *memorydb.MemoryDB, clusterI
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -77,7 +78,7 @@ func waitClusterDeleted(ctx context.Context, conn *memorydb.MemoryDB, clusterId
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -92,7 +93,7 @@ func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.MemoryD
 		Timeout: clusterParameterGroupInSyncTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -107,7 +108,7 @@ func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.MemoryD
 		Timeout: clusterSecurityGroupsActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -121,7 +122,7 @@ func waitUserActive(ctx context.Context, conn *memorydb.MemoryDB, userId string)
 		Timeout: userActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -135,7 +136,7 @@ func waitUserDeleted(ctx context.Context, conn *memorydb.MemoryDB, userId string
 		Timeout: userDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -149,7 +150,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *memorydb.MemoryDB, snapsho
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -163,7 +164,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *memorydb.MemoryDB, snapshotI
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
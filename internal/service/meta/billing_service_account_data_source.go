@@ -10,11 +10,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 )
 
-func init() {
-	_sp.registerFrameworkDataSourceFactory(newDataSourceBillingServiceAccount)
-}
-
 // newDataSourceBillingServiceAccount instantiates a new DataSource for the aws_billing_service_account data source.
+// @FrameworkDataSource
 func newDataSourceBillingServiceAccount(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceBillingServiceAccount{}
 	d.SetMigratedFromPluginSDK(true)
@@ -9,11 +9,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 )
 
-func init() {
-	_sp.registerFrameworkDataSourceFactory(newDataSourcePartition)
-}
-
 // newDataSourcePartition instantiates a new DataSource for the aws_partition data source.
+// @FrameworkDataSource
 func newDataSourcePartition(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourcePartition{}
 	d.SetMigratedFromPluginSDK(true)
@@ -12,11 +12,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 )
 
-func init() {
-	_sp.registerFrameworkDataSourceFactory(newDataSourceRegion)
-}
-
 // newDataSourceRegion instantiates a new DataSource for the aws_region data source.
+// @FrameworkDataSource
 func newDataSourceRegion(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceRegion{}
 	d.SetMigratedFromPluginSDK(true)
@@ -13,11 +13,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
-func init() {
-	_sp.registerFrameworkDataSourceFactory(newDataSourceService)
-}
-
 // newDataSourceService instantiates a new DataSource for the aws_service data source.
+// @FrameworkDataSource
 func newDataSourceService(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceService{}
 	d.SetMigratedFromPluginSDK(true)
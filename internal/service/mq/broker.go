@@ -30,6 +30,7 @@ import (
 	"github.com/mitchellh/copystructure"
 )
 
+// @SDKResource("aws_mq_broker")
 func ResourceBroker() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBrokerCreate,
@@ -680,7 +681,7 @@ func waitBrokerCreated(ctx context.Context, conn *mq.MQ, id string, timeout time
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerResponse); ok {
 		return output, err
@@ -701,7 +702,7 @@ func waitBrokerDeleted(ctx context.Context, conn *mq.MQ, id string, timeout time
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerResponse); ok {
 		return output, err
@@ -717,7 +718,7 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.MQ, id string, timeout tim
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerResponse); ok {
 		return output, err
@@ -24,6 +24,7 @@ const (
 	propagationTimeout = 2 * time.Minute
 )
 
+// @SDKResource("aws_mwaa_environment")
 func ResourceEnvironment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEnvironmentCreate,
@@ -613,7 +614,7 @@ func waitEnvironmentCreated(ctx context.Context, conn *mwaa.MWAA, name string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*mwaa.Environment); ok {
 		if v.LastUpdate != nil && v.LastUpdate.Error != nil {
@@ -634,7 +635,7 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*mwaa.Environment); ok {
 		if v.LastUpdate != nil && v.LastUpdate.Error != nil {
@@ -655,7 +656,7 @@ func waitEnvironmentDeleted(ctx context.Context, conn *mwaa.MWAA, name string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*mwaa.Environment); ok {
 		if v.LastUpdate != nil && v.LastUpdate.Error != nil {
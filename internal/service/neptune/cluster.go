@@ -35,6 +35,7 @@ const (
 	ServerlessMaxNCUs = 128.0
 )
 
+// @SDKResource("aws_neptune_cluster")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -928,7 +929,7 @@ func waitClusterAvailable(ctx context.Context, conn *neptune.Neptune, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBCluster); ok {
 		return output, err
@@ -952,7 +953,7 @@ func waitClusterDeleted(ctx context.Context, conn *neptune.Neptune, id string, t
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBCluster); ok {
 		return output, err
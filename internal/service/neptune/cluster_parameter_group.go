@@ -19,6 +19,7 @@ import (
 
 const clusterParameterGroupMaxParamsBulkEdit = 20
 
+// @SDKResource("aws_neptune_cluster_parameter_group")
 func ResourceClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterParameterGroupCreate,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -28,7 +29,7 @@ func WaitEventSubscriptionDeleted(ctx context.Context, conn *neptune.Neptune, su
 		Timeout: EventSubscriptionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*neptune.EventSubscription); ok {
 		return v, err
@@ -46,7 +47,7 @@ func WaitDBClusterEndpointAvailable(ctx context.Context, conn *neptune.Neptune,
 		Timeout: DBClusterEndpointAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
 		return v, err
@@ -64,7 +65,7 @@ func WaitDBClusterEndpointDeleted(ctx context.Context, conn *neptune.Neptune, id
 		Timeout: DBClusterEndpointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
 		return v, err
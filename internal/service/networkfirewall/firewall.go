@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkfirewall_firewall")
 func ResourceFirewall() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFirewallCreate,
@@ -480,7 +481,7 @@ func waitFirewallCreated(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Timeout: firewallTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return output.Firewall, err
@@ -501,7 +502,7 @@ func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Delay: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return aws.StringValue(output.UpdateToken), err
@@ -518,7 +519,7 @@ func waitFirewallDeleted(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Timeout: firewallTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return output.Firewall, err
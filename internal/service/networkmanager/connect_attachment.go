@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_connect_attachment")
 func ResourceConnectAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConnectAttachmentCreate,
@@ -349,7 +350,7 @@ func waitConnectAttachmentCreated(ctx context.Context, conn *networkmanager.Netw
 		Refresh: statusConnectAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
 		return output, err
@@ -367,7 +368,7 @@ func waitConnectAttachmentDeleted(ctx context.Context, conn *networkmanager.Netw
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
 		return output, err
@@ -384,7 +385,7 @@ func waitConnectAttachmentAvailable(ctx context.Context, conn *networkmanager.Ne
 		Refresh: statusConnectAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
 		return output, err
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_connection")
 func ResourceConnection() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConnectionCreate,
@@ -341,7 +342,7 @@ func waitConnectionCreated(ctx context.Context, conn *networkmanager.NetworkMana
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Connection); ok {
 		return output, err
@@ -358,7 +359,7 @@ func waitConnectionDeleted(ctx context.Context, conn *networkmanager.NetworkMana
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Connection); ok {
 		return output, err
@@ -375,7 +376,7 @@ func waitConnectionUpdated(ctx context.Context, conn *networkmanager.NetworkMana
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Connection); ok {
 		return output, err
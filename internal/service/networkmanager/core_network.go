@@ -29,6 +29,7 @@ const (
 
 // This resource is explicitly NOT exported from the provider until design is finalized.
 // Its Delete handler is used by sweepers.
+// @SDKResource("aws_networkmanager_core_network")
 func ResourceCoreNetwork() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCoreNetworkCreate,
@@ -441,7 +442,7 @@ func waitCoreNetworkCreated(ctx context.Context, conn *networkmanager.NetworkMan
 		Refresh: statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
 		return output, err
@@ -458,7 +459,7 @@ func waitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkMan
 		Refresh: statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
 		return output, err
@@ -475,7 +476,7 @@ func waitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkMan
 		Refresh: statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
 		return output, err
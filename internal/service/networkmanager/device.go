@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_device")
 func ResourceDevice() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDeviceCreate,
@@ -435,7 +436,7 @@ func waitDeviceCreated(ctx context.Context, conn *networkmanager.NetworkManager,
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Device); ok {
 		return output, err
@@ -452,7 +453,7 @@ func waitDeviceDeleted(ctx context.Context, conn *networkmanager.NetworkManager,
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Device); ok {
 		return output, err
@@ -469,7 +470,7 @@ func waitDeviceUpdated(ctx context.Context, conn *networkmanager.NetworkManager,
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Device); ok {
 		return output, err
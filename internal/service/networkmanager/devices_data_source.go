@@ -11,6 +11,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// @SDKDataSource("aws_networkmanager_devices")
 func DataSourceDevices() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDevicesRead,
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_global_network")
 func ResourceGlobalNetwork() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGlobalNetworkCreate,
@@ -379,7 +380,7 @@ func waitGlobalNetworkCreated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: statusGlobalNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
 		return output, err
@@ -397,7 +398,7 @@ func waitGlobalNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkM
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
 		return output, err
@@ -414,7 +415,7 @@ func waitGlobalNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: statusGlobalNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
 		return output, err
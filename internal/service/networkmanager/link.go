@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_link")
 func ResourceLink() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLinkCreate,
@@ -367,7 +368,7 @@ func waitLinkCreated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Link); ok {
 		return output, err
@@ -384,7 +385,7 @@ func waitLinkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Link); ok {
 		return output, err
@@ -401,7 +402,7 @@ func waitLinkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Link); ok {
 		return output, err
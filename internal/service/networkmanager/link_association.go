@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_networkmanager_link_association")
 func ResourceLinkAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLinkAssociationCreate,
@@ -245,7 +246,7 @@ func waitLinkAssociationCreated(ctx context.Context, conn *networkmanager.Networ
 		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
 		return output, err
@@ -262,7 +263,7 @@ func waitLinkAssociationDeleted(ctx context.Context, conn *networkmanager.Networ
 		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
 		return output, err
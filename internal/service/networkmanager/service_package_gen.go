@@ -11,72 +11,156 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_networkmanager_connection",
+			Factory:  DataSourceConnection,
+		},
+		{
+			TypeName: "aws_networkmanager_connections",
+			Factory:  DataSourceConnections,
+		},
+		{
+			TypeName: "aws_networkmanager_core_network_policy_document",
+			Factory:  DataSourceCoreNetworkPolicyDocument,
+		},
+		{
+			TypeName: "aws_networkmanager_device",
+			Factory:  DataSourceDevice,
+		},
+		{
+			TypeName: "aws_networkmanager_devices",
+			Factory:  DataSourceDevices,
+		},
+		{
+			TypeName: "aws_networkmanager_global_network",
+			Factory:  DataSourceGlobalNetwork,
+		},
+		{
+			TypeName: "aws_networkmanager_global_networks",
+			Factory:  DataSourceGlobalNetworks,
+		},
+		{
+			TypeName: "aws_networkmanager_link",
+			Factory:  DataSourceLink,
+		},
+		{
+			TypeName: "aws_networkmanager_links",
+			Factory:  DataSourceLinks,
+		},
+		{
+			TypeName: "aws_networkmanager_site",
+			Factory:  DataSourceSite,
+		},
+		{
+			TypeName: "aws_networkmanager_sites",
+			Factory:  DataSourceSites,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "networkmanager"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_networkmanager_attachment_accepter",
+			Factory:  ResourceAttachmentAccepter,
+		},
+		{
+			TypeName: "aws_networkmanager_connect_attachment",
+			Factory:  ResourceConnectAttachment,
+		},
+		{
+			TypeName: "aws_networkmanager_connection",
+			Factory:  ResourceConnection,
+		},
+		{
+			TypeName: "aws_networkmanager_core_network",
+			Factory:  ResourceCoreNetwork,
+		},
+		{
+			TypeName: "aws_networkmanager_core_network_policy_attachment",
+			Factory:  ResourceCoreNetworkPolicyAttachment,
+		},
+		{
+			TypeName: "aws_networkmanager_customer_gateway_association",
+			Factory:  ResourceCustomerGatewayAssociation,
+		},
+		{
+			TypeName: "aws_networkmanager_device",
+			Factory:  ResourceDevice,
+		},
+		{
+			TypeName: "aws_networkmanager_global_network",
+			Factory:  ResourceGlobalNetwork,
+		},
+		{
+			TypeName: "aws_networkmanager_link",
+			Factory:  ResourceLink,
+		},
+		{
+			TypeName: "aws_networkmanager_link_association",
+			Factory:  ResourceLinkAssociation,
+		},
+		{
+			TypeName: "aws_networkmanager_site",
+			Factory:  ResourceSite,
+		},
+		{
+			TypeName: "aws_networkmanager_site_to_site_vpn_attachment",
+			Factory:  ResourceSiteToSiteVPNAttachment,
+		},
+		{
+			TypeName: "aws_networkmanager_transit_gateway_connect_peer_association",
+			Factory:  ResourceTransitGatewayConnectPeerAssociation,
+		},
+		{
+			TypeName: "aws_networkmanager_transit_gateway_peering",
+			Factory:  ResourceTransitGatewayPeering,
+		},
+		{
+			TypeName: "aws_networkmanager_transit_gateway_registration",
+			Factory:  ResourceTransitGatewayRegistration,
+		},
+		{
+			TypeName: "aws_networkmanager_transit_gateway_route_table_attachment",
+			Factory:  ResourceTransitGatewayRouteTableAttachment,
+		},
+		{
+			TypeName: "aws_networkmanager_vpc_attachment",
+			Factory:  ResourceVPCAttachment,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "networkmanager"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
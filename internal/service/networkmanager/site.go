@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_site")
 func ResourceSite() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSiteCreate,
@@ -356,7 +357,7 @@ func waitSiteCreated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Site); ok {
 		return output, err
@@ -373,7 +374,7 @@ func waitSiteDeleted(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Site); ok {
 		return output, err
@@ -390,7 +391,7 @@ func waitSiteUpdated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Site); ok {
 		return output, err
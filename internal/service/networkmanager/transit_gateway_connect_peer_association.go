@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_transit_gateway_connect_peer_association")
 func ResourceTransitGatewayConnectPeerAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayConnectPeerAssociationCreate,
@@ -266,7 +267,7 @@ func waitTransitGatewayConnectPeerAssociationCreated(ctx context.Context, conn *
 		Refresh: statusTransitGatewayConnectPeerAssociationState(ctx, conn, globalNetworkID, connectPeerARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayConnectPeerAssociation); ok {
 		return output, err
@@ -283,7 +284,7 @@ func waitTransitGatewayConnectPeerAssociationDeleted(ctx context.Context, conn *
 		Refresh: statusTransitGatewayConnectPeerAssociationState(ctx, conn, globalNetworkID, connectPeerARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayConnectPeerAssociation); ok {
 		return output, err
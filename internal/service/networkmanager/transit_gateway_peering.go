@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_transit_gateway_peering")
 func ResourceTransitGatewayPeering() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayPeeringCreate,
@@ -249,7 +250,7 @@ func waitTransitGatewayPeeringCreated(ctx context.Context, conn *networkmanager.
 		Refresh: StatusTransitGatewayPeeringState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayPeering); ok {
 		return output, err
@@ -266,7 +267,7 @@ func waitTransitGatewayPeeringDeleted(ctx context.Context, conn *networkmanager.
 		Refresh: StatusTransitGatewayPeeringState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayPeering); ok {
 		return output, err
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_networkmanager_vpc_attachment")
 func ResourceVPCAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCAttachmentCreate,
@@ -360,7 +361,7 @@ func waitVPCAttachmentCreated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: StatusVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
@@ -378,7 +379,7 @@ func waitVPCAttachmentDeleted(ctx context.Context, conn *networkmanager.NetworkM
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
@@ -395,7 +396,7 @@ func waitVPCAttachmentUpdated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: StatusVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
 		return output, err
@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_opensearch_inbound_connection_accepter")
 func ResourceInboundConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInboundConnectionAccepterCreate,
@@ -160,7 +162,7 @@ func inboundConnectionWaitUntilActive(ctx context.Context, conn *opensearchservi
 		Refresh: inboundConnectionRefreshState(ctx, conn, id),
 		Timeout: timeout,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
 		return fmt.Errorf("Error waiting for Inbound Connection (%s) to become available: %s", id, err)
 	}
 	return nil
@@ -178,7 +180,7 @@ func waitForInboundConnectionDeletion(ctx context.Context, conn *opensearchservi
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -27,7 +27,7 @@ func waitUpgradeSucceeded(ctx context.Context, conn *opensearchservice.OpenSearc
 		Delay:      domainUpgradeSuccessDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.GetUpgradeStatusOutput); ok {
 		return output, err
@@ -147,7 +147,7 @@ func waitForDomainDelete(ctx context.Context, conn *opensearchservice.OpenSearch
 		ContinuousTargetOccurence: 3,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_opsworks_application")
 func ResourceApplication() *schema.Resource {
 	return &schema.Resource{
 
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// @SDKResource("aws_opsworks_custom_layer")
 func ResourceCustomLayer() *schema.Resource {
 	layerType := &opsworksLayerType{
 		TypeName:        opsworks.LayerTypeCustom,
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_opsworks_rds_db_instance")
 func ResourceRDSDBInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRDSDBInstanceCreate,
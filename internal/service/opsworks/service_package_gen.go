@@ -11,72 +11,111 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "opsworks"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_opsworks_application",
+			Factory:  ResourceApplication,
+		},
+		{
+			TypeName: "aws_opsworks_custom_layer",
+			Factory:  ResourceCustomLayer,
+		},
+		{
+			TypeName: "aws_opsworks_ecs_cluster_layer",
+			Factory:  ResourceECSClusterLayer,
+		},
+		{
+			TypeName: "aws_opsworks_ganglia_layer",
+			Factory:  ResourceGangliaLayer,
+		},
+		{
+			TypeName: "aws_opsworks_haproxy_layer",
+			Factory:  ResourceHAProxyLayer,
+		},
+		{
+			TypeName: "aws_opsworks_instance",
+			Factory:  ResourceInstance,
+		},
+		{
+			TypeName: "aws_opsworks_java_app_layer",
+			Factory:  ResourceJavaAppLayer,
+		},
+		{
+			TypeName: "aws_opsworks_memcached_layer",
+			Factory:  ResourceMemcachedLayer,
+		},
+		{
+			TypeName: "aws_opsworks_mysql_layer",
+			Factory:  ResourceMySQLLayer,
+		},
+		{
+			TypeName: "aws_opsworks_nodejs_app_layer",
+			Factory:  ResourceNodejsAppLayer,
+		},
+		{
+			TypeName: "aws_opsworks_permission",
+			Factory:  ResourcePermission,
+		},
+		{
+			TypeName: "aws_opsworks_php_app_layer",
+			Factory:  ResourcePHPAppLayer,
+		},
+		{
+			TypeName: "aws_opsworks_rails_app_layer",
+			Factory:  ResourceRailsAppLayer,
+		},
+		{
+			TypeName: "aws_opsworks_rds_db_instance",
+			Factory:  ResourceRDSDBInstance,
+		},
+		{
+			TypeName: "aws_opsworks_stack",
+			Factory:  ResourceStack,
+		},
+		{
+			TypeName: "aws_opsworks_static_web_layer",
+			Factory:  ResourceStaticWebLayer,
+		},
+		{
+			TypeName: "aws_opsworks_user_profile",
+			Factory:  ResourceUserProfile,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "opsworks"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_organizations_account")
 func ResourceAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountCreate,
@@ -429,7 +430,7 @@ func waitAccountCreated(ctx context.Context, conn *organizations.Organizations,
 		Timeout:      5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*organizations.CreateAccountStatus); ok {
 		if state := aws.StringValue(output.State); state == organizations.CreateAccountStateFailed {
@@ -467,7 +468,7 @@ func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations,
 		Timeout:      5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*organizations.Account); ok {
 		return output, err
@@ -16,10 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const policyTypeStatusDisabled = "DISABLED"
 
+// @SDKResource("aws_organizations_organization")
 func ResourceOrganization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOrganizationCreate,
@@ -526,7 +528,7 @@ func waitForOrganizationDefaultRootPolicyTypeDisable(ctx context.Context, conn *
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -542,7 +544,7 @@ func waitForOrganizationDefaultRootPolicyTypeEnable(ctx context.Context, conn *o
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
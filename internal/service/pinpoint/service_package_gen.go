@@ -11,72 +11,87 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "pinpoint"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_pinpoint_adm_channel",
+			Factory:  ResourceADMChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_apns_channel",
+			Factory:  ResourceAPNSChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_apns_sandbox_channel",
+			Factory:  ResourceAPNSSandboxChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_apns_voip_channel",
+			Factory:  ResourceAPNSVoIPChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_apns_voip_sandbox_channel",
+			Factory:  ResourceAPNSVoIPSandboxChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_app",
+			Factory:  ResourceApp,
+		},
+		{
+			TypeName: "aws_pinpoint_baidu_channel",
+			Factory:  ResourceBaiduChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_email_channel",
+			Factory:  ResourceEmailChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_event_stream",
+			Factory:  ResourceEventStream,
+		},
+		{
+			TypeName: "aws_pinpoint_gcm_channel",
+			Factory:  ResourceGCMChannel,
+		},
+		{
+			TypeName: "aws_pinpoint_sms_channel",
+			Factory:  ResourceSMSChannel,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "pinpoint"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_qldb_ledger")
 func ResourceLedger() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLedgerCreate,
@@ -280,7 +281,7 @@ func waitLedgerCreated(ctx context.Context, conn *qldb.QLDB, name string) (*qldb
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qldb.DescribeLedgerOutput); ok {
 		return output, err
@@ -298,7 +299,7 @@ func waitLedgerDeleted(ctx context.Context, conn *qldb.QLDB, name string) (*qldb
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qldb.DescribeLedgerOutput); ok {
 		return output, err
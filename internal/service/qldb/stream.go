@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_qldb_stream")
 func ResourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
@@ -317,7 +318,7 @@ func waitStreamCreated(ctx context.Context, conn *qldb.QLDB, ledgerName, streamI
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qldb.JournalKinesisStreamDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorCause)))
@@ -353,7 +354,7 @@ func waitStreamDeleted(ctx context.Context, conn *qldb.QLDB, ledgerName, streamI
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qldb.JournalKinesisStreamDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorCause)))
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_ram_resource_association")
 func ResourceResourceAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceAssociationCreate,
@@ -202,7 +203,7 @@ func waitForResourceShareResourceAssociation(ctx context.Context, conn *ram.RAM,
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -215,7 +216,7 @@ func WaitForResourceShareResourceDisassociation(ctx context.Context, conn *ram.R
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
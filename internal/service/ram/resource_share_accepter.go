@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_ram_resource_share_accepter")
 func ResourceResourceShareAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceShareAccepterCreate,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,7 +23,7 @@ func WaitResourceShareInvitationAccepted(ctx context.Context, conn *ram.RAM, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareInvitation); ok {
 		return v, err
@@ -40,7 +41,7 @@ func WaitResourceShareOwnedBySelfDisassociated(ctx context.Context, conn *ram.RA
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShare); ok {
 		return v, err
@@ -58,7 +59,7 @@ func WaitResourceShareOwnedBySelfActive(ctx context.Context, conn *ram.RAM, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShare); ok {
 		return v, err
@@ -76,7 +77,7 @@ func WaitResourceShareOwnedBySelfDeleted(ctx context.Context, conn *ram.RAM, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShare); ok {
 		return v, err
@@ -93,7 +94,7 @@ func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, re
 		Timeout: PrincipalAssociationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
@@ -110,7 +111,7 @@ func WaitResourceSharePrincipalDisassociated(ctx context.Context, conn *ram.RAM,
 		Timeout: PrincipalDisassociationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
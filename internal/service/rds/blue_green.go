@@ -2,25 +2,43 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 type cleanupWaiterFunc func(context.Context, ...tfresource.OptionsFunc)
 
-type cleanupWaiterErrFunc func(context.Context, ...tfresource.OptionsFunc) error //nolint:unused // WIP
+type cleanupWaiterErrFunc func(context.Context, ...tfresource.OptionsFunc) error
+
+// blueGreenHandler implements the resource type specific steps of a Blue/Green Deployment.
+type blueGreenHandler interface {
+	precondition(ctx context.Context, d *schema.ResourceData) error
+	createBlueGreenInput(d *schema.ResourceData) *rds_sdkv2.CreateBlueGreenDeploymentInput
+	// waitForTarget waits for the Green environment to be available and returns its identifier.
+	waitForTarget(ctx context.Context, targetARN string, deadline deadline) (string, error)
+	modifyTarget(ctx context.Context, identifier string, d *schema.ResourceData, timeout time.Duration, operation string) error
+	// deleteSource deletes the Blue environment after switchover and returns a waiter for its deletion.
+	deleteSource(ctx context.Context, sourceARN string, d *schema.ResourceData, deadline deadline) (cleanupWaiterErrFunc, error)
+}
 
 type blueGreenOrchestrator struct {
 	conn           *rds_sdkv2.Client
-	cleanupWaiters []cleanupWaiterFunc //nolint:unused // WIP
+	cleanupWaiters []cleanupWaiterFunc
 }
 
 func newBlueGreenOrchestrator(conn *rds_sdkv2.Client) *blueGreenOrchestrator {
@@ -29,7 +47,7 @@ func newBlueGreenOrchestrator(conn *rds_sdkv2.Client) *blueGreenOrchestrator {
 	}
 }
 
-func (o *blueGreenOrchestrator) cleanUp(ctx context.Context) { //nolint:unused // WIP
+func (o *blueGreenOrchestrator) cleanUp(ctx context.Context) {
 	if len(o.cleanupWaiters) == 0 {
 		return
 	}
@@ -43,6 +61,93 @@ func (o *blueGreenOrchestrator) cleanUp(ctx context.Context) { //nolint:unused /
 	}
 }
 
+// update applies the resource's changes using a Blue/Green Deployment: the Green environment is
+// created and modified, switched over to, and the old Blue environment is deleted.
+// resourceName is the name of the resource type used in messages, e.g. "RDS DB Instance".
+func (o *blueGreenOrchestrator) update(ctx context.Context, d *schema.ResourceData, handler blueGreenHandler, deadline deadline, resourceName string) (diags diag.Diagnostics) {
+	defer o.cleanUp(ctx)
+
+	err := handler.precondition(ctx, d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): %s", resourceName, d.Id(), err)
+	}
+
+	createIn := handler.createBlueGreenInput(d)
+
+	log.Printf("[DEBUG] Updating %s (%s): Creating Blue/Green Deployment", resourceName, d.Id())
+
+	dep, err := o.createDeployment(ctx, createIn)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): %s", resourceName, d.Id(), err)
+	}
+	deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
+	defer func() {
+		log.Printf("[DEBUG] Updating %s (%s): Deleting Blue/Green Deployment", resourceName, d.Id())
+
+		if dep == nil {
+			log.Printf("[DEBUG] Updating %s (%s): Deleting Blue/Green Deployment: deployment disappeared", resourceName, d.Id())
+			return
+		}
+
+		// Ensure that the Blue/Green Deployment is always cleaned up
+		input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: deploymentIdentifier,
+		}
+		if aws.StringValue(dep.Status) != "SWITCHOVER_COMPLETED" {
+			input.DeleteTarget = aws.Bool(true)
+		}
+		_, err := o.conn.DeleteBlueGreenDeployment(ctx, input)
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating %s (%s): deleting Blue/Green Deployment: %s", resourceName, d.Id(), err)
+			return
+		}
+
+		o.cleanupWaiters = append(o.cleanupWaiters, func(ctx context.Context, optFns ...tfresource.OptionsFunc) {
+			_, err := waitBlueGreenDeploymentDeleted(ctx, o.conn, aws.StringValue(deploymentIdentifier), deadline.remaining(), optFns...)
+			if err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating %s (%s): deleting Blue/Green Deployment: waiting for completion: %s", resourceName, d.Id(), err)
+			}
+		})
+	}()
+
+	dep, err = o.waitForDeploymentAvailable(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): %s", resourceName, d.Id(), err)
+	}
+
+	targetIdentifier, err := handler.waitForTarget(ctx, aws.StringValue(dep.Target), deadline)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): creating Blue/Green Deployment: waiting for Green environment: %s", resourceName, d.Id(), err)
+	}
+
+	err = handler.modifyTarget(ctx, targetIdentifier, d, deadline.remaining(), fmt.Sprintf("Updating %s (%s)", resourceName, d.Id()))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): %s", resourceName, d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updating %s (%s): Switching over Blue/Green Deployment", resourceName, d.Id())
+
+	dep, err = o.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): %s", resourceName, d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updating %s (%s): Deleting Blue/Green Deployment source", resourceName, d.Id())
+
+	waiter, err := handler.deleteSource(ctx, aws.StringValue(dep.Source), d, deadline)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): deleting Blue/Green Deployment source: %s", resourceName, d.Id(), err)
+	}
+
+	o.cleanupWaiters = append(o.cleanupWaiters, func(ctx context.Context, optFns ...tfresource.OptionsFunc) {
+		if err := waiter(ctx, optFns...); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating %s (%s): deleting Blue/Green Deployment source: waiting for completion: %s", resourceName, d.Id(), err)
+		}
+	})
+
+	return diags
+}
+
 func (o *blueGreenOrchestrator) createDeployment(ctx context.Context, input *rds_sdkv2.CreateBlueGreenDeploymentInput) (*types.BlueGreenDeployment, error) {
 	createOut, err := o.conn.CreateBlueGreenDeployment(ctx, input)
 	if err != nil {
@@ -157,6 +262,250 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 	return nil
 }
 
+func (h *instanceHandler) waitForTarget(ctx context.Context, targetARN string, deadline deadline) (string, error) {
+	arn, err := parseDBInstanceARN(targetARN)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := waitDBInstanceAvailableSDKv2(ctx, h.conn, arn.Identifier, deadline.remaining()); err != nil {
+		return "", err
+	}
+
+	return arn.Identifier, nil
+}
+
+func (h *instanceHandler) deleteSource(ctx context.Context, sourceARN string, d *schema.ResourceData, deadline deadline) (cleanupWaiterErrFunc, error) {
+	arn, err := parseDBInstanceARN(sourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Get("deletion_protection").(bool) {
+		input := &rds_sdkv2.ModifyDBInstanceInput{
+			ApplyImmediately:     true,
+			DBInstanceIdentifier: aws.String(arn.Identifier),
+			DeletionProtection:   aws.Bool(false),
+		}
+		err := dbInstanceModify(ctx, h.conn, input, deadline.remaining())
+		if err != nil {
+			return nil, fmt.Errorf("disabling deletion protection: %s", err)
+		}
+	}
+
+	input := &rds_sdkv2.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(arn.Identifier),
+		SkipFinalSnapshot:    true,
+	}
+	_, err = tfresource.RetryWhen(ctx, 5*time.Minute,
+		func() (any, error) {
+			return h.conn.DeleteDBInstance(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// Retry for IAM eventual consistency.
+			apiErr, ok := errs.As[smithy.APIError](err)
+			if ok && apiErr.ErrorCode() == errCodeInvalidParameterValue && strings.Contains(apiErr.ErrorMessage(), "IAM role ARN value is invalid or does not include the required permissions") {
+				return true, err
+			}
+
+			if ok && apiErr.ErrorCode() == errCodeInvalidParameterCombination && strings.Contains(apiErr.ErrorMessage(), "disable deletion pro") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+		return waitDBInstanceDeletedSDKv2(ctx, h.conn, arn.Identifier, deadline.remaining(), optFns...)
+	}, nil
+}
+
+type clusterHandler struct {
+	conn *rds.RDS
+}
+
+func newClusterHandler(conn *rds.RDS) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) precondition(ctx context.Context, d *schema.ResourceData) error {
+	// The Green environment copies the settings of the Blue environment.
+	if d.HasChange("deletion_protection") {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(d.Id()),
+			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
+		}
+
+		err := dbClusterModify(ctx, h.conn, input, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("setting pre-conditions: %s", err)
+		}
+	}
+	return nil
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds_sdkv2.CreateBlueGreenDeploymentInput {
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+	}
+
+	return input
+}
+
+func (h *clusterHandler) waitForTarget(ctx context.Context, targetARN string, deadline deadline) (string, error) {
+	arn, err := parseDBClusterARN(targetARN)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := waitDBClusterCreated(ctx, h.conn, arn.Identifier, deadline.remaining())
+	if err != nil {
+		return "", err
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		id := aws.StringValue(member.DBInstanceIdentifier)
+
+		if _, err := waitDBInstanceAvailableSDKv1(ctx, h.conn, id, deadline.remaining()); err != nil {
+			return "", fmt.Errorf("RDS Cluster Instance (%s): %s", id, err)
+		}
+	}
+
+	return arn.Identifier, nil
+}
+
+func (h *clusterHandler) modifyTarget(ctx context.Context, identifier string, d *schema.ResourceData, timeout time.Duration, operation string) error {
+	modifyInput := &rds.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(true),
+		DBClusterIdentifier: aws.String(identifier),
+	}
+
+	needsModify := clusterPopulateModify(modifyInput, d)
+
+	if needsModify {
+		log.Printf("[DEBUG] %s: Updating Green environment", operation)
+
+		err := dbClusterModify(ctx, h.conn, modifyInput, timeout)
+		if err != nil {
+			return fmt.Errorf("updating Green environment: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteSource deletes the Blue cluster. Its instances are deleted without final snapshots but,
+// unless skip_final_snapshot is set, a final snapshot of the cluster is taken, named after
+// final_snapshot_identifier and the time of deletion so that each deployment's snapshot is kept.
+func (h *clusterHandler) deleteSource(ctx context.Context, sourceARN string, d *schema.ResourceData, deadline deadline) (cleanupWaiterErrFunc, error) {
+	arn, err := parseDBClusterARN(sourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(arn.Identifier),
+		SkipFinalSnapshot:   aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	}
+
+	if !aws.BoolValue(input.SkipFinalSnapshot) {
+		v, ok := d.GetOk("final_snapshot_identifier")
+		if !ok {
+			return nil, errors.New("final_snapshot_identifier is required when skip_final_snapshot is false")
+		}
+
+		input.FinalDBSnapshotIdentifier = aws.String(blueGreenFinalSnapshotIdentifier(v.(string), time.Now()))
+	}
+
+	cluster, err := FindDBClusterByID(ctx, h.conn, arn.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(cluster.DeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(arn.Identifier),
+			DeletionProtection:  aws.Bool(false),
+		}
+		err := dbClusterModify(ctx, h.conn, input, deadline.remaining())
+		if err != nil {
+			return nil, fmt.Errorf("disabling deletion protection: %s", err)
+		}
+	}
+
+	// A cluster can only be deleted once all of its instances have been deleted.
+	var instanceIDs []string
+	for _, member := range cluster.DBClusterMembers {
+		id := aws.StringValue(member.DBInstanceIdentifier)
+
+		_, err := h.conn.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+
+		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("deleting RDS Cluster Instance (%s): %s", id, err)
+		}
+
+		instanceIDs = append(instanceIDs, id)
+	}
+
+	for _, id := range instanceIDs {
+		if _, err := waitDBInstanceDeleted(ctx, h.conn, id, deadline.remaining()); err != nil {
+			return nil, fmt.Errorf("deleting RDS Cluster Instance (%s): waiting for completion: %s", id, err)
+		}
+	}
+
+	_, err = tfresource.RetryWhen(ctx, clusterTimeoutDelete,
+		func() (interface{}, error) {
+			return h.conn.DeleteDBClusterWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, _ ...tfresource.OptionsFunc) error {
+		_, err := waitDBClusterDeleted(ctx, h.conn, arn.Identifier, deadline.remaining())
+		return err
+	}, nil
+}
+
+// blueGreenFinalSnapshotIdentifier returns the identifier of the final snapshot of a Blue cluster.
+func blueGreenFinalSnapshotIdentifier(finalSnapshotIdentifier string, now time.Time) string {
+	return fmt.Sprintf("%s-blue-%s", finalSnapshotIdentifier, now.UTC().Format("20060102150405"))
+}
+
 type deadline time.Time
 
 func NewDeadline(duration time.Duration) deadline {
@@ -0,0 +1,50 @@
+package rds
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBlueGreenFinalSnapshotIdentifier(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 2, 3, 4, 5, 6, 0, time.FixedZone("UTC-8", -8*60*60))
+
+	if got, want := blueGreenFinalSnapshotIdentifier("final", now), "final-blue-20230203120506"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestParseDBClusterARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		arn        string
+		identifier string
+		expectErr  bool
+	}{
+		{arn: "arn:aws:rds:us-west-2:123456789012:cluster:my-cluster-old1", identifier: "my-cluster-old1"},
+		{arn: "arn:aws:rds:us-west-2:123456789012:db:my-instance", expectErr: true},
+		{arn: "my-cluster", expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		got, err := parseDBClusterARN(testCase.arn)
+
+		if testCase.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected error", testCase.arn)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", testCase.arn, err)
+			continue
+		}
+
+		if got.Identifier != testCase.identifier {
+			t.Errorf("%s: expected identifier %q, got %q", testCase.arn, testCase.identifier, got.Identifier)
+		}
+	}
+}
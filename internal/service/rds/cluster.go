@@ -2,16 +2,19 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +24,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -29,6 +33,7 @@ const (
 	clusterTimeoutDelete                           = 2 * time.Minute
 )
 
+// @SDKResource("aws_rds_cluster")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -85,6 +90,19 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -457,7 +475,39 @@ func ResourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get("engine").(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				engineMode := d.Get("engine_mode").(string)
+				if engineMode != EngineModeProvisioned {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine_mode" is %q.`, engineMode)
+				}
+				return nil
+			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+				return nil
+			},
+		),
 	}
 }
 
@@ -1101,151 +1151,70 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if d.HasChangesExcept(
 		"allow_major_version_upgrade",
+		"apply_immediately",
+		"blue_green_update",
 		"final_snapshot_identifier",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
 		"tags", "tags_all") {
-		input := &rds.ModifyDBClusterInput{
-			ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
-			DBClusterIdentifier: aws.String(d.Id()),
-		}
-
-		if d.HasChange("allocated_storage") {
-			input.AllocatedStorage = aws.Int64(int64(d.Get("allocated_storage").(int)))
-		}
-
-		if v, ok := d.GetOk("allow_major_version_upgrade"); ok {
-			input.AllowMajorVersionUpgrade = aws.Bool(v.(bool))
-		}
-
-		if d.HasChange("backtrack_window") {
-			input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
-		}
-
-		if d.HasChange("backup_retention_period") {
-			input.BackupRetentionPeriod = aws.Int64(int64(d.Get("backup_retention_period").(int)))
-		}
-
-		if d.HasChange("copy_tags_to_snapshot") {
-			input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
-		}
-
-		if d.HasChange("db_cluster_instance_class") {
-			input.EngineVersion = aws.String(d.Get("db_cluster_instance_class").(string))
-		}
-
-		if d.HasChange("db_cluster_parameter_group_name") {
-			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
-		}
-
-		if d.HasChange("db_instance_parameter_group_name") {
-			input.DBInstanceParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
-		}
-
-		if d.HasChange("deletion_protection") {
-			input.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
-		}
-
-		if d.HasChange("enable_global_write_forwarding") {
-			input.EnableGlobalWriteForwarding = aws.Bool(d.Get("enable_global_write_forwarding").(bool))
-		}
-
-		if d.HasChange("enable_http_endpoint") {
-			input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
-		}
-
-		if d.HasChange("enabled_cloudwatch_logs_exports") {
-			oraw, nraw := d.GetChange("enabled_cloudwatch_logs_exports")
-			o := oraw.(*schema.Set)
-			n := nraw.(*schema.Set)
+		if d.Get("blue_green_update.0.enabled").(bool) {
+			orchestrator := newBlueGreenOrchestrator(meta.(*conns.AWSClient).RDSClient())
+			handler := newClusterHandler(conn)
 
-			input.CloudwatchLogsExportConfiguration = &rds.CloudwatchLogsExportConfiguration{
-				DisableLogTypes: flex.ExpandStringSet(o.Difference(n)),
-				EnableLogTypes:  flex.ExpandStringSet(n.Difference(o)),
+			diags = append(diags, orchestrator.update(ctx, d, handler, NewDeadline(d.Timeout(schema.TimeoutUpdate)), "RDS Cluster")...)
+			if diags.HasError() {
+				return diags
+			}
+		} else {
+			input := &rds.ModifyDBClusterInput{
+				ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
+				DBClusterIdentifier: aws.String(d.Id()),
 			}
-		}
-
-		if d.HasChange("engine_version") {
-			input.EngineVersion = aws.String(d.Get("engine_version").(string))
-		}
-
-		if d.HasChange("iam_database_authentication_enabled") {
-			input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
-		}
-
-		if d.HasChange("iops") {
-			input.Iops = aws.Int64(int64(d.Get("iops").(int)))
-		}
-
-		if d.HasChange("master_password") {
-			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
-		}
-
-		if d.HasChange("network_type") {
-			input.NetworkType = aws.String(d.Get("network_type").(string))
-		}
-
-		if d.HasChange("port") {
-			input.Port = aws.Int64(int64(d.Get("port").(int)))
-		}
-
-		if d.HasChange("preferred_backup_window") {
-			input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
-		}
 
-		if d.HasChange("preferred_maintenance_window") {
-			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
-		}
+			clusterPopulateModify(input, d)
 
-		if d.HasChange("scaling_configuration") {
-			if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				input.ScalingConfiguration = expandScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			if v, ok := d.GetOk("allow_major_version_upgrade"); ok {
+				input.AllowMajorVersionUpgrade = aws.Bool(v.(bool))
 			}
-		}
 
-		if d.HasChange("serverlessv2_scaling_configuration") {
-			if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			if d.HasChange("db_cluster_parameter_group_name") {
+				input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 			}
-		}
 
-		if d.HasChange("storage_type") {
-			input.StorageType = aws.String(d.Get("storage_type").(string))
-		}
+			if d.HasChange("db_instance_parameter_group_name") {
+				input.DBInstanceParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+			}
 
-		if d.HasChange("vpc_security_group_ids") {
-			if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-				input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
-			} else {
-				input.VpcSecurityGroupIds = aws.StringSlice(nil)
+			if d.HasChange("engine_version") {
+				input.EngineVersion = aws.String(d.Get("engine_version").(string))
 			}
-		}
 
-		_, err := tfresource.RetryWhen(ctx, 5*time.Minute,
-			func() (interface{}, error) {
-				return conn.ModifyDBClusterWithContext(ctx, input)
-			},
-			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
-					return true, err
-				}
+			_, err := tfresource.RetryWhen(ctx, 5*time.Minute,
+				func() (interface{}, error) {
+					return conn.ModifyDBClusterWithContext(ctx, input)
+				},
+				func(err error) (bool, error) {
+					if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
+						return true, err
+					}
 
-				if tfawserr.ErrCodeEquals(err, rds.ErrCodeInvalidDBClusterStateFault) {
-					return true, err
-				}
+					if tfawserr.ErrCodeEquals(err, rds.ErrCodeInvalidDBClusterStateFault) {
+						return true, err
+					}
 
-				return false, err
-			},
-		)
+					return false, err
+				},
+			)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
-		}
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+			}
 
-		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
+			if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -1311,6 +1280,155 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+func clusterPopulateModify(input *rds.ModifyDBClusterInput, d *schema.ResourceData) bool {
+	needsModify := false
+
+	if d.HasChange("allocated_storage") {
+		needsModify = true
+		input.AllocatedStorage = aws.Int64(int64(d.Get("allocated_storage").(int)))
+	}
+
+	if d.HasChange("backtrack_window") {
+		needsModify = true
+		input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
+	}
+
+	if d.HasChange("backup_retention_period") {
+		needsModify = true
+		input.BackupRetentionPeriod = aws.Int64(int64(d.Get("backup_retention_period").(int)))
+	}
+
+	if d.HasChange("copy_tags_to_snapshot") {
+		needsModify = true
+		input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
+	}
+
+	if d.HasChange("db_cluster_instance_class") {
+		needsModify = true
+		input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
+	}
+
+	if d.HasChange("deletion_protection") {
+		needsModify = true
+		input.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
+	}
+
+	if d.HasChange("enable_global_write_forwarding") {
+		needsModify = true
+		input.EnableGlobalWriteForwarding = aws.Bool(d.Get("enable_global_write_forwarding").(bool))
+	}
+
+	if d.HasChange("enable_http_endpoint") {
+		needsModify = true
+		input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
+	}
+
+	if d.HasChange("enabled_cloudwatch_logs_exports") {
+		needsModify = true
+		oraw, nraw := d.GetChange("enabled_cloudwatch_logs_exports")
+		o := oraw.(*schema.Set)
+		n := nraw.(*schema.Set)
+
+		input.CloudwatchLogsExportConfiguration = &rds.CloudwatchLogsExportConfiguration{
+			DisableLogTypes: flex.ExpandStringSet(o.Difference(n)),
+			EnableLogTypes:  flex.ExpandStringSet(n.Difference(o)),
+		}
+	}
+
+	if d.HasChange("iam_database_authentication_enabled") {
+		needsModify = true
+		input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
+	}
+
+	if d.HasChange("iops") {
+		needsModify = true
+		input.Iops = aws.Int64(int64(d.Get("iops").(int)))
+	}
+
+	if d.HasChange("master_password") {
+		needsModify = true
+		input.MasterUserPassword = aws.String(d.Get("master_password").(string))
+	}
+
+	if d.HasChange("network_type") {
+		needsModify = true
+		input.NetworkType = aws.String(d.Get("network_type").(string))
+	}
+
+	if d.HasChange("port") {
+		needsModify = true
+		input.Port = aws.Int64(int64(d.Get("port").(int)))
+	}
+
+	if d.HasChange("preferred_backup_window") {
+		needsModify = true
+		input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+	}
+
+	if d.HasChange("preferred_maintenance_window") {
+		needsModify = true
+		input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+	}
+
+	if d.HasChange("scaling_configuration") {
+		needsModify = true
+		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScalingConfiguration = expandScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("serverlessv2_scaling_configuration") {
+		needsModify = true
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("storage_type") {
+		needsModify = true
+		input.StorageType = aws.String(d.Get("storage_type").(string))
+	}
+
+	if d.HasChange("vpc_security_group_ids") {
+		needsModify = true
+		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+		} else {
+			input.VpcSecurityGroupIds = aws.StringSlice(nil)
+		}
+	}
+
+	return needsModify
+}
+
+func dbClusterModify(ctx context.Context, conn *rds.RDS, input *rds.ModifyDBClusterInput, timeout time.Duration) error {
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.ModifyDBClusterWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
+				return true, err
+			}
+
+			if tfawserr.ErrCodeEquals(err, rds.ErrCodeInvalidDBClusterStateFault) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, conn, aws.StringValue(input.DBClusterIdentifier), timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+	return nil
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn()
 
@@ -1458,6 +1576,32 @@ func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *r
 	compareActualEngineVersion(d, oldVersion, newVersion)
 }
 
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+var dbClusterARNResourceRegexp = regexp.MustCompile(`^cluster:([0-9a-z-]+)$`)
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	matches := dbClusterARNResourceRegexp.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
+
 func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
@@ -1514,7 +1658,7 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -1540,7 +1684,7 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -1564,7 +1708,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -1588,3 +1732,10 @@ func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) resource.Sta
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 const clusterSnapshotCreateTimeout = 2 * time.Minute
 
+// @SDKResource("aws_db_cluster_snapshot")
 func ResourceClusterSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterSnapshotCreate,
@@ -159,7 +160,7 @@ func resourceClusterSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Cluster Snapshot %q to create: %s", d.Id(), err)
 	}
@@ -1210,6 +1210,110 @@ func TestAccRDSCluster_engineVersionWithPrimaryInstance(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.initial", "version"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.updated", "version"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_members.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"allow_major_version_upgrade",
+					"apply_immediately",
+					"blue_green_update",
+					"cluster_identifier_prefix",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_applyImmediately(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_applyImmediately(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "apply_immediately", "false"),
+				),
+			},
+			{
+				// A Blue/Green Deployment's switchover replaces the cluster.
+				Config: testAccClusterConfig_BlueGreenDeployment_applyImmediately(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "apply_immediately", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_invalidEngine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_BlueGreenDeployment_invalidEngine(rName),
+				ExpectError: regexp.MustCompile(`"blue_green_update.enabled" cannot be set when "engine" is "aurora"`),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_GlobalClusterIdentifierEngineMode_global(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster1 rds.DBCluster
@@ -2305,6 +2409,16 @@ func testAccCheckClusterRecreated(i, j *rds.DBCluster) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckClusterNotRecreated(i, j *rds.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.ClusterCreateTime).Equal(aws.TimeValue(j.ClusterCreateTime)) {
+			return errors.New("RDS Cluster was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccClusterConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -2902,6 +3016,119 @@ resource "aws_rds_cluster_instance" "test" {
 `, rName, upgrade)
 }
 
+func testAccClusterConfig_BlueGreenDeployment_engineVersion(rName string, updated bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "initial" {
+  engine             = "aurora-mysql"
+  preferred_versions = ["8.0.mysql_aurora.3.02.0", "8.0.mysql_aurora.3.02.1"]
+}
+
+data "aws_rds_engine_version" "updated" {
+  engine             = data.aws_rds_engine_version.initial.engine
+  preferred_versions = ["8.0.mysql_aurora.3.02.2", "8.0.mysql_aurora.3.03.0"]
+}
+
+# Binary logging is required for Aurora MySQL Blue/Green Deployments.
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.initial.parameter_group_family
+
+  parameter {
+    name         = "binlog_format"
+    value        = "ROW"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = data.aws_rds_engine_version.initial.engine
+  engine_version                  = %[2]t ? data.aws_rds_engine_version.updated.version : data.aws_rds_engine_version.initial.version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.initial.engine
+  engine_version             = data.aws_rds_engine_version.initial.version
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+  apply_immediately  = true
+
+  lifecycle {
+    ignore_changes = [engine_version]
+  }
+}
+`, rName, updated)
+}
+
+func testAccClusterConfig_BlueGreenDeployment_applyImmediately(rName string, applyImmediately bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine             = "aurora-mysql"
+  preferred_versions = ["8.0.mysql_aurora.3.02.0", "8.0.mysql_aurora.3.02.1"]
+}
+
+# Binary logging is required for Aurora MySQL Blue/Green Deployments.
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.test.parameter_group_family
+
+  parameter {
+    name         = "binlog_format"
+    value        = "ROW"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  apply_immediately               = %[2]t
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = data.aws_rds_engine_version.test.engine
+  engine_version                  = data.aws_rds_engine_version.test.version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, applyImmediately)
+}
+
+func testAccClusterConfig_BlueGreenDeployment_invalidEngine(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  database_name       = "test"
+  engine              = "aurora"
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName)
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -26,10 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func init() {
-	_sp.registerFrameworkResourceFactory(newResourceExportTask)
-}
-
+// @FrameworkResource
 func newResourceExportTask(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceExportTask{}
 	r.SetDefaultCreateTimeout(60 * time.Minute)
@@ -316,7 +313,7 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ExportTask); ok {
 		return out, err
 	}
@@ -332,7 +329,7 @@ func waitExportTaskDeleted(ctx context.Context, conn *rds.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ExportTask); ok {
 		return out, err
 	}
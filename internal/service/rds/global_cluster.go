@@ -27,6 +27,7 @@ const (
 	globalClusterUpdateTimeout  = 90 * time.Minute
 )
 
+// @SDKResource("aws_rds_global_cluster")
 func ResourceGlobalCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGlobalClusterCreate,
@@ -463,7 +464,7 @@ func waitForGlobalClusterCreation(ctx context.Context, conn *rds.RDS, globalClus
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) availability", globalClusterID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -478,7 +479,7 @@ func waitForGlobalClusterUpdate(ctx context.Context, conn *rds.RDS, globalCluste
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) availability", globalClusterID)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -495,7 +496,7 @@ func WaitForGlobalClusterDeletion(ctx context.Context, conn *rds.RDS, globalClus
 		NotFoundChecks: 1,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -780,7 +781,7 @@ func WaitForClusterUpdate(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
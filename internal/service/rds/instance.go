@@ -33,6 +33,7 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// @SDKResource("aws_db_instance")
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceCreate,
@@ -1708,142 +1709,10 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if d.Get("blue_green_update.0.enabled").(bool) {
 			orchestrator := newBlueGreenOrchestrator(conn)
 			handler := newInstanceHandler(conn)
-			var cleaupWaiters []func(optFns ...tfresource.OptionsFunc)
-			defer func() {
-				if len(cleaupWaiters) == 0 {
-					return
-				}
-
-				waiter, waiters := cleaupWaiters[0], cleaupWaiters[1:]
-				waiter()
-				for _, waiter := range waiters {
-					// Skip the delay for subsequent waiters. Since we're waiting for all of the waiters
-					// to complete, we don't need to run them concurrently, saving on network traffic.
-					waiter(tfresource.WithDelay(0))
-				}
-			}()
-
-			err := handler.precondition(ctx, d)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
-			}
-
-			createIn := handler.createBlueGreenInput(d)
-
-			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Creating Blue/Green Deployment", d.Id())
-
-			dep, err := orchestrator.createDeployment(ctx, createIn)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
-			}
-			deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
-			defer func() {
-				log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment", d.Id())
-
-				if dep == nil {
-					log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment: deployment disappeared", d.Id())
-					return
-				}
-
-				// Ensure that the Blue/Green Deployment is always cleaned up
-				input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
-					BlueGreenDeploymentIdentifier: deploymentIdentifier,
-				}
-				if aws.StringValue(dep.Status) != "SWITCHOVER_COMPLETED" {
-					input.DeleteTarget = aws.Bool(true)
-				}
-				_, err = conn.DeleteBlueGreenDeployment(ctx, input)
-				if err != nil {
-					diags = sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
-					return
-				}
-
-				cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
-					_, err = waitBlueGreenDeploymentDeleted(ctx, conn, aws.StringValue(deploymentIdentifier), deadline.remaining(), optFns...)
-					if err != nil {
-						diags = sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
-					}
-				})
-			}()
-
-			dep, err = orchestrator.waitForDeploymentAvailable(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.remaining())
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
-			}
-
-			targetARN, err := parseDBInstanceARN(aws.StringValue(dep.Target))
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
-			}
-			_, err = waitDBInstanceAvailableSDKv2(ctx, conn, targetARN.Identifier, deadline.remaining())
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
-			}
-
-			err = handler.modifyTarget(ctx, targetARN.Identifier, d, deadline.remaining(), fmt.Sprintf("Updating RDS DB Instance (%s)", d.Id()))
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
-			}
-
-			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Switching over Blue/Green Deployment", d.Id())
-
-			dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.remaining())
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
-			}
-
-			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment source", d.Id())
-
-			sourceARN, err := parseDBInstanceARN(aws.StringValue(dep.Source))
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
-			}
-			if d.Get("deletion_protection").(bool) {
-				input := &rds_sdkv2.ModifyDBInstanceInput{
-					ApplyImmediately:     true,
-					DBInstanceIdentifier: aws.String(sourceARN.Identifier),
-					DeletionProtection:   aws.Bool(false),
-				}
-				err := dbInstanceModify(ctx, conn, input, deadline.remaining())
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: disabling deletion protection: %s", d.Id(), err)
-				}
-			}
-			deleteInput := &rds_sdkv2.DeleteDBInstanceInput{
-				DBInstanceIdentifier: aws.String(sourceARN.Identifier),
-				SkipFinalSnapshot:    true,
-			}
-			_, err = tfresource.RetryWhen(ctx, 5*time.Minute,
-				func() (any, error) {
-					return conn.DeleteDBInstance(ctx, deleteInput)
-				},
-				func(err error) (bool, error) {
-					// Retry for IAM eventual consistency.
-					apiErr, ok := errs.As[smithy.APIError](err)
-					if ok && apiErr.ErrorCode() == errCodeInvalidParameterValue && strings.Contains(apiErr.ErrorMessage(), "IAM role ARN value is invalid or does not include the required permissions") {
-						return true, err
-					}
-
-					if ok && apiErr.ErrorCode() == errCodeInvalidParameterCombination && strings.Contains(apiErr.ErrorMessage(), "disable deletion pro") {
-						return true, err
-					}
-
-					return false, err
-				},
-			)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
-			}
-
-			cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
-				_, err = waitDBInstanceDeleted(ctx, meta.(*conns.AWSClient).RDSConn(), sourceARN.Identifier, deadline.remaining(), optFns...)
-				if err != nil {
-					diags = sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
-				}
-			})
 
+			diags = append(diags, orchestrator.update(ctx, d, handler, deadline, "RDS DB Instance")...)
 			if diags.HasError() {
-				return
+				return diags
 			}
 		} else {
 			input := &rds_sdkv2.ModifyDBInstanceInput{
@@ -2325,7 +2194,7 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -2368,7 +2237,7 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -2409,7 +2278,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeou
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -2418,6 +2287,43 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeou
 	return nil, err
 }
 
+func waitDBInstanceDeletedSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	options := tfresource.Options{
+		PollInterval:              10 * time.Second,
+		Delay:                     1 * time.Minute,
+		ContinuousTargetOccurence: 3,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			InstanceStatusAvailable,
+			InstanceStatusBackingUp,
+			InstanceStatusConfiguringEnhancedMonitoring,
+			InstanceStatusConfiguringLogExports,
+			InstanceStatusCreating,
+			InstanceStatusDeleting,
+			InstanceStatusIncompatibleParameters,
+			InstanceStatusIncompatibleRestore,
+			InstanceStatusModifying,
+			InstanceStatusStarting,
+			InstanceStatusStopping,
+			InstanceStatusStorageFull,
+			InstanceStatusStorageOptimization,
+		},
+		Target:  []string{},
+		Refresh: statusDBInstanceSDKv2(ctx, conn, id),
+		Timeout: timeout,
+	}
+	options.Apply(stateConf)
+
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	return err
+}
+
 func statusDBInstanceSDKv1(ctx context.Context, conn *rds.RDS, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findDBInstanceByIDSDKv1(ctx, conn, id)
@@ -2499,7 +2405,7 @@ func waitBlueGreenDeploymentAvailable(ctx context.Context, conn *rds_sdkv2.Clien
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.BlueGreenDeployment); ok {
 		return output, err
@@ -2525,7 +2431,7 @@ func waitBlueGreenDeploymentSwitchoverCompleted(ctx context.Context, conn *rds_s
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.BlueGreenDeployment); ok {
 		if status := aws.StringValue(output.Status); status == "INVALID_CONFIGURATION" || status == "SWITCHOVER_FAILED" {
@@ -2555,7 +2461,7 @@ func waitBlueGreenDeploymentDeleted(ctx context.Context, conn *rds_sdkv2.Client,
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.BlueGreenDeployment); ok {
 		return output, err
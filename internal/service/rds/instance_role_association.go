@@ -30,6 +30,7 @@ const (
 	dbInstanceRoleAssociationDeletedTimeout = 10 * time.Minute
 )
 
+// @SDKResource("aws_db_instance_role_association")
 func ResourceInstanceRoleAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceRoleAssociationCreate,
@@ -202,7 +203,7 @@ func waitForDBInstanceRoleAssociation(ctx context.Context, conn *rds.RDS, dbInst
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS DB Instance (%s) IAM Role association: %s", dbInstanceIdentifier, roleArn)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -219,7 +220,7 @@ func WaitForDBInstanceRoleDisassociation(ctx context.Context, conn *rds.RDS, dbI
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS DB Instance (%s) IAM Role disassociation: %s", dbInstanceIdentifier, roleArn)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
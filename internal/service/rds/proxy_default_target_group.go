@@ -15,8 +15,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_db_proxy_default_target_group")
 func ResourceProxyDefaultTargetGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProxyDefaultTargetGroupCreate,
@@ -159,7 +161,7 @@ func resourceProxyDefaultTargetGroupCreateUpdate(ctx context.Context, d *schema.
 		Timeout: d.Timeout(timeout),
 	}
 
-	if _, err := stateChangeConf.WaitForStateContext(ctx); err != nil {
+	if _, err := tfresource.WaitForStateContext(ctx, stateChangeConf); err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating RDS DB Proxy (%s) default target group: waiting for completion: %s", d.Id(), err)
 	}
 
@@ -11,72 +11,190 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){
+		newResourceExportTask,
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_db_cluster_snapshot",
+			Factory:  DataSourceClusterSnapshot,
+		},
+		{
+			TypeName: "aws_db_event_categories",
+			Factory:  DataSourceEventCategories,
+		},
+		{
+			TypeName: "aws_db_instance",
+			Factory:  DataSourceInstance,
+		},
+		{
+			TypeName: "aws_db_instances",
+			Factory:  DataSourceInstances,
+		},
+		{
+			TypeName: "aws_db_proxy",
+			Factory:  DataSourceProxy,
+		},
+		{
+			TypeName: "aws_db_snapshot",
+			Factory:  DataSourceSnapshot,
+		},
+		{
+			TypeName: "aws_db_subnet_group",
+			Factory:  DataSourceSubnetGroup,
+		},
+		{
+			TypeName: "aws_rds_certificate",
+			Factory:  DataSourceCertificate,
+		},
+		{
+			TypeName: "aws_rds_cluster",
+			Factory:  DataSourceCluster,
+		},
+		{
+			TypeName: "aws_rds_clusters",
+			Factory:  DataSourceClusters,
+		},
+		{
+			TypeName: "aws_rds_engine_version",
+			Factory:  DataSourceEngineVersion,
+		},
+		{
+			TypeName: "aws_rds_orderable_db_instance",
+			Factory:  DataSourceOrderableInstance,
+		},
+		{
+			TypeName: "aws_rds_reserved_instance_offering",
+			Factory:  DataSourceReservedOffering,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "rds"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_db_cluster_snapshot",
+			Factory:  ResourceClusterSnapshot,
+		},
+		{
+			TypeName: "aws_db_event_subscription",
+			Factory:  ResourceEventSubscription,
+		},
+		{
+			TypeName: "aws_db_instance",
+			Factory:  ResourceInstance,
+		},
+		{
+			TypeName: "aws_db_instance_automated_backups_replication",
+			Factory:  ResourceInstanceAutomatedBackupsReplication,
+		},
+		{
+			TypeName: "aws_db_instance_role_association",
+			Factory:  ResourceInstanceRoleAssociation,
+		},
+		{
+			TypeName: "aws_db_option_group",
+			Factory:  ResourceOptionGroup,
+		},
+		{
+			TypeName: "aws_db_parameter_group",
+			Factory:  ResourceParameterGroup,
+		},
+		{
+			TypeName: "aws_db_proxy",
+			Factory:  ResourceProxy,
+		},
+		{
+			TypeName: "aws_db_proxy_default_target_group",
+			Factory:  ResourceProxyDefaultTargetGroup,
+		},
+		{
+			TypeName: "aws_db_proxy_endpoint",
+			Factory:  ResourceProxyEndpoint,
+		},
+		{
+			TypeName: "aws_db_proxy_target",
+			Factory:  ResourceProxyTarget,
+		},
+		{
+			TypeName: "aws_db_security_group",
+			Factory:  ResourceSecurityGroup,
+		},
+		{
+			TypeName: "aws_db_snapshot",
+			Factory:  ResourceSnapshot,
+		},
+		{
+			TypeName: "aws_db_snapshot_copy",
+			Factory:  ResourceSnapshotCopy,
+		},
+		{
+			TypeName: "aws_db_subnet_group",
+			Factory:  ResourceSubnetGroup,
+		},
+		{
+			TypeName: "aws_rds_cluster",
+			Factory:  ResourceCluster,
+		},
+		{
+			TypeName: "aws_rds_cluster_activity_stream",
+			Factory:  ResourceClusterActivityStream,
+		},
+		{
+			TypeName: "aws_rds_cluster_endpoint",
+			Factory:  ResourceClusterEndpoint,
+		},
+		{
+			TypeName: "aws_rds_cluster_instance",
+			Factory:  ResourceClusterInstance,
+		},
+		{
+			TypeName: "aws_rds_cluster_parameter_group",
+			Factory:  ResourceClusterParameterGroup,
+		},
+		{
+			TypeName: "aws_rds_cluster_role_association",
+			Factory:  ResourceClusterRoleAssociation,
+		},
+		{
+			TypeName: "aws_rds_global_cluster",
+			Factory:  ResourceGlobalCluster,
+		},
+		{
+			TypeName: "aws_rds_reserved_instance",
+			Factory:  ResourceReservedInstance,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "rds"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,7 +30,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -48,7 +49,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -68,7 +69,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *rds.RDS, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -89,7 +90,7 @@ func waitDBProxyEndpointAvailable(ctx context.Context, conn *rds.RDS, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -107,7 +108,7 @@ func waitDBProxyEndpointDeleted(ctx context.Context, conn *rds.RDS, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -124,7 +125,7 @@ func waitDBClusterRoleAssociationCreated(ctx context.Context, conn *rds.RDS, dbC
 		Timeout: dbClusterRoleAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -141,7 +142,7 @@ func waitDBClusterRoleAssociationDeleted(ctx context.Context, conn *rds.RDS, dbC
 		Timeout: dbClusterRoleAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -174,7 +175,7 @@ func waitDBClusterInstanceCreated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -207,7 +208,7 @@ func waitDBClusterInstanceUpdated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -230,7 +231,7 @@ func waitDBClusterInstanceDeleted(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -252,7 +253,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.RDS, dbClusterArn
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS Cluster Activity Stream (%s) to be started: %v", dbClusterArn, err)
 	}
@@ -272,7 +273,7 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.RDS, dbClusterArn
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS Cluster Activity Stream (%s) to be stopped: %v", dbClusterArn, err)
 	}
@@ -287,7 +288,7 @@ func waitDBInstanceAutomatedBackupCreated(ctx context.Context, conn *rds.RDS, ar
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
 		return output, err
@@ -306,7 +307,7 @@ func waitDBInstanceAutomatedBackupDeleted(ctx context.Context, conn *rds.RDS, db
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -323,7 +324,7 @@ func waitDBProxyCreated(ctx context.Context, conn *rds.RDS, name string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -340,7 +341,7 @@ func waitDBProxyDeleted(ctx context.Context, conn *rds.RDS, name string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -357,7 +358,7 @@ func waitDBProxyUpdated(ctx context.Context, conn *rds.RDS, name string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -379,7 +380,7 @@ func waitReservedInstanceCreated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:          30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
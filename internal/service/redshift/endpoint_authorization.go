@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_redshift_endpoint_authorization")
 func ResourceEndpointAuthorization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEndpointAuthorizationCreate,
@@ -11,72 +11,132 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_redshift_cluster",
+			Factory:  DataSourceCluster,
+		},
+		{
+			TypeName: "aws_redshift_cluster_credentials",
+			Factory:  DataSourceClusterCredentials,
+		},
+		{
+			TypeName: "aws_redshift_orderable_cluster",
+			Factory:  DataSourceOrderableCluster,
+		},
+		{
+			TypeName: "aws_redshift_service_account",
+			Factory:  DataSourceServiceAccount,
+		},
+		{
+			TypeName: "aws_redshift_subnet_group",
+			Factory:  DataSourceSubnetGroup,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "redshift"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_redshift_authentication_profile",
+			Factory:  ResourceAuthenticationProfile,
+		},
+		{
+			TypeName: "aws_redshift_cluster",
+			Factory:  ResourceCluster,
+		},
+		{
+			TypeName: "aws_redshift_cluster_iam_roles",
+			Factory:  ResourceClusterIAMRoles,
+		},
+		{
+			TypeName: "aws_redshift_endpoint_access",
+			Factory:  ResourceEndpointAccess,
+		},
+		{
+			TypeName: "aws_redshift_endpoint_authorization",
+			Factory:  ResourceEndpointAuthorization,
+		},
+		{
+			TypeName: "aws_redshift_event_subscription",
+			Factory:  ResourceEventSubscription,
+		},
+		{
+			TypeName: "aws_redshift_hsm_client_certificate",
+			Factory:  ResourceHSMClientCertificate,
+		},
+		{
+			TypeName: "aws_redshift_hsm_configuration",
+			Factory:  ResourceHSMConfiguration,
+		},
+		{
+			TypeName: "aws_redshift_parameter_group",
+			Factory:  ResourceParameterGroup,
+		},
+		{
+			TypeName: "aws_redshift_partner",
+			Factory:  ResourcePartner,
+		},
+		{
+			TypeName: "aws_redshift_scheduled_action",
+			Factory:  ResourceScheduledAction,
+		},
+		{
+			TypeName: "aws_redshift_security_group",
+			Factory:  ResourceSecurityGroup,
+		},
+		{
+			TypeName: "aws_redshift_snapshot_copy_grant",
+			Factory:  ResourceSnapshotCopyGrant,
+		},
+		{
+			TypeName: "aws_redshift_snapshot_schedule",
+			Factory:  ResourceSnapshotSchedule,
+		},
+		{
+			TypeName: "aws_redshift_snapshot_schedule_association",
+			Factory:  ResourceSnapshotScheduleAssociation,
+		},
+		{
+			TypeName: "aws_redshift_subnet_group",
+			Factory:  ResourceSubnetGroup,
+		},
+		{
+			TypeName: "aws_redshift_usage_limit",
+			Factory:  ResourceUsageLimit,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "redshift"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
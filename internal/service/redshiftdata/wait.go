@@ -25,7 +25,7 @@ func waitStatementFinished(ctx context.Context, conn *redshiftdataapiservice.Red
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftdataapiservice.DescribeStatementOutput); ok {
 		if status := aws.StringValue(output.Status); status == redshiftdataapiservice.StatusStringFailed {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/redshiftserverless"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Namespace, error) {
@@ -18,7 +19,7 @@ func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Redshift
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
 		return output, err
@@ -39,7 +40,7 @@ func waitNamespaceUpdated(ctx context.Context, conn *redshiftserverless.Redshift
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
 		return output, err
@@ -61,7 +62,7 @@ func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Redshi
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
 		return output, err
@@ -81,7 +82,7 @@ func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.Redshift
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
 		return output, err
@@ -103,7 +104,7 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.Reds
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.EndpointAccess); ok {
 		return output, err
@@ -122,7 +123,7 @@ func waitEndpointAccessDeleted(ctx context.Context, conn *redshiftserverless.Red
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.EndpointAccess); ok {
 		return output, err
@@ -143,7 +144,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *redshiftserverless.Redshif
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Snapshot); ok {
 		return output, err
@@ -162,7 +163,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *redshiftserverless.RedshiftS
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Snapshot); ok {
 		return output, err
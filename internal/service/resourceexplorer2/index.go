@@ -27,10 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func init() {
-	_sp.registerFrameworkResourceFactory(newResourceIndex)
-}
-
+// @FrameworkResource
 func newResourceIndex(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceIndex{}
 	r.SetDefaultCreateTimeout(2 * time.Hour)
@@ -333,7 +330,7 @@ func waitIndexCreated(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -350,7 +347,7 @@ func waitIndexUpdated(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -367,7 +364,7 @@ func waitIndexDeleted(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
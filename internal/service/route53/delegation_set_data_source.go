@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKDataSource("aws_route53_delegation_set")
 func DataSourceDelegationSet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegationSetRead,
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53_health_check")
 func ResourceHealthCheck() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHealthCheckCreate,
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_route53_hosted_zone_dnssec")
 func ResourceHostedZoneDNSSEC() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedZoneDNSSECCreate,
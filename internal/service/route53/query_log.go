@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53_query_log")
 func ResourceQueryLog() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueryLogCreate,
@@ -32,6 +32,7 @@ var (
 	errNoHostedZoneFound = errors.New("No matching Hosted Zone found")
 )
 
+// @SDKResource("aws_route53_record")
 func ResourceRecord() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -546,7 +547,7 @@ func WaitForRecordSetToSync(ctx context.Context, conn *route53.Route53, requestI
 			return resourceGoWait(ctx, conn, changeRequest)
 		},
 	}
-	_, err := wait.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &wait)
 	return err
 }
 
@@ -11,72 +11,100 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_route53_delegation_set",
+			Factory:  DataSourceDelegationSet,
+		},
+		{
+			TypeName: "aws_route53_traffic_policy_document",
+			Factory:  DataSourceTrafficPolicyDocument,
+		},
+		{
+			TypeName: "aws_route53_zone",
+			Factory:  DataSourceZone,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "route53"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_route53_delegation_set",
+			Factory:  ResourceDelegationSet,
+		},
+		{
+			TypeName: "aws_route53_health_check",
+			Factory:  ResourceHealthCheck,
+		},
+		{
+			TypeName: "aws_route53_hosted_zone_dnssec",
+			Factory:  ResourceHostedZoneDNSSEC,
+		},
+		{
+			TypeName: "aws_route53_key_signing_key",
+			Factory:  ResourceKeySigningKey,
+		},
+		{
+			TypeName: "aws_route53_query_log",
+			Factory:  ResourceQueryLog,
+		},
+		{
+			TypeName: "aws_route53_record",
+			Factory:  ResourceRecord,
+		},
+		{
+			TypeName: "aws_route53_traffic_policy",
+			Factory:  ResourceTrafficPolicy,
+		},
+		{
+			TypeName: "aws_route53_traffic_policy_instance",
+			Factory:  ResourceTrafficPolicyInstance,
+		},
+		{
+			TypeName: "aws_route53_vpc_association_authorization",
+			Factory:  ResourceVPCAssociationAuthorization,
+		},
+		{
+			TypeName: "aws_route53_zone",
+			Factory:  ResourceZone,
+		},
+		{
+			TypeName: "aws_route53_zone_association",
+			Factory:  ResourceZoneAssociation,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "route53"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
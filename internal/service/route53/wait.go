@@ -41,7 +41,7 @@ func waitChangeInfoStatusInsync(ctx context.Context, conn *route53.Route53, chan
 		Timeout:      changeTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.ChangeInfo); ok {
 		return output, err
@@ -58,7 +58,7 @@ func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Route5
 		Timeout:    hostedZoneDNSSECStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.DNSSECStatus); ok {
 		if serveSignature := aws.StringValue(output.ServeSignature); serveSignature == ServeSignatureInternalFailure {
@@ -79,7 +79,7 @@ func waitKeySigningKeyStatusUpdated(ctx context.Context, conn *route53.Route53,
 		Timeout:    keySigningKeyStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.KeySigningKey); ok {
 		if status := aws.StringValue(output.Status); status == KeySigningKeyStatusInternalFailure {
@@ -100,7 +100,7 @@ func waitTrafficPolicyInstanceStateCreated(ctx context.Context, conn *route53.Ro
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
 		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
@@ -121,7 +121,7 @@ func waitTrafficPolicyInstanceStateDeleted(ctx context.Context, conn *route53.Ro
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
 		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
@@ -142,7 +142,7 @@ func waitTrafficPolicyInstanceStateUpdated(ctx context.Context, conn *route53.Ro
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
 		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
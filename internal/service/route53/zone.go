@@ -33,6 +33,7 @@ const (
 	zoneChangeSyncMaxPollInterval = 30
 )
 
+// @SDKResource("aws_route53_zone")
 func ResourceZone() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneCreate,
@@ -714,7 +715,7 @@ func waitForChangeSynchronization(ctx context.Context, conn *route53.Route53, ch
 		},
 	}
 
-	_, err := conf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &conf)
 
 	return err
 }
@@ -15,8 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_route53_zone_association")
 func ResourceZoneAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneAssociationCreate,
@@ -93,7 +95,7 @@ func resourceZoneAssociationCreate(ctx context.Context, d *schema.ResourceData,
 			Refresh:    resourceZoneAssociationRefreshFunc(ctx, conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id)), d.Id()),
 		}
 
-		if _, err := wait.WaitForStateContext(ctx); err != nil {
+		if _, err := tfresource.WaitForStateContext(ctx, &wait); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Zone Association (%s) synchronization: %s", d.Id(), err)
 		}
 	}
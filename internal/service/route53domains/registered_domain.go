@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53domains_registered_domain")
 func ResourceRegisteredDomain() *schema.Resource {
 	contactSchema := &schema.Schema{
 		Type:     schema.TypeList,
@@ -716,7 +717,7 @@ func waitOperationSucceeded(ctx context.Context, conn *route53domains.Client, id
 		Refresh: statusOperation(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53domains.GetOperationDetailOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
@@ -6,6 +6,7 @@ import (
 
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,7 +23,7 @@ func waitClusterCreated(ctx context.Context, conn *r53rcc.Route53RecoveryControl
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeClusterOutput); ok {
 		return output, err
@@ -41,7 +42,7 @@ func waitClusterDeleted(ctx context.Context, conn *r53rcc.Route53RecoveryControl
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeClusterOutput); ok {
 		return output, err
@@ -59,7 +60,7 @@ func waitRoutingControlCreated(ctx context.Context, conn *r53rcc.Route53Recovery
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeRoutingControlOutput); ok {
 		return output, err
@@ -78,7 +79,7 @@ func waitRoutingControlDeleted(ctx context.Context, conn *r53rcc.Route53Recovery
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeRoutingControlOutput); ok {
 		return output, err
@@ -96,7 +97,7 @@ func waitControlPanelCreated(ctx context.Context, conn *r53rcc.Route53RecoveryCo
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeControlPanelOutput); ok {
 		return output, err
@@ -115,7 +116,7 @@ func waitControlPanelDeleted(ctx context.Context, conn *r53rcc.Route53RecoveryCo
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeControlPanelOutput); ok {
 		return output, err
@@ -133,7 +134,7 @@ func waitSafetyRuleCreated(ctx context.Context, conn *r53rcc.Route53RecoveryCont
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
 		return output, err
@@ -152,7 +153,7 @@ func waitSafetyRuleDeleted(ctx context.Context, conn *r53rcc.Route53RecoveryCont
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
 		return output, err
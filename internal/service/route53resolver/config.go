@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_route53_resolver_config")
 func ResourceConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConfigCreate,
@@ -200,7 +201,7 @@ func waitAutodefinedReverseEnabled(ctx context.Context, conn *route53resolver.Ro
 		Timeout: autodefinedReverseUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverConfig); ok {
 		return output, err
@@ -217,7 +218,7 @@ func waitAutodefinedReverseDisabled(ctx context.Context, conn *route53resolver.R
 		Timeout: autodefinedReverseUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverConfig); ok {
 		return output, err
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_route53_resolver_dnssec_config")
 func ResourceDNSSECConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDNSSECConfigCreate,
@@ -197,7 +198,7 @@ func waitDNSSECConfigCreated(ctx context.Context, conn *route53resolver.Route53R
 		Timeout: dnssecConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return output, err
@@ -214,7 +215,7 @@ func waitDNSSECConfigDeleted(ctx context.Context, conn *route53resolver.Route53R
 		Timeout: dnssecConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return output, err
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53_resolver_endpoint")
 func ResourceEndpoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEndpointCreate,
@@ -355,7 +356,7 @@ func waitEndpointCreated(ctx context.Context, conn *route53resolver.Route53Resol
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -376,7 +377,7 @@ func waitEndpointUpdated(ctx context.Context, conn *route53resolver.Route53Resol
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -397,7 +398,7 @@ func waitEndpointDeleted(ctx context.Context, conn *route53resolver.Route53Resol
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_route53_resolver_firewall_config")
 func ResourceFirewallConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFirewallConfigCreate,
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53_resolver_firewall_domain_list")
 func ResourceFirewallDomainList() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFirewallDomainListCreate,
@@ -285,7 +286,7 @@ func waitFirewallDomainListUpdated(ctx context.Context, conn *route53resolver.Ro
 		Timeout: firewallDomainListUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallDomainList); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.FirewallDomainListStatusCompleteImportFailed {
@@ -306,7 +307,7 @@ func waitFirewallDomainListDeleted(ctx context.Context, conn *route53resolver.Ro
 		Timeout: firewallDomainListDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallDomainList); ok {
 		return output, err
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53_resolver_firewall_rule_group_association")
 func ResourceFirewallRuleGroupAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFirewallRuleGroupAssociationCreate,
@@ -264,7 +265,7 @@ func waitFirewallRuleGroupAssociationCreated(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -283,7 +284,7 @@ func waitFirewallRuleGroupAssociationUpdated(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -302,7 +303,7 @@ func waitFirewallRuleGroupAssociationDeleted(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
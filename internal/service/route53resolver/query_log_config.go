@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53_resolver_query_log_config")
 func ResourceQueryLogConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueryLogConfigCreate,
@@ -227,7 +228,7 @@ func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Route5
 		Timeout: queryLogConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
 		return output, err
@@ -244,7 +245,7 @@ func waitQueryLogConfigDeleted(ctx context.Context, conn *route53resolver.Route5
 		Timeout: queryLogConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
 		return output, err
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_route53_resolver_rule")
 func ResourceRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRuleCreate,
@@ -324,7 +325,7 @@ func waitRuleCreated(ctx context.Context, conn *route53resolver.Route53Resolver,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverRule); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverRuleStatusFailed {
@@ -345,7 +346,7 @@ func waitRuleUpdated(ctx context.Context, conn *route53resolver.Route53Resolver,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverRule); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverRuleStatusFailed {
@@ -366,7 +367,7 @@ func waitRuleDeleted(ctx context.Context, conn *route53resolver.Route53Resolver,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverRule); ok {
 		if status := aws.StringValue(output.Status); status == route53resolver.ResolverRuleStatusFailed {
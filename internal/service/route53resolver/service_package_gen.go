@@ -11,72 +11,124 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_route53_resolver_endpoint",
+			Factory:  DataSourceEndpoint,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_config",
+			Factory:  DataSourceFirewallConfig,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_domain_list",
+			Factory:  DataSourceFirewallDomainList,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_rule_group",
+			Factory:  DataSourceFirewallRuleGroup,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_rule_group_association",
+			Factory:  DataSourceFirewallRuleGroupAssociation,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_rules",
+			Factory:  DataSourceResolverFirewallRules,
+		},
+		{
+			TypeName: "aws_route53_resolver_rule",
+			Factory:  DataSourceRule,
+		},
+		{
+			TypeName: "aws_route53_resolver_rules",
+			Factory:  DataSourceRules,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "route53resolver"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_route53_resolver_config",
+			Factory:  ResourceConfig,
+		},
+		{
+			TypeName: "aws_route53_resolver_dnssec_config",
+			Factory:  ResourceDNSSECConfig,
+		},
+		{
+			TypeName: "aws_route53_resolver_endpoint",
+			Factory:  ResourceEndpoint,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_config",
+			Factory:  ResourceFirewallConfig,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_domain_list",
+			Factory:  ResourceFirewallDomainList,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_rule",
+			Factory:  ResourceFirewallRule,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_rule_group",
+			Factory:  ResourceFirewallRuleGroup,
+		},
+		{
+			TypeName: "aws_route53_resolver_firewall_rule_group_association",
+			Factory:  ResourceFirewallRuleGroupAssociation,
+		},
+		{
+			TypeName: "aws_route53_resolver_query_log_config",
+			Factory:  ResourceQueryLogConfig,
+		},
+		{
+			TypeName: "aws_route53_resolver_query_log_config_association",
+			Factory:  ResourceQueryLogConfigAssociation,
+		},
+		{
+			TypeName: "aws_route53_resolver_rule",
+			Factory:  ResourceRule,
+		},
+		{
+			TypeName: "aws_route53_resolver_rule_association",
+			Factory:  ResourceRuleAssociation,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "route53resolver"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
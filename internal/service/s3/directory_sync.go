@@ -0,0 +1,889 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncDefaultConcurrency = 10
+
+	// Maximum number of keys in a single DeleteObjects request.
+	directorySyncDeleteBatchSize = 1000
+)
+
+// @SDKResource("aws_s3_directory_sync")
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDirectorySyncImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validDirectorySyncPattern,
+				},
+			},
+			"file_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validDirectorySyncPattern,
+						},
+						"server_side_encryption": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+						},
+					},
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	id := bucket
+	if keyPrefix != "" {
+		id = strings.Join([]string{bucket, keyPrefix}, resourceIDSeparator)
+	}
+
+	// The ID is set first so that the objects that were uploaded are tracked if some uploads fail.
+	d.SetId(id)
+
+	if err := directorySync(ctx, d, meta, map[string]interface{}{}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "syncing S3 Bucket (%s) prefix (%s): %s", bucket, keyPrefix, err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	keys, err := FindObjectKeysByPrefix(ctx, conn, bucket, directorySyncListPrefix(d.Get("key_prefix").(string)))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Directory Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	// Keys deleted outside of Terraform are dropped so that they are uploaded again.
+	// Unmanaged keys are only tracked when they are to be deleted.
+	objects := make(map[string]interface{})
+
+	for key, hash := range d.Get("objects").(map[string]interface{}) {
+		if _, ok := keys[key]; ok {
+			objects[key] = hash
+		}
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		for key := range keys {
+			if _, ok := objects[key]; !ok {
+				objects[key] = ""
+			}
+		}
+	}
+
+	d.Set("objects", objects)
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	o, _ := d.GetChange("objects")
+
+	if err := directorySync(ctx, d, meta, o.(map[string]interface{})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "syncing S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var keys []string
+
+	for key, hash := range d.Get("objects").(map[string]interface{}) {
+		// Unmanaged keys are left in place.
+		if hash.(string) != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s): %d objects", d.Id(), len(keys))
+	_, err := deleteObjectKeys(ctx, conn, d.Get("bucket").(string), keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Bucket names can't contain commas, key prefixes can.
+	bucket, keyPrefix, _ := strings.Cut(d.Id(), resourceIDSeparator)
+
+	d.Set("bucket", bucket)
+	d.Set("concurrency", directorySyncDefaultConcurrency)
+	d.Set("delete_extraneous", false)
+	d.Set("key_prefix", keyPrefix)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+
+	for _, key := range []string{"content_types", "exclude", "file_configuration", "key_prefix", "source"} {
+		if !rawConfig.GetAttr(key).IsWhollyKnown() {
+			return d.SetNewComputed("objects")
+		}
+	}
+
+	files, err := directorySyncFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	objects := make(map[string]interface{}, len(files))
+
+	for key, file := range files {
+		objects[key] = file.hash
+	}
+
+	// The plan shows the keys to be added, changed and removed.
+	return d.SetNew("objects", objects)
+}
+
+// directorySync uploads new and changed files and deletes removed keys.
+// old holds the previously synchronized keys and their hashes.
+// objects is set to the keys actually synchronized, so that keys whose upload or deletion
+// failed still differ from the configuration and are retried by the next apply.
+func directorySync(ctx context.Context, d *schema.ResourceData, meta interface{}, old map[string]interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+	bucket := d.Get("bucket").(string)
+
+	files, err := directorySyncFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	var uploads []*directorySyncFile
+
+	for key, file := range files {
+		if v, ok := old[key]; !ok || v.(string) != file.hash {
+			uploads = append(uploads, file)
+		}
+	}
+
+	// Unmanaged keys, which have an empty hash, are only deleted (and tracked) while delete_extraneous is set.
+	deleteExtraneous := d.Get("delete_extraneous").(bool)
+	var deletes []string
+
+	for key, hash := range old {
+		if _, ok := files[key]; ok {
+			continue
+		}
+
+		if hash.(string) == "" && !deleteExtraneous {
+			continue
+		}
+
+		deletes = append(deletes, key)
+	}
+
+	log.Printf("[DEBUG] Syncing S3 Bucket (%s): uploading %d objects, deleting %d objects", bucket, len(uploads), len(deletes))
+
+	var errs *multierror.Error
+
+	uploaded, err := uploadDirectorySyncFiles(ctx, conn, bucket, uploads, d.Get("concurrency").(int))
+
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	deleted, err := deleteObjectKeys(ctx, conn, bucket, deletes)
+
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	objects := make(map[string]interface{}, len(files))
+
+	for key, hash := range old {
+		if hash.(string) == "" && !deleteExtraneous {
+			continue
+		}
+
+		objects[key] = hash
+	}
+
+	for _, key := range uploaded {
+		objects[key] = files[key].hash
+	}
+
+	for _, key := range deleted {
+		delete(objects, key)
+	}
+
+	d.Set("objects", objects)
+
+	if err := errs.ErrorOrNil(); err != nil {
+		// Don't save the planned values of the other arguments.
+		d.Partial(true)
+
+		return err
+	}
+
+	return nil
+}
+
+type directorySyncObjectSettings struct {
+	CacheControl         string            `json:",omitempty"`
+	ContentEncoding      string            `json:",omitempty"`
+	ContentType          string            `json:",omitempty"`
+	KMSKeyID             string            `json:",omitempty"`
+	Metadata             map[string]string `json:",omitempty"`
+	ServerSideEncryption string            `json:",omitempty"`
+}
+
+type directorySyncFile struct {
+	hash     string
+	key      string
+	path     string
+	settings *directorySyncObjectSettings
+}
+
+type directorySyncFileConfiguration struct {
+	pattern  *regexp.Regexp
+	settings *directorySyncObjectSettings
+}
+
+type resourceDataGetter interface {
+	Get(string) interface{}
+}
+
+// directorySyncFiles returns the files under the configured source directory keyed by object key.
+func directorySyncFiles(d resourceDataGetter) (map[string]*directorySyncFile, error) {
+	source, err := homedir.Expand(d.Get("source").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source: %w", err)
+	}
+
+	var excludes []*regexp.Regexp
+
+	for _, v := range d.Get("exclude").(*schema.Set).List() {
+		re, err := directorySyncPatternRegexp(v.(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		excludes = append(excludes, re)
+	}
+
+	configurations, err := expandDirectorySyncFileConfigurations(d.Get("file_configuration").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	contentTypes := flex.ExpandStringValueMap(d.Get("content_types").(map[string]interface{}))
+	keyPrefix := d.Get("key_prefix").(string)
+	files := make(map[string]*directorySyncFile)
+
+	err = filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Symbolic links to files are followed, other non-regular files are skipped.
+		if entry.Type()&fs.ModeSymlink != 0 {
+			fi, err := os.Stat(path)
+
+			if err != nil {
+				return err
+			}
+
+			if !fi.Mode().IsRegular() {
+				return nil
+			}
+		} else if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(source, path)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		for _, re := range excludes {
+			if re.MatchString(rel) {
+				return nil
+			}
+		}
+
+		settings := &directorySyncObjectSettings{
+			ContentType: directorySyncContentType(path, contentTypes),
+		}
+
+		for _, configuration := range configurations {
+			if configuration.pattern.MatchString(rel) {
+				settings.merge(configuration.settings)
+			}
+		}
+
+		hash, err := directorySyncFileHash(path, settings)
+
+		if err != nil {
+			return err
+		}
+
+		key := directorySyncKey(keyPrefix, rel)
+		files[key] = &directorySyncFile{
+			hash:     hash,
+			key:      key,
+			path:     path,
+			settings: settings,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", source, err)
+	}
+
+	return files, nil
+}
+
+func expandDirectorySyncFileConfigurations(tfList []interface{}) ([]*directorySyncFileConfiguration, error) {
+	var configurations []*directorySyncFileConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		re, err := directorySyncPatternRegexp(tfMap["pattern"].(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		settings := &directorySyncObjectSettings{}
+
+		if v, ok := tfMap["cache_control"].(string); ok {
+			settings.CacheControl = v
+		}
+
+		if v, ok := tfMap["content_encoding"].(string); ok {
+			settings.ContentEncoding = v
+		}
+
+		if v, ok := tfMap["content_type"].(string); ok {
+			settings.ContentType = v
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok {
+			settings.KMSKeyID = v
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok && len(v) > 0 {
+			settings.Metadata = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["server_side_encryption"].(string); ok {
+			settings.ServerSideEncryption = v
+		}
+
+		configurations = append(configurations, &directorySyncFileConfiguration{
+			pattern:  re,
+			settings: settings,
+		})
+	}
+
+	return configurations, nil
+}
+
+// merge overrides settings with the non-empty values of other. Metadata is merged key by key.
+func (s *directorySyncObjectSettings) merge(other *directorySyncObjectSettings) {
+	if other.CacheControl != "" {
+		s.CacheControl = other.CacheControl
+	}
+
+	if other.ContentEncoding != "" {
+		s.ContentEncoding = other.ContentEncoding
+	}
+
+	if other.ContentType != "" {
+		s.ContentType = other.ContentType
+	}
+
+	if other.KMSKeyID != "" {
+		s.KMSKeyID = other.KMSKeyID
+	}
+
+	if len(other.Metadata) > 0 {
+		if s.Metadata == nil {
+			s.Metadata = make(map[string]string)
+		}
+
+		for k, v := range other.Metadata {
+			s.Metadata[k] = v
+		}
+	}
+
+	if other.ServerSideEncryption != "" {
+		s.ServerSideEncryption = other.ServerSideEncryption
+	}
+}
+
+// directorySyncFileHash returns a hash of the file content and the object settings,
+// so that changes to either cause the object to be uploaded again.
+func directorySyncFileHash(path string, settings *directorySyncObjectSettings) (string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	h := sha256.New()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	// Map keys are marshalled in sorted order.
+	b, err := json.Marshal(settings)
+
+	if err != nil {
+		return "", err
+	}
+
+	h.Write(b)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func directorySyncKey(keyPrefix, rel string) string {
+	if keyPrefix == "" {
+		return rel
+	}
+
+	return strings.TrimSuffix(keyPrefix, "/") + "/" + rel
+}
+
+func directorySyncListPrefix(keyPrefix string) string {
+	if keyPrefix == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(keyPrefix, "/") + "/"
+}
+
+// directorySyncPatternRegexp converts a glob pattern to a regular expression.
+// "*" and "?" match within a single path segment, "**" matches across segments
+// and "[...]" matches a character class.
+func directorySyncPatternRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	sb.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i:], ']')
+
+			if end < 0 {
+				return nil, fmt.Errorf("invalid pattern (%s): unterminated character class", pattern)
+			}
+
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			sb.WriteString("[" + class + "]")
+			i += end
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern (%s): %w", pattern, err)
+	}
+
+	return re, nil
+}
+
+func validDirectorySyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := directorySyncPatternRegexp(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// FindObjectKeysByPrefix returns the keys and ETags of the current object versions under the specified prefix.
+func FindObjectKeysByPrefix(ctx context.Context, conn *s3.S3, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	keys := make(map[string]string)
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			keys[aws.StringValue(v.Key)] = aws.StringValue(v.ETag)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// uploadDirectorySyncFiles uploads files with at most concurrency uploads in flight.
+// It returns the keys of the objects that were uploaded, even if some uploads failed.
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.S3, bucket string, files []*directorySyncFile, concurrency int) ([]string, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].key < files[j].key
+	})
+
+	uploader := s3manager.NewUploaderWithClient(conn)
+	semaphore := make(chan struct{}, concurrency)
+
+	var (
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		uploaded  []string
+		uploadErr *multierror.Error
+	)
+
+	for _, file := range files {
+		file := file
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return uploaded, multierror.Append(uploadErr, ctx.Err()).ErrorOrNil()
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			err := uploadDirectorySyncFile(ctx, uploader, bucket, file)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				uploadErr = multierror.Append(uploadErr, err)
+			} else {
+				uploaded = append(uploaded, file.key)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return uploaded, uploadErr.ErrorOrNil()
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *s3manager.Uploader, bucket string, file *directorySyncFile) error {
+	body, err := os.Open(file.path)
+
+	if err != nil {
+		return fmt.Errorf("opening (%s): %w", file.path, err)
+	}
+
+	defer body.Close()
+
+	input := &s3manager.UploadInput{
+		Body:   body,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(file.key),
+	}
+
+	if v := file.settings.CacheControl; v != "" {
+		input.CacheControl = aws.String(v)
+	}
+
+	if v := file.settings.ContentEncoding; v != "" {
+		input.ContentEncoding = aws.String(v)
+	}
+
+	if v := file.settings.ContentType; v != "" {
+		input.ContentType = aws.String(v)
+	}
+
+	if v := file.settings.Metadata; len(v) > 0 {
+		input.Metadata = aws.StringMap(v)
+	}
+
+	if v := file.settings.ServerSideEncryption; v != "" {
+		input.ServerSideEncryption = aws.String(v)
+	}
+
+	if v := file.settings.KMSKeyID; v != "" {
+		input.SSEKMSKeyId = aws.String(v)
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("uploading object (%s): %w", file.key, err)
+	}
+
+	return nil
+}
+
+// deleteObjectKeys deletes the current versions of the specified keys in batches.
+// It returns the keys that were deleted, or didn't exist, even if some deletions failed.
+func deleteObjectKeys(ctx context.Context, conn *s3.S3, bucket string, keys []string) ([]string, error) {
+	sort.Strings(keys)
+
+	var (
+		deleted    []string
+		deleteErrs *multierror.Error
+	)
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > directorySyncDeleteBatchSize {
+			n = directorySyncDeleteBatchSize
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Quiet: aws.Bool(true),
+			},
+		}
+
+		for _, key := range keys[:n] {
+			input.Delete.Objects = append(input.Delete.Objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		batch := keys[:n]
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjectsWithContext(ctx, input)
+
+		if err != nil {
+			return deleted, multierror.Append(deleteErrs, err).ErrorOrNil()
+		}
+
+		failed := make(map[string]bool)
+
+		for _, v := range output.Errors {
+			if aws.StringValue(v.Code) == s3.ErrCodeNoSuchKey {
+				continue
+			}
+
+			failed[aws.StringValue(v.Key)] = true
+			deleteErrs = multierror.Append(deleteErrs, newDeleteObjectVersionError(v))
+		}
+
+		for _, key := range batch {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+
+	return deleted, deleteErrs.ErrorOrNil()
+}
@@ -0,0 +1,73 @@
+package s3
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// directorySyncContentTypes maps lower-case file extensions to the content types of their objects.
+// The table is bundled, rather than read from the host's MIME database, so that object hashes
+// and therefore plans are the same on every machine.
+var directorySyncContentTypes = map[string]string{
+	".avif":        "image/avif",
+	".bmp":         "image/bmp",
+	".css":         "text/css; charset=utf-8",
+	".csv":         "text/csv; charset=utf-8",
+	".eot":         "application/vnd.ms-fontobject",
+	".gif":         "image/gif",
+	".gz":          "application/gzip",
+	".htm":         "text/html; charset=utf-8",
+	".html":        "text/html; charset=utf-8",
+	".ico":         "image/vnd.microsoft.icon",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript; charset=utf-8",
+	".json":        "application/json",
+	".map":         "application/json",
+	".md":          "text/markdown; charset=utf-8",
+	".mjs":         "text/javascript; charset=utf-8",
+	".mp3":         "audio/mpeg",
+	".mp4":         "video/mp4",
+	".oga":         "audio/ogg",
+	".ogg":         "audio/ogg",
+	".ogv":         "video/ogg",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".svg":         "image/svg+xml",
+	".tar":         "application/x-tar",
+	".tif":         "image/tiff",
+	".tiff":        "image/tiff",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain; charset=utf-8",
+	".wasm":        "application/wasm",
+	".wav":         "audio/wav",
+	".webm":        "video/webm",
+	".webmanifest": "application/manifest+json",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xml":         "text/xml; charset=utf-8",
+	".yaml":        "application/yaml",
+	".yml":         "application/yaml",
+	".zip":         "application/zip",
+}
+
+// directorySyncContentType returns the content type for the file's extension.
+// overrides, keyed by extension with or without the leading ".", take precedence over the bundled table.
+// An empty string is returned for unknown extensions, leaving the S3 default content type.
+func directorySyncContentType(path string, overrides map[string]string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+
+	if ext == "" {
+		return ""
+	}
+
+	for k, v := range overrides {
+		if strings.ToLower("."+strings.TrimPrefix(k, ".")) == ext {
+			return v
+		}
+	}
+
+	return directorySyncContentTypes[ext]
+}
@@ -0,0 +1,80 @@
+package s3
+
+import (
+	"testing"
+)
+
+func TestDirectorySyncPatternRegexp(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{pattern: "*.html", path: "index.html", match: true},
+		{pattern: "*.html", path: "docs/index.html", match: false},
+		{pattern: "**/*.html", path: "index.html", match: true},
+		{pattern: "**/*.html", path: "docs/api/index.html", match: true},
+		{pattern: "assets/**", path: "assets/img/logo.png", match: true},
+		{pattern: "assets/**", path: "static/assets/logo.png", match: false},
+		{pattern: "file?.txt", path: "file1.txt", match: true},
+		{pattern: "file?.txt", path: "file/.txt", match: false},
+		{pattern: "[ab].css", path: "a.css", match: true},
+		{pattern: "[!ab].css", path: "a.css", match: false},
+		{pattern: "[!ab].css", path: "c.css", match: true},
+		{pattern: "a+b.txt", path: "a+b.txt", match: true},
+		{pattern: "a+b.txt", path: "aab.txt", match: false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.pattern+" "+testCase.path, func(t *testing.T) {
+			t.Parallel()
+
+			re, err := directorySyncPatternRegexp(testCase.pattern)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := re.MatchString(testCase.path); got != testCase.match {
+				t.Errorf("expected match %t, got %t", testCase.match, got)
+			}
+		})
+	}
+
+	if _, err := directorySyncPatternRegexp("[abc"); err == nil {
+		t.Error("expected error for unterminated character class, got none")
+	}
+}
+
+func TestDirectorySyncContentType(t *testing.T) {
+	t.Parallel()
+
+	overrides := map[string]string{
+		"md":    "text/x-markdown",
+		".WASM": "application/octet-stream",
+	}
+
+	testCases := []struct {
+		path      string
+		overrides map[string]string
+		expected  string
+	}{
+		{path: "index.html", expected: "text/html; charset=utf-8"},
+		{path: "img/LOGO.PNG", expected: "image/png"},
+		{path: "README", expected: ""},
+		{path: "archive.unknown", expected: ""},
+		{path: "README.md", expected: "text/markdown; charset=utf-8"},
+		{path: "README.md", overrides: overrides, expected: "text/x-markdown"},
+		{path: "app.wasm", overrides: overrides, expected: "application/octet-stream"},
+		{path: "index.html", overrides: overrides, expected: "text/html; charset=utf-8"},
+	}
+
+	for _, testCase := range testCases {
+		if got := directorySyncContentType(testCase.path, testCase.overrides); got != testCase.expected {
+			t.Errorf("directorySyncContentType(%q, %v) = %q, expected %q", testCase.path, testCase.overrides, got, testCase.expected)
+		}
+	}
+}
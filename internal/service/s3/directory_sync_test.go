@@ -0,0 +1,291 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccDirectorySyncWriteFile(t, source, "index.html", "<html></html>")
+	testAccDirectorySyncWriteFile(t, source, "css/site.css", "body {}")
+	testAccDirectorySyncWriteFile(t, source, "tmp/scratch.txt", "ignored")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/css/site.css"),
+					testAccCheckDirectorySyncObject(ctx, rName, "site/index.html", "text/html; charset=utf-8", "max-age=60"),
+					testAccCheckDirectorySyncObject(ctx, rName, "site/css/site.css", "text/css; charset=utf-8", "max-age=3600"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFile(t, source, "index.html", "<html><body></body></html>")
+					testAccDirectorySyncWriteFile(t, source, "js/app.js", "void 0;")
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/js/app.js"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, rName, "site/css/site.css"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The hashes of existing objects are unknown until the next apply uploads the files.
+				ImportStateVerifyIgnore: []string{"content_types", "exclude", "file_configuration", "objects", "source"},
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccDirectorySyncWriteFile(t, source, "index.html", "<html></html>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+					_, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+						Bucket: aws.String(rName),
+						Key:    aws.String("site/extraneous.txt"),
+					})
+					if err != nil {
+						t.Fatalf("putting S3 Object: %s", err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, rName, "site/extraneous.txt"),
+				),
+			},
+			{
+				// Unmanaged objects are no longer deleted once delete_extraneous is unset.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+					_, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+						Bucket: aws.String(rName),
+						Key:    aws.String("site/unmanaged.txt"),
+					})
+					if err != nil {
+						t.Fatalf("putting S3 Object: %s", err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteExtraneousDisabled(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+					testAccCheckDirectorySyncObjectExists(ctx, rName, "site/unmanaged.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			keys, err := tfs3.FindObjectKeysByPrefix(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+			if err != nil {
+				// The bucket may have been destroyed first.
+				continue
+			}
+
+			for key := range keys {
+				if _, ok := rs.Primary.Attributes["objects."+key]; ok {
+					return fmt.Errorf("S3 Directory Sync %s object %s still exists", rs.Primary.ID, key)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObject(ctx context.Context, bucket, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (%s): %w", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) content type: expected %q, got %q", key, contentType, got)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) cache control: expected %q, got %q", key, cacheControl, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		keys, err := tfs3.FindObjectKeysByPrefix(ctx, conn, bucket, key)
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := keys[key]; ok {
+			return fmt.Errorf("S3 Object (%s) still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		keys, err := tfs3.FindObjectKeysByPrefix(ctx, conn, bucket, key)
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := keys[key]; !ok {
+			return fmt.Errorf("S3 Object (%s) not found", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site"
+  source     = %[2]q
+
+  exclude = ["tmp/**"]
+
+  file_configuration {
+    pattern       = "**"
+    cache_control = "max-age=60"
+  }
+
+  file_configuration {
+    pattern       = "**/*.css"
+    cache_control = "max-age=3600"
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_deleteExtraneous(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site"
+  source            = %[2]q
+  delete_extraneous = true
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_deleteExtraneousDisabled(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site"
+  source            = %[2]q
+  delete_extraneous = false
+}
+`, rName, source)
+}
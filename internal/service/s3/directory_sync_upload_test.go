@@ -0,0 +1,103 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/google/go-cmp/cmp"
+)
+
+// newDirectorySyncTestConn returns a client for a stand-in S3 endpoint that fails
+// PutObject for keys containing "fail" and reports DeleteObjects errors for them.
+func newDirectorySyncTestConn(t *testing.T) *s3.S3 {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && strings.Contains(r.URL.Path, "fail"):
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `<Error><Code>InternalError</Code><Message>failed</Message></Error>`)
+		case r.Method == http.MethodPut:
+			w.Header().Set("ETag", `"etag"`)
+		case r.Method == http.MethodPost && r.URL.Query().Has("delete"):
+			fmt.Fprint(w, `<DeleteResult><Error><Key>fail.txt</Key><Code>AccessDenied</Code><Message>denied</Message></Error></DeleteResult>`)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("mock", "mock", ""),
+		Endpoint:         aws.String(server.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+	}))
+
+	return s3.New(sess)
+}
+
+func TestUploadDirectorySyncFiles_partialFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := newDirectorySyncTestConn(t)
+	dir := t.TempDir()
+
+	var files []*directorySyncFile
+
+	for _, key := range []string{"a.txt", "fail.txt", "b.txt"} {
+		path := filepath.Join(dir, key)
+
+		if err := os.WriteFile(path, []byte(key), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		files = append(files, &directorySyncFile{
+			key:      key,
+			path:     path,
+			settings: &directorySyncObjectSettings{},
+		})
+	}
+
+	uploaded, err := uploadDirectorySyncFiles(ctx, conn, "bucket", files, 2)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	sort.Strings(uploaded)
+
+	if diff := cmp.Diff(uploaded, []string{"a.txt", "b.txt"}); diff != "" {
+		t.Errorf("unexpected uploaded keys (-got +want):\n%s", diff)
+	}
+}
+
+func TestDeleteObjectKeys_partialFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := newDirectorySyncTestConn(t)
+
+	deleted, err := deleteObjectKeys(ctx, conn, "bucket", []string{"b.txt", "fail.txt", "a.txt"})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if diff := cmp.Diff(deleted, []string{"a.txt", "b.txt"}); diff != "" {
+		t.Errorf("unexpected deleted keys (-got +want):\n%s", diff)
+	}
+}
@@ -34,6 +34,7 @@ import (
 
 const objectCreationTimeout = 2 * time.Minute
 
+// @SDKResource("aws_s3_object")
 func ResourceObject() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceObjectCreate,
@@ -72,6 +73,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -207,6 +229,11 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		Key:    aws.String(key),
 	}
 
+	// Retrieving checksums requires kms:Decrypt for SSE-KMS encrypted objects.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.RetryContext(ctx, objectCreationTimeout, func() *resource.RetryError {
@@ -243,6 +270,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -432,42 +463,14 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
+	body, closeBody, err := objectBody(d)
 
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "uploading S3 object: %s", err)
 	}
 
+	defer closeBody()
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
@@ -540,12 +543,34 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	var optFns []func(*s3manager.Uploader)
+	checksumAlgorithm := d.Get("checksum_algorithm").(string)
+
+	if checksumAlgorithm != "" {
+		input.ChecksumAlgorithm = aws.String(checksumAlgorithm)
+		optFns = append(optFns, func(u *s3manager.Uploader) {
+			u.RequestOptions = append(u.RequestOptions, objectChecksumUploadOption(checksumAlgorithm))
+		})
+	}
+
+	output, err := uploader.Upload(input, optFns...)
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
 	d.SetId(key)
 
+	if checksumAlgorithm != "" {
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying S3 Bucket (%s) Object (%s) checksum: %s", bucket, key, err)
+		}
+
+		if err := verifyObjectChecksum(ctx, conn, bucket, key, aws.StringValue(output.VersionID), checksumAlgorithm, body); err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying S3 Bucket (%s) Object (%s) checksum: %s", bucket, key, err)
+		}
+	}
+
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
@@ -582,7 +607,7 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
-		return d.SetNewComputed("version_id")
+		return setObjectContentNewComputed(d, "version_id")
 	}
 
 	if d.HasChange("source_hash") {
@@ -590,13 +615,111 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 		d.SetNewComputed("etag")
 	}
 
+	// Detect objects replaced outside of Terraform. A replaced object may have no checksum at all.
+	if v, ok := d.GetOk("checksum_algorithm"); ok && d.Id() != "" {
+		checksumAlgorithm := v.(string)
+		attribute := objectChecksumAttributes[checksumAlgorithm]
+
+		if !d.NewValueKnown(attribute) || d.Get(attribute).(string) == "" {
+			log.Printf("[DEBUG] S3 Object (%s) has no %s checksum, uploading", d.Id(), checksumAlgorithm)
+			return setObjectContentNewComputed(d, "etag", "version_id")
+		}
+
+		// Hashing a source file on every plan is expensive, so changes to its content are
+		// detected with etag or source_hash instead. Inline content is compared with the stored checksum.
+		if _, ok := d.GetOk("source"); ok {
+			return nil
+		}
+
+		stored := d.Get(attribute).(string)
+		body, closeBody, err := objectBody(d)
+
+		if err != nil {
+			return err
+		}
+
+		defer closeBody()
+
+		expected, err := objectChecksum(checksumAlgorithm, body)
+
+		if err != nil {
+			return fmt.Errorf("computing %s checksum: %w", checksumAlgorithm, err)
+		}
+
+		if expected != stored {
+			log.Printf("[DEBUG] S3 Object (%s) %s checksum changed (%s != %s), uploading", d.Id(), checksumAlgorithm, stored, expected)
+			return setObjectContentNewComputed(d, "etag", "version_id")
+		}
+	}
+
 	return nil
 }
 
+func setObjectContentNewComputed(d *schema.ResourceDiff, keys ...string) error {
+	for _, key := range keys {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range objectChecksumAttributes {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type resourceGetter interface {
+	GetOk(string) (interface{}, bool)
+}
+
+// objectBody returns the configured object content and a function that releases it.
+func objectBody(d resourceGetter) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	}
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding content_base64: %w", err)
+		}
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+
+	return bytes.NewReader([]byte{}), func() {}, nil
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -0,0 +1,270 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// Attribute names of the additional object checksums, keyed by algorithm.
+var objectChecksumAttributes = map[string]string{
+	s3.ChecksumAlgorithmCrc32:  "checksum_crc32",
+	s3.ChecksumAlgorithmCrc32c: "checksum_crc32c",
+	s3.ChecksumAlgorithmSha1:   "checksum_sha1",
+	s3.ChecksumAlgorithmSha256: "checksum_sha256",
+}
+
+func newObjectChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// objectChecksumDigest returns the raw digest of the remaining content of r.
+// The reader is restored to its original offset.
+func objectChecksumDigest(algorithm string, r io.ReadSeeker) ([]byte, error) {
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := r.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// objectUploadPartSize returns the part size the uploader uses for an object of the
+// specified size, or 0 if the object is uploaded in a single part.
+func objectUploadPartSize(size int64) int64 {
+	partSize := int64(s3manager.DefaultUploadPartSize)
+
+	if size <= partSize {
+		return 0
+	}
+
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = (size / int64(s3manager.MaxUploadParts)) + 1
+	}
+
+	return partSize
+}
+
+// objectChecksum returns the checksum that S3 reports for an object with the specified
+// body once it has been uploaded with the provider's default uploader settings.
+// Objects uploaded in multiple parts have a composite "checksum of checksums" with a
+// part count suffix, e.g. "<base64>-3".
+func objectChecksum(algorithm string, body io.ReadSeeker) (string, error) {
+	size, err := aws.SeekerLen(body)
+
+	if err != nil {
+		return "", err
+	}
+
+	partSize := objectUploadPartSize(size)
+
+	if partSize == 0 {
+		digest, err := objectChecksumDigest(algorithm, body)
+
+		if err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(digest), nil
+	}
+
+	offset, err := body.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return "", err
+	}
+
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	var n int64
+
+	for pos := offset; pos < offset+size; pos += partSize {
+		if _, err := body.Seek(pos, io.SeekStart); err != nil {
+			return "", err
+		}
+
+		part, err := newObjectChecksumHash(algorithm)
+
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := io.CopyN(part, body, partSize); err != nil && err != io.EOF {
+			return "", err
+		}
+
+		h.Write(part.Sum(nil))
+		n++
+	}
+
+	if _, err := body.Seek(offset, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), n), nil
+}
+
+// objectChecksumUploadOption returns an s3manager request option that sends the additional
+// checksum of each single-part or multipart upload request and, for multipart uploads,
+// includes the part checksums when completing the upload.
+// s3manager.Uploader only copies ChecksumAlgorithm to single-part PutObject requests;
+// it sends no checksum with UploadPart requests and doesn't record part checksums.
+func objectChecksumUploadOption(algorithm string) request.Option {
+	var mutex sync.Mutex
+	parts := make(map[int64]string)
+
+	return func(r *request.Request) {
+		switch input := r.Params.(type) {
+		case *s3.PutObjectInput, *s3.UploadPartInput:
+			r.Handlers.Build.PushFront(func(r *request.Request) {
+				var body io.ReadSeeker
+
+				switch input := r.Params.(type) {
+				case *s3.PutObjectInput:
+					body = input.Body
+				case *s3.UploadPartInput:
+					body = input.Body
+				}
+
+				if body == nil {
+					return
+				}
+
+				digest, err := objectChecksumDigest(algorithm, body)
+
+				if err != nil {
+					r.Error = fmt.Errorf("computing %s checksum: %w", algorithm, err)
+					return
+				}
+
+				checksum := base64.StdEncoding.EncodeToString(digest)
+
+				switch input := r.Params.(type) {
+				case *s3.PutObjectInput:
+					input.ChecksumAlgorithm = aws.String(algorithm)
+					setObjectChecksum(algorithm, checksum, &input.ChecksumCRC32, &input.ChecksumCRC32C, &input.ChecksumSHA1, &input.ChecksumSHA256)
+				case *s3.UploadPartInput:
+					input.ChecksumAlgorithm = aws.String(algorithm)
+					setObjectChecksum(algorithm, checksum, &input.ChecksumCRC32, &input.ChecksumCRC32C, &input.ChecksumSHA1, &input.ChecksumSHA256)
+
+					mutex.Lock()
+					parts[aws.Int64Value(input.PartNumber)] = checksum
+					mutex.Unlock()
+				}
+			})
+		case *s3.CompleteMultipartUploadInput:
+			if input.MultipartUpload == nil {
+				return
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			for _, part := range input.MultipartUpload.Parts {
+				setObjectChecksum(algorithm, parts[aws.Int64Value(part.PartNumber)], &part.ChecksumCRC32, &part.ChecksumCRC32C, &part.ChecksumSHA1, &part.ChecksumSHA256)
+			}
+		}
+	}
+}
+
+func setObjectChecksum(algorithm, checksum string, checksumCRC32, checksumCRC32C, checksumSHA1, checksumSHA256 **string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		*checksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		*checksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		*checksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		*checksumSHA256 = aws.String(checksum)
+	}
+}
+
+// objectChecksumFromHeadObjectOutput returns the additional checksum reported for the specified algorithm.
+func objectChecksumFromHeadObjectOutput(algorithm string, output *s3.HeadObjectOutput) string {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return aws.StringValue(output.ChecksumCRC32)
+	case s3.ChecksumAlgorithmCrc32c:
+		return aws.StringValue(output.ChecksumCRC32C)
+	case s3.ChecksumAlgorithmSha1:
+		return aws.StringValue(output.ChecksumSHA1)
+	case s3.ChecksumAlgorithmSha256:
+		return aws.StringValue(output.ChecksumSHA256)
+	}
+
+	return ""
+}
+
+// verifyObjectChecksum compares the additional checksum that S3 reports for an uploaded
+// object with the checksum of the local body.
+func verifyObjectChecksum(ctx context.Context, conn *s3.S3, bucket, key, versionID, algorithm string, body io.ReadSeeker) error {
+	expected, err := objectChecksum(algorithm, body)
+
+	if err != nil {
+		return fmt.Errorf("computing %s checksum: %w", algorithm, err)
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		ChecksumMode: aws.String(s3.ChecksumModeEnabled),
+		Key:          aws.String(key),
+	}
+
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	output, err := conn.HeadObjectWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if actual := objectChecksumFromHeadObjectOutput(algorithm, output); actual != expected {
+		return fmt.Errorf("%s checksum mismatch: expected %s, got %s", algorithm, expected, actual)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package s3
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	multipart := bytes.Repeat([]byte("a"), int(s3manager.DefaultUploadPartSize)+1)
+
+	testCases := []struct {
+		name      string
+		algorithm string
+		body      []byte
+		expected  string
+	}{
+		{
+			name:      "CRC32",
+			algorithm: s3.ChecksumAlgorithmCrc32,
+			body:      []byte("hello world"),
+			expected:  "DUoRhQ==",
+		},
+		{
+			name:      "CRC32C",
+			algorithm: s3.ChecksumAlgorithmCrc32c,
+			body:      []byte("hello world"),
+			expected:  "yZRlqg==",
+		},
+		{
+			name:      "SHA1",
+			algorithm: s3.ChecksumAlgorithmSha1,
+			body:      []byte("hello world"),
+			expected:  "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+		},
+		{
+			name:      "SHA256",
+			algorithm: s3.ChecksumAlgorithmSha256,
+			body:      []byte("hello world"),
+			expected:  "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=",
+		},
+		{
+			name:      "SHA256 multipart",
+			algorithm: s3.ChecksumAlgorithmSha256,
+			body:      multipart,
+			expected:  "IP2+YB/Xxc4PFiGk08WdcdQH4iMKsJoOp5bgq1111Uw=-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := bytes.NewReader(testCase.body)
+
+			got, err := objectChecksum(testCase.algorithm, body)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected checksum %q, got %q", testCase.expected, got)
+			}
+
+			if offset, _ := body.Seek(0, io.SeekCurrent); offset != 0 {
+				t.Errorf("expected body to be rewound, got offset %d", offset)
+			}
+		})
+	}
+}
@@ -13,12 +13,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// @SDKDataSource("aws_s3_object")
 func DataSourceObject() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceObjectRead,
@@ -40,6 +42,27 @@ func DataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumMode_Values(), false),
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content_disposition": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -140,6 +163,9 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
+	if v, ok := d.GetOk("checksum_mode"); ok {
+		input.ChecksumMode = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("range"); ok {
 		input.Range = aws.String(v.(string))
 	}
@@ -169,6 +195,10 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("bucket_key_enabled", out.BucketKeyEnabled)
 	d.Set("cache_control", out.CacheControl)
+	d.Set("checksum_crc32", out.ChecksumCRC32)
+	d.Set("checksum_crc32c", out.ChecksumCRC32C)
+	d.Set("checksum_sha1", out.ChecksumSHA1)
+	d.Set("checksum_sha256", out.ChecksumSHA256)
 	d.Set("content_disposition", out.ContentDisposition)
 	d.Set("content_encoding", out.ContentEncoding)
 	d.Set("content_language", out.ContentLanguage)
@@ -81,6 +81,33 @@ func TestAccS3ObjectDataSource_basicViaAccessPoint(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_checksumMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dsObj, rObj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	dataSourceName := "data.aws_s3_object.test"
+	resourceName := "aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_checksumMode(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &rObj),
+					testAccCheckObjectExistsDataSource(ctx, dataSourceName, &dsObj),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_mode", s3.ChecksumModeEnabled),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum_sha256", resourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_crc32", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_readableBody(t *testing.T) {
 	ctx := acctest.Context(t)
 	rInt := sdkacctest.RandInt()
@@ -511,6 +538,27 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_checksumMode(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = %[1]q
+  content            = "Hello World"
+  checksum_algorithm = "SHA256"
+}
+
+data "aws_s3_object" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = aws_s3_object.test.key
+  checksum_mode = "ENABLED"
+}
+`, rName)
+}
+
 func testAccObjectDataSourceConfig_readableBody(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
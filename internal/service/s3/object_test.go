@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -145,6 +146,70 @@ func TestAccS3Object_content(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "hello world", s3.ChecksumAlgorithmSha256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "hello world"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmSha256),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "checksum_algorithm", "checksum_sha256", "content", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "hello world", s3.ChecksumAlgorithmCrc32c),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmCrc32c),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", "yZRlqg=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+			{
+				// Replace the object outside of Terraform; the checksum no longer matches the configured content.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+					_, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+						Body:   strings.NewReader("goodbye world"),
+						Bucket: aws.String(rName),
+						Key:    aws.String("test-key"),
+					})
+					if err != nil {
+						t.Fatalf("replacing S3 Object: %s", err)
+					}
+				},
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "hello world", s3.ChecksumAlgorithmCrc32c),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "hello world"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", "yZRlqg=="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_etagEncryption(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1658,6 +1723,21 @@ resource "aws_s3_object" "object" {
 `, rName, content)
 }
 
+func testAccObjectConfig_checksumAlgorithm(rName, content, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  content            = %[2]q
+  checksum_algorithm = %[3]q
+}
+`, rName, content, checksumAlgorithm)
+}
+
 func testAccObjectConfig_etagEncryption(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -13,6 +13,7 @@ import (
 
 const keyRequestPageSize = 1000
 
+// @SDKDataSource("aws_s3_objects")
 func DataSourceObjects() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceObjectsRead,
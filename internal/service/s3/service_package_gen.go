@@ -11,72 +11,168 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_canonical_user_id",
+			Factory:  DataSourceCanonicalUserID,
+		},
+		{
+			TypeName: "aws_s3_bucket",
+			Factory:  DataSourceBucket,
+		},
+		{
+			TypeName: "aws_s3_bucket_object",
+			Factory:  DataSourceBucketObject,
+		},
+		{
+			TypeName: "aws_s3_bucket_objects",
+			Factory:  DataSourceBucketObjects,
+		},
+		{
+			TypeName: "aws_s3_bucket_policy",
+			Factory:  DataSourceBucketPolicy,
+		},
+		{
+			TypeName: "aws_s3_object",
+			Factory:  DataSourceObject,
+		},
+		{
+			TypeName: "aws_s3_objects",
+			Factory:  DataSourceObjects,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "s3"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_s3_bucket",
+			Factory:  ResourceBucket,
+		},
+		{
+			TypeName: "aws_s3_bucket_accelerate_configuration",
+			Factory:  ResourceBucketAccelerateConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_acl",
+			Factory:  ResourceBucketACL,
+		},
+		{
+			TypeName: "aws_s3_bucket_analytics_configuration",
+			Factory:  ResourceBucketAnalyticsConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_cors_configuration",
+			Factory:  ResourceBucketCorsConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_intelligent_tiering_configuration",
+			Factory:  ResourceBucketIntelligentTieringConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_inventory",
+			Factory:  ResourceBucketInventory,
+		},
+		{
+			TypeName: "aws_s3_bucket_lifecycle_configuration",
+			Factory:  ResourceBucketLifecycleConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_logging",
+			Factory:  ResourceBucketLogging,
+		},
+		{
+			TypeName: "aws_s3_bucket_metric",
+			Factory:  ResourceBucketMetric,
+		},
+		{
+			TypeName: "aws_s3_bucket_notification",
+			Factory:  ResourceBucketNotification,
+		},
+		{
+			TypeName: "aws_s3_bucket_object",
+			Factory:  ResourceBucketObject,
+		},
+		{
+			TypeName: "aws_s3_bucket_object_lock_configuration",
+			Factory:  ResourceBucketObjectLockConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_ownership_controls",
+			Factory:  ResourceBucketOwnershipControls,
+		},
+		{
+			TypeName: "aws_s3_bucket_policy",
+			Factory:  ResourceBucketPolicy,
+		},
+		{
+			TypeName: "aws_s3_bucket_public_access_block",
+			Factory:  ResourceBucketPublicAccessBlock,
+		},
+		{
+			TypeName: "aws_s3_bucket_replication_configuration",
+			Factory:  ResourceBucketReplicationConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_request_payment_configuration",
+			Factory:  ResourceBucketRequestPaymentConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_server_side_encryption_configuration",
+			Factory:  ResourceBucketServerSideEncryptionConfiguration,
+		},
+		{
+			TypeName: "aws_s3_bucket_versioning",
+			Factory:  ResourceBucketVersioning,
+		},
+		{
+			TypeName: "aws_s3_bucket_website_configuration",
+			Factory:  ResourceBucketWebsiteConfiguration,
+		},
+		{
+			TypeName: "aws_s3_directory_sync",
+			Factory:  ResourceDirectorySync,
+		},
+		{
+			TypeName: "aws_s3_object",
+			Factory:  ResourceObject,
+		},
+		{
+			TypeName: "aws_s3_object_copy",
+			Factory:  ResourceObjectCopy,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "s3"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
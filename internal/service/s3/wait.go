@@ -37,7 +37,7 @@ func waitForLifecycleConfigurationRulesStatus(ctx context.Context, conn *s3.S3,
 		NotFoundChecks:            20,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -53,7 +53,7 @@ func waitForBucketVersioningStatus(ctx context.Context, conn *s3.S3, bucket, exp
 		Delay:                     1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*s3.GetBucketVersioningOutput); ok {
 		return output, err
@@ -17,10 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-func init() {
-	_sp.registerSDKResourceFactory("aws_s3_account_public_access_block", resourceAccountPublicAccessBlock)
-}
-
+// @SDKResource("aws_s3_account_public_access_block")
 func resourceAccountPublicAccessBlock() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountPublicAccessBlockCreate,
@@ -222,7 +219,7 @@ func waitPublicAccessBlockEqual(ctx context.Context, conn *s3control.S3Control,
 		ContinuousTargetOccurence: propagationContinuousTargetOccurence,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*s3control.PublicAccessBlockConfiguration); ok {
 		return output, err
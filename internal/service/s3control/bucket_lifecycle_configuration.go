@@ -20,10 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-func init() {
-	_sp.registerSDKResourceFactory("aws_s3control_bucket_lifecycle_configuration", resourceBucketLifecycleConfiguration)
-}
-
+// @SDKResource("aws_s3control_bucket_lifecycle_configuration")
 func resourceBucketLifecycleConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketLifecycleConfigurationCreate,
@@ -11,72 +11,96 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_s3_account_public_access_block",
+			Factory:  dataSourceAccountPublicAccessBlock,
+		},
+		{
+			TypeName: "aws_s3control_multi_region_access_point",
+			Factory:  dataSourceMultiRegionAccessPoint,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "s3control"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_s3_access_point",
+			Factory:  resourceAccessPoint,
+		},
+		{
+			TypeName: "aws_s3_account_public_access_block",
+			Factory:  resourceAccountPublicAccessBlock,
+		},
+		{
+			TypeName: "aws_s3control_access_point_policy",
+			Factory:  resourceAccessPointPolicy,
+		},
+		{
+			TypeName: "aws_s3control_bucket",
+			Factory:  resourceBucket,
+		},
+		{
+			TypeName: "aws_s3control_bucket_lifecycle_configuration",
+			Factory:  resourceBucketLifecycleConfiguration,
+		},
+		{
+			TypeName: "aws_s3control_bucket_policy",
+			Factory:  resourceBucketPolicy,
+		},
+		{
+			TypeName: "aws_s3control_multi_region_access_point",
+			Factory:  resourceMultiRegionAccessPoint,
+		},
+		{
+			TypeName: "aws_s3control_multi_region_access_point_policy",
+			Factory:  resourceMultiRegionAccessPointPolicy,
+		},
+		{
+			TypeName: "aws_s3control_object_lambda_access_point",
+			Factory:  resourceObjectLambdaAccessPoint,
+		},
+		{
+			TypeName: "aws_s3control_object_lambda_access_point_policy",
+			Factory:  resourceObjectLambdaAccessPointPolicy,
+		},
+		{
+			TypeName: "aws_s3control_storage_lens_configuration",
+			Factory:  resourceStorageLensConfiguration,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "s3control"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
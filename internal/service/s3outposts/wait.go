@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/s3outposts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -28,7 +29,7 @@ func waitEndpointStatusCreated(ctx context.Context, conn *s3outposts.S3Outposts,
 		Timeout: endpointStatusCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*s3outposts.Endpoint); ok {
 		return v, err
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_sagemaker_device_fleet")
 func ResourceDeviceFleet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDeviceFleetCreate,
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_sagemaker_feature_group")
 func ResourceFeatureGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFeatureGroupCreate,
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_sagemaker_human_task_ui")
 func ResourceHumanTaskUI() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHumanTaskUICreate,
@@ -11,72 +11,152 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_sagemaker_prebuilt_ecr_image",
+			Factory:  DataSourcePrebuiltECRImage,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "sagemaker"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_sagemaker_app",
+			Factory:  ResourceApp,
+		},
+		{
+			TypeName: "aws_sagemaker_app_image_config",
+			Factory:  ResourceAppImageConfig,
+		},
+		{
+			TypeName: "aws_sagemaker_code_repository",
+			Factory:  ResourceCodeRepository,
+		},
+		{
+			TypeName: "aws_sagemaker_device",
+			Factory:  ResourceDevice,
+		},
+		{
+			TypeName: "aws_sagemaker_device_fleet",
+			Factory:  ResourceDeviceFleet,
+		},
+		{
+			TypeName: "aws_sagemaker_domain",
+			Factory:  ResourceDomain,
+		},
+		{
+			TypeName: "aws_sagemaker_endpoint",
+			Factory:  ResourceEndpoint,
+		},
+		{
+			TypeName: "aws_sagemaker_endpoint_configuration",
+			Factory:  ResourceEndpointConfiguration,
+		},
+		{
+			TypeName: "aws_sagemaker_feature_group",
+			Factory:  ResourceFeatureGroup,
+		},
+		{
+			TypeName: "aws_sagemaker_flow_definition",
+			Factory:  ResourceFlowDefinition,
+		},
+		{
+			TypeName: "aws_sagemaker_human_task_ui",
+			Factory:  ResourceHumanTaskUI,
+		},
+		{
+			TypeName: "aws_sagemaker_image",
+			Factory:  ResourceImage,
+		},
+		{
+			TypeName: "aws_sagemaker_image_version",
+			Factory:  ResourceImageVersion,
+		},
+		{
+			TypeName: "aws_sagemaker_model",
+			Factory:  ResourceModel,
+		},
+		{
+			TypeName: "aws_sagemaker_model_package_group",
+			Factory:  ResourceModelPackageGroup,
+		},
+		{
+			TypeName: "aws_sagemaker_model_package_group_policy",
+			Factory:  ResourceModelPackageGroupPolicy,
+		},
+		{
+			TypeName: "aws_sagemaker_notebook_instance",
+			Factory:  ResourceNotebookInstance,
+		},
+		{
+			TypeName: "aws_sagemaker_notebook_instance_lifecycle_configuration",
+			Factory:  ResourceNotebookInstanceLifeCycleConfiguration,
+		},
+		{
+			TypeName: "aws_sagemaker_pipeline",
+			Factory:  ResourcePipeline,
+		},
+		{
+			TypeName: "aws_sagemaker_project",
+			Factory:  ResourceProject,
+		},
+		{
+			TypeName: "aws_sagemaker_servicecatalog_portfolio_status",
+			Factory:  ResourceServicecatalogPortfolioStatus,
+		},
+		{
+			TypeName: "aws_sagemaker_space",
+			Factory:  ResourceSpace,
+		},
+		{
+			TypeName: "aws_sagemaker_studio_lifecycle_config",
+			Factory:  ResourceStudioLifecycleConfig,
+		},
+		{
+			TypeName: "aws_sagemaker_user_profile",
+			Factory:  ResourceUserProfile,
+		},
+		{
+			TypeName: "aws_sagemaker_workforce",
+			Factory:  ResourceWorkforce,
+		},
+		{
+			TypeName: "aws_sagemaker_workteam",
+			Factory:  ResourceWorkteam,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "sagemaker"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_sagemaker_studio_lifecycle_config")
 func ResourceStudioLifecycleConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStudioLifecycleConfigCreate,
@@ -149,6 +149,11 @@ func init() {
 		Name: "aws_sagemaker_project",
 		F:    sweepProjects,
 	})
+
+	resource.AddTestSweepers("aws_sagemaker_pipeline", &resource.Sweeper{
+		Name: "aws_sagemaker_pipeline",
+		F:    sweepPipelines,
+	})
 }
 
 func sweepAppImagesConfig(region string) error {
@@ -991,3 +996,45 @@ func sweepProjects(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepPipelines(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SageMakerConn()
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListPipelinesPagesWithContext(ctx, &sagemaker.ListPipelinesInput{}, func(page *sagemaker.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, pipeline := range page.PipelineSummaries {
+			r := ResourcePipeline()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(pipeline.PipelineName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Pipeline sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Pipelines: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Pipelines: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
@@ -53,7 +53,7 @@ func WaitNotebookInstanceInService(ctx context.Context, conn *sagemaker.SageMake
 		Timeout: NotebookInstanceInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -79,7 +79,7 @@ func WaitNotebookInstanceStarted(ctx context.Context, conn *sagemaker.SageMaker,
 		Timeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -104,7 +104,7 @@ func WaitNotebookInstanceStopped(ctx context.Context, conn *sagemaker.SageMaker,
 		Timeout: NotebookInstanceStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -128,7 +128,7 @@ func WaitNotebookInstanceDeleted(ctx context.Context, conn *sagemaker.SageMaker,
 		Timeout: NotebookInstanceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -153,7 +153,7 @@ func WaitModelPackageGroupCompleted(ctx context.Context, conn *sagemaker.SageMak
 		Timeout: ModelPackageGroupCompletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -173,7 +173,7 @@ func WaitModelPackageGroupDeleted(ctx context.Context, conn *sagemaker.SageMaker
 		Timeout: ModelPackageGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -194,7 +194,7 @@ func WaitImageCreated(ctx context.Context, conn *sagemaker.SageMaker, name strin
 		Timeout: ImageCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageOutput); ok {
 		return output, err
@@ -212,7 +212,7 @@ func WaitImageDeleted(ctx context.Context, conn *sagemaker.SageMaker, name strin
 		Timeout: ImageDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageOutput); ok {
 		return output, err
@@ -232,7 +232,7 @@ func WaitImageVersionCreated(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: ImageVersionCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -250,7 +250,7 @@ func WaitImageVersionDeleted(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: ImageVersionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -271,7 +271,7 @@ func WaitDomainInService(ctx context.Context, conn *sagemaker.SageMaker, domainI
 		Timeout: DomainInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.DomainStatusFailed || status == sagemaker.DomainStatusUpdateFailed && reason != "" {
@@ -295,7 +295,7 @@ func WaitDomainDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainID
 		Timeout: DomainDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.DomainStatusDeleteFailed && reason != "" {
@@ -317,7 +317,7 @@ func WaitFeatureGroupCreated(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: FeatureGroupCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := aws.StringValue(output.FeatureGroupStatus), aws.StringValue(output.FailureReason); status == sagemaker.FeatureGroupStatusCreateFailed && reason != "" {
@@ -339,7 +339,7 @@ func WaitFeatureGroupDeleted(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: FeatureGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := aws.StringValue(output.FeatureGroupStatus), aws.StringValue(output.FailureReason); status == sagemaker.FeatureGroupStatusDeleteFailed && reason != "" {
@@ -364,7 +364,7 @@ func WaitUserProfileInService(ctx context.Context, conn *sagemaker.SageMaker, do
 		Timeout: UserProfileInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		return output, err
@@ -392,7 +392,7 @@ func WaitUserProfileDeleted(ctx context.Context, conn *sagemaker.SageMaker, doma
 		Timeout: UserProfileDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.UserProfileStatusDeleteFailed && reason != "" {
@@ -414,7 +414,7 @@ func WaitAppInService(ctx context.Context, conn *sagemaker.SageMaker, domainID,
 		Timeout: AppInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.AppStatusFailed && reason != "" {
@@ -438,7 +438,7 @@ func WaitAppDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainID, us
 		Timeout: AppDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.AppStatusFailed && reason != "" {
@@ -460,7 +460,7 @@ func WaitFlowDefinitionActive(ctx context.Context, conn *sagemaker.SageMaker, na
 		Timeout: FlowDefinitionActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := aws.StringValue(output.FlowDefinitionStatus), aws.StringValue(output.FailureReason); status == sagemaker.FlowDefinitionStatusFailed && reason != "" {
@@ -482,7 +482,7 @@ func WaitFlowDefinitionDeleted(ctx context.Context, conn *sagemaker.SageMaker, n
 		Timeout: FlowDefinitionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := aws.StringValue(output.FlowDefinitionStatus), aws.StringValue(output.FailureReason); status == sagemaker.FlowDefinitionStatusFailed && reason != "" {
@@ -504,7 +504,7 @@ func WaitProjectDeleted(ctx context.Context, conn *sagemaker.SageMaker, name str
 		Timeout: ProjectDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := aws.StringValue(output.ProjectStatus), aws.StringValue(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == sagemaker.ProjectStatusDeleteFailed && reason != "" {
@@ -526,7 +526,7 @@ func WaitProjectCreated(ctx context.Context, conn *sagemaker.SageMaker, name str
 		Timeout: ProjectCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := aws.StringValue(output.ProjectStatus), aws.StringValue(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == sagemaker.ProjectStatusCreateFailed && reason != "" {
@@ -548,7 +548,7 @@ func WaitProjectUpdated(ctx context.Context, conn *sagemaker.SageMaker, name str
 		Timeout: ProjectCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := aws.StringValue(output.ProjectStatus), aws.StringValue(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == sagemaker.ProjectStatusUpdateFailed && reason != "" {
@@ -569,7 +569,7 @@ func WaitWorkforceActive(ctx context.Context, conn *sagemaker.SageMaker, name st
 		Timeout: WorkforceActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.Workforce); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.WorkforceStatusFailed && reason != "" {
@@ -590,7 +590,7 @@ func WaitWorkforceDeleted(ctx context.Context, conn *sagemaker.SageMaker, name s
 		Timeout: WorkforceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.Workforce); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.WorkforceStatusFailed && reason != "" {
@@ -611,7 +611,7 @@ func WaitSpaceInService(ctx context.Context, conn *sagemaker.SageMaker, domainId
 		Timeout: SpaceInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeSpaceOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.SpaceStatusUpdateFailed && reason != "" {
@@ -632,7 +632,7 @@ func WaitSpaceDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainId,
 		Timeout: SpaceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeSpaceOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.SpaceStatusDeleteFailed && reason != "" {
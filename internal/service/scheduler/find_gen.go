@@ -0,0 +1,64 @@
+// Code generated by "internal/generate/finders/main.go -AWSSDKVersion=2"; DO NOT EDIT.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func findScheduleByTwoPartKey(ctx context.Context, conn *scheduler.Client, groupName string, scheduleName string) (*scheduler.GetScheduleOutput, error) {
+	input := &scheduler.GetScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(scheduleName),
+	}
+
+	output, err := conn.GetSchedule(ctx, input)
+
+	if errs.APIErrorCodeEquals(err, "ResourceNotFoundException") {
+		return nil, &sdkresource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Arn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findScheduleGroupByName(ctx context.Context, conn *scheduler.Client, name string) (*scheduler.GetScheduleGroupOutput, error) {
+	input := &scheduler.GetScheduleGroupInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetScheduleGroup(ctx, input)
+
+	if errs.APIErrorCodeEquals(err, "ResourceNotFoundException") {
+		return nil, &sdkresource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Arn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
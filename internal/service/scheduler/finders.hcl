@@ -0,0 +1,25 @@
+finder "findScheduleByTwoPartKey" {
+  operation = "GetSchedule"
+
+  argument "groupName" {
+    field = "GroupName"
+  }
+
+  argument "scheduleName" {
+    field = "Name"
+  }
+
+  not_found_error_codes = ["ResourceNotFoundException"]
+  required_fields       = ["Arn"]
+}
+
+finder "findScheduleGroupByName" {
+  operation = "GetScheduleGroup"
+
+  argument "name" {
+    field = "Name"
+  }
+
+  not_found_error_codes = ["ResourceNotFoundException"]
+  required_fields       = ["Arn"]
+}
@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/finders/main.go -AWSSDKVersion=2
 //go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -ListTags -UpdateTags -ServiceTagsSlice
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
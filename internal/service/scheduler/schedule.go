@@ -21,17 +21,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func init() {
-	_sp.registerSDKResourceFactory("aws_scheduler_schedule", resourceSchedule)
-}
-
+// @SDKResource("aws_scheduler_schedule")
 func resourceSchedule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScheduleCreate,
@@ -642,32 +638,6 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func findScheduleByTwoPartKey(ctx context.Context, conn *scheduler.Client, groupName, scheduleName string) (*scheduler.GetScheduleOutput, error) {
-	in := &scheduler.GetScheduleInput{
-		GroupName: aws.String(groupName),
-		Name:      aws.String(scheduleName),
-	}
-
-	out, err := conn.GetSchedule(ctx, in)
-
-	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil, &resource.NotFoundError{
-			LastError:   err,
-			LastRequest: in,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if out == nil || out.Arn == nil {
-		return nil, tfresource.NewEmptyResultError(in)
-	}
-
-	return out, nil
-}
-
 // ResourceScheduleIDFromARN constructs a string of the form "group_name/schedule_name"
 // from the given Schedule ARN.
 func ResourceScheduleIDFromARN(arn string) (id string, err error) {
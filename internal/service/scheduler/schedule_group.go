@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_scheduler_schedule_group")
 func ResourceScheduleGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScheduleGroupCreate,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_schemas_discoverer")
 func ResourceDiscoverer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDiscovererCreate,
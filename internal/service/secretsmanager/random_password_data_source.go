@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKDataSource("aws_secretsmanager_random_password")
 func DataSourceRandomPassword() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRandomPasswordRead,
@@ -0,0 +1,395 @@
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAutomationRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.type", "FINDING_FIELDS_UPDATE"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.workflow.0.status", "SUPPRESSED"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.aws_account_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &rule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceAutomationRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_full(rName, "LOW", "NOTIFIED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.confidence", "20"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.criticality", "25"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.note.0.text", "example note"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.note.0.updated_by", "sechub-automation"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.severity.0.label", "LOW"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.user_defined_fields.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.user_defined_fields.key", "value"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.verification_state", "TRUE_POSITIVE"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.workflow.0.status", "NOTIFIED"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.confidence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.created_at.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.severity_label.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_full(rName, "HIGH", "RESOLVED", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.severity.0.label", "HIGH"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.workflow.0.status", "RESOLVED"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_automation_rule" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Automation Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRuleExists(ctx context.Context, n string, v *securityhub.AutomationRulesConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Automation Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn()
+
+		output, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAutomationRuleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName)
+}
+
+func testAccAutomationRuleConfig_full(rName, severityLabel, workflowStatus, ruleStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  is_terminal = true
+  rule_name   = %[1]q
+  rule_order  = 5
+  rule_status = %[4]q
+
+  actions {
+    type = "FINDING_FIELDS_UPDATE"
+
+    finding_fields_update {
+      confidence         = 20
+      criticality        = 25
+      types              = ["Software and Configuration Checks/Industry and Regulatory Standards"]
+      verification_state = "TRUE_POSITIVE"
+
+      note {
+        text       = "example note"
+        updated_by = "sechub-automation"
+      }
+
+      severity {
+        label = %[2]q
+      }
+
+      user_defined_fields = {
+        key = "value"
+      }
+
+      workflow {
+        status = %[3]q
+      }
+    }
+  }
+
+  criteria {
+    confidence {
+      gte = "40"
+    }
+
+    created_at {
+      date_range {
+        unit  = "DAYS"
+        value = 5
+      }
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "test"
+    }
+
+    severity_label {
+      comparison = "EQUALS"
+      value      = "MEDIUM"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, severityLabel, workflowStatus, ruleStatus)
+}
+
+func testAccAutomationRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+  }
+
+  criteria {
+    severity_label {
+      comparison = "EQUALS"
+      value      = "INFORMATIONAL"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAutomationRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+  }
+
+  criteria {
+    severity_label {
+      comparison = "EQUALS"
+      value      = "INFORMATIONAL"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
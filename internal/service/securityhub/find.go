@@ -132,3 +132,32 @@ func FindStandardsSubscriptionByARN(ctx context.Context, conn *securityhub.Secur
 
 	return subscription, nil
 }
+
+func FindAutomationRuleByARN(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.AutomationRulesConfig, error) {
+	input := &securityhub.BatchGetAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.BatchGetAutomationRulesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Rules that do not exist are reported as unprocessed rather than as an error.
+	if output == nil || len(output.Rules) == 0 || output.Rules[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Rules[0], nil
+}
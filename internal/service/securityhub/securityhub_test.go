@@ -23,6 +23,12 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"Description": testAccActionTarget_Description,
 			"Name":        testAccActionTarget_Name,
 		},
+		"AutomationRule": {
+			"basic":      testAccAutomationRule_basic,
+			"disappears": testAccAutomationRule_disappears,
+			"full":       testAccAutomationRule_full,
+			"tags":       testAccAutomationRule_tags,
+		},
 		"Insight": {
 			"basic":            testAccInsight_basic,
 			"disappears":       testAccInsight_disappears,
@@ -11,72 +11,91 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "securityhub"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_securityhub_account",
+			Factory:  ResourceAccount,
+		},
+		{
+			TypeName: "aws_securityhub_action_target",
+			Factory:  ResourceActionTarget,
+		},
+		{
+			TypeName: "aws_securityhub_automation_rule",
+			Factory:  ResourceAutomationRule,
+		},
+		{
+			TypeName: "aws_securityhub_finding_aggregator",
+			Factory:  ResourceFindingAggregator,
+		},
+		{
+			TypeName: "aws_securityhub_insight",
+			Factory:  ResourceInsight,
+		},
+		{
+			TypeName: "aws_securityhub_invite_accepter",
+			Factory:  ResourceInviteAccepter,
+		},
+		{
+			TypeName: "aws_securityhub_member",
+			Factory:  ResourceMember,
+		},
+		{
+			TypeName: "aws_securityhub_organization_admin_account",
+			Factory:  ResourceOrganizationAdminAccount,
+		},
+		{
+			TypeName: "aws_securityhub_organization_configuration",
+			Factory:  ResourceOrganizationConfiguration,
+		},
+		{
+			TypeName: "aws_securityhub_product_subscription",
+			Factory:  ResourceProductSubscription,
+		},
+		{
+			TypeName: "aws_securityhub_standards_control",
+			Factory:  ResourceStandardsControl,
+		},
+		{
+			TypeName: "aws_securityhub_standards_subscription",
+			Factory:  ResourceStandardsSubscription,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "securityhub"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -28,7 +29,7 @@ func waitAdminAccountEnabled(ctx context.Context, conn *securityhub.SecurityHub,
 		Timeout: adminAccountEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*securityhub.AdminAccount); ok {
 		return output, err
@@ -46,7 +47,7 @@ func waitAdminAccountNotFound(ctx context.Context, conn *securityhub.SecurityHub
 		Timeout: adminAccountNotFoundTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*securityhub.AdminAccount); ok {
 		return output, err
@@ -63,7 +64,7 @@ func waitStandardsSubscriptionCreated(ctx context.Context, conn *securityhub.Sec
 		Timeout: standardsSubscriptionCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*securityhub.StandardsSubscription); ok {
 		return output, err
@@ -80,7 +81,7 @@ func waitStandardsSubscriptionDeleted(ctx context.Context, conn *securityhub.Sec
 		Timeout: standardsSubscriptionDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*securityhub.StandardsSubscription); ok {
 		return output, err
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_servicecatalog_portfolio_share")
 func ResourcePortfolioShare() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePortfolioShareCreate,
@@ -11,72 +11,116 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_servicecatalog_constraint",
+			Factory:  DataSourceConstraint,
+		},
+		{
+			TypeName: "aws_servicecatalog_launch_paths",
+			Factory:  DataSourceLaunchPaths,
+		},
+		{
+			TypeName: "aws_servicecatalog_portfolio",
+			Factory:  DataSourcePortfolio,
+		},
+		{
+			TypeName: "aws_servicecatalog_portfolio_constraints",
+			Factory:  DataSourcePortfolioConstraints,
+		},
+		{
+			TypeName: "aws_servicecatalog_product",
+			Factory:  DataSourceProduct,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "servicecatalog"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_servicecatalog_budget_resource_association",
+			Factory:  ResourceBudgetResourceAssociation,
+		},
+		{
+			TypeName: "aws_servicecatalog_constraint",
+			Factory:  ResourceConstraint,
+		},
+		{
+			TypeName: "aws_servicecatalog_organizations_access",
+			Factory:  ResourceOrganizationsAccess,
+		},
+		{
+			TypeName: "aws_servicecatalog_portfolio",
+			Factory:  ResourcePortfolio,
+		},
+		{
+			TypeName: "aws_servicecatalog_portfolio_share",
+			Factory:  ResourcePortfolioShare,
+		},
+		{
+			TypeName: "aws_servicecatalog_principal_portfolio_association",
+			Factory:  ResourcePrincipalPortfolioAssociation,
+		},
+		{
+			TypeName: "aws_servicecatalog_product",
+			Factory:  ResourceProduct,
+		},
+		{
+			TypeName: "aws_servicecatalog_product_portfolio_association",
+			Factory:  ResourceProductPortfolioAssociation,
+		},
+		{
+			TypeName: "aws_servicecatalog_provisioned_product",
+			Factory:  ResourceProvisionedProduct,
+		},
+		{
+			TypeName: "aws_servicecatalog_provisioning_artifact",
+			Factory:  ResourceProvisioningArtifact,
+		},
+		{
+			TypeName: "aws_servicecatalog_service_action",
+			Factory:  ResourceServiceAction,
+		},
+		{
+			TypeName: "aws_servicecatalog_tag_option",
+			Factory:  ResourceTagOption,
+		},
+		{
+			TypeName: "aws_servicecatalog_tag_option_resource_association",
+			Factory:  ResourceTagOptionResourceAssociation,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "servicecatalog"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
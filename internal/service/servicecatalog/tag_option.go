@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKResource("aws_servicecatalog_tag_option")
 func ResourceTagOption() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTagOptionCreate,
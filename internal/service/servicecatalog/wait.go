@@ -85,7 +85,7 @@ func WaitProductReady(ctx context.Context, conn *servicecatalog.ServiceCatalog,
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
 		return output, err
@@ -102,7 +102,7 @@ func WaitProductDeleted(ctx context.Context, conn *servicecatalog.ServiceCatalog
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil, nil
@@ -119,7 +119,7 @@ func WaitTagOptionReady(ctx context.Context, conn *servicecatalog.ServiceCatalog
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.TagOptionDetail); ok {
 		return output, err
@@ -136,7 +136,7 @@ func WaitTagOptionDeleted(ctx context.Context, conn *servicecatalog.ServiceCatal
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -159,7 +159,7 @@ func WaitPortfolioShareReady(ctx context.Context, conn *servicecatalog.ServiceCa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioShareDetail); ok {
 		return output, err
@@ -182,7 +182,7 @@ func WaitPortfolioShareCreatedWithToken(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -199,7 +199,7 @@ func WaitPortfolioShareDeleted(ctx context.Context, conn *servicecatalog.Service
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioShareDetail); ok {
 		return output, err
@@ -216,7 +216,7 @@ func WaitPortfolioShareDeletedWithToken(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -233,7 +233,7 @@ func WaitOrganizationsAccessStable(ctx context.Context, conn *servicecatalog.Ser
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.GetAWSOrganizationsAccessStatusOutput); ok {
 		return aws.StringValue(output.AccessStatus), err
@@ -253,7 +253,7 @@ func WaitConstraintReady(ctx context.Context, conn *servicecatalog.ServiceCatalo
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeConstraintOutput); ok {
 		return output, err
@@ -270,7 +270,7 @@ func WaitConstraintDeleted(ctx context.Context, conn *servicecatalog.ServiceCata
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -286,7 +286,7 @@ func WaitProductPortfolioAssociationReady(ctx context.Context, conn *servicecata
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioDetail); ok {
 		return output, err
@@ -303,7 +303,7 @@ func WaitProductPortfolioAssociationDeleted(ctx context.Context, conn *serviceca
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -316,7 +316,7 @@ func WaitServiceActionReady(ctx context.Context, conn *servicecatalog.ServiceCat
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.ServiceActionDetail); ok {
 		return output, err
@@ -333,7 +333,7 @@ func WaitServiceActionDeleted(ctx context.Context, conn *servicecatalog.ServiceC
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -350,7 +350,7 @@ func WaitBudgetResourceAssociationReady(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.BudgetDetail); ok {
 		return output, err
@@ -367,7 +367,7 @@ func WaitBudgetResourceAssociationDeleted(ctx context.Context, conn *servicecata
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -380,7 +380,7 @@ func WaitTagOptionResourceAssociationReady(ctx context.Context, conn *servicecat
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.ResourceDetail); ok {
 		return output, err
@@ -397,7 +397,7 @@ func WaitTagOptionResourceAssociationDeleted(ctx context.Context, conn *servicec
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -413,7 +413,7 @@ func WaitProvisioningArtifactReady(ctx context.Context, conn *servicecatalog.Ser
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
 		return output, err
@@ -430,7 +430,7 @@ func WaitProvisioningArtifactDeleted(ctx context.Context, conn *servicecatalog.S
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -450,7 +450,7 @@ func WaitPrincipalPortfolioAssociationReady(ctx context.Context, conn *serviceca
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.Principal); ok {
 		return output, err
@@ -468,7 +468,7 @@ func WaitPrincipalPortfolioAssociationDeleted(ctx context.Context, conn *service
 		NotFoundChecks: 1,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -484,7 +484,7 @@ func WaitLaunchPathsReady(ctx context.Context, conn *servicecatalog.ServiceCatal
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*servicecatalog.LaunchPathSummary); ok {
 		return output, err
@@ -504,7 +504,7 @@ func WaitProvisionedProductReady(ctx context.Context, conn *servicecatalog.Servi
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisionedProductOutput); ok {
 		if detail := output.ProvisionedProductDetail; detail != nil {
@@ -529,7 +529,7 @@ func WaitProvisionedProductTerminated(ctx context.Context, conn *servicecatalog.
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -542,7 +542,7 @@ func WaitPortfolioConstraintsReady(ctx context.Context, conn *servicecatalog.Ser
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*servicecatalog.ConstraintDetail); ok {
 		return output, err
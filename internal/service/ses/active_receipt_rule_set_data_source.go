@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// @SDKDataSource("aws_ses_active_receipt_rule_set")
 func DataSourceActiveReceiptRuleSet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceActiveReceiptRuleSetRead,
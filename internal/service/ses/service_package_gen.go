@@ -11,72 +11,112 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_ses_active_receipt_rule_set",
+			Factory:  DataSourceActiveReceiptRuleSet,
+		},
+		{
+			TypeName: "aws_ses_domain_identity",
+			Factory:  DataSourceDomainIdentity,
+		},
+		{
+			TypeName: "aws_ses_email_identity",
+			Factory:  DataSourceEmailIdentity,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "ses"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_ses_active_receipt_rule_set",
+			Factory:  ResourceActiveReceiptRuleSet,
+		},
+		{
+			TypeName: "aws_ses_configuration_set",
+			Factory:  ResourceConfigurationSet,
+		},
+		{
+			TypeName: "aws_ses_domain_dkim",
+			Factory:  ResourceDomainDKIM,
+		},
+		{
+			TypeName: "aws_ses_domain_identity",
+			Factory:  ResourceDomainIdentity,
+		},
+		{
+			TypeName: "aws_ses_domain_identity_verification",
+			Factory:  ResourceDomainIdentityVerification,
+		},
+		{
+			TypeName: "aws_ses_domain_mail_from",
+			Factory:  ResourceDomainMailFrom,
+		},
+		{
+			TypeName: "aws_ses_email_identity",
+			Factory:  ResourceEmailIdentity,
+		},
+		{
+			TypeName: "aws_ses_event_destination",
+			Factory:  ResourceEventDestination,
+		},
+		{
+			TypeName: "aws_ses_identity_notification_topic",
+			Factory:  ResourceIdentityNotificationTopic,
+		},
+		{
+			TypeName: "aws_ses_identity_policy",
+			Factory:  ResourceIdentityPolicy,
+		},
+		{
+			TypeName: "aws_ses_receipt_filter",
+			Factory:  ResourceReceiptFilter,
+		},
+		{
+			TypeName: "aws_ses_receipt_rule",
+			Factory:  ResourceReceiptRule,
+		},
+		{
+			TypeName: "aws_ses_receipt_rule_set",
+			Factory:  ResourceReceiptRuleSet,
+		},
+		{
+			TypeName: "aws_ses_template",
+			Factory:  ResourceTemplate,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "ses"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -0,0 +1,251 @@
+package sfn
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_sfn_alias")
+func ResourceAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAliasCreate,
+		ReadWithoutTimeout:   resourceAliasRead,
+		UpdateWithoutTimeout: resourceAliasUpdate,
+		DeleteWithoutTimeout: resourceAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 80),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]+$`), "the name should only contain 0-9, A-Z, a-z, - and _"),
+				),
+			},
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state_machine_version_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SFNConn()
+
+	name := d.Get("name").(string)
+	input := &sfn.CreateStateMachineAliasInput{
+		Name:                 aws.String(name),
+		RoutingConfiguration: expandRoutingConfigurationListItems(d.Get("routing_configuration").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateStateMachineAliasWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Step Functions State Machine Alias (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.StateMachineAliasArn))
+
+	return resourceAliasRead(ctx, d, meta)
+}
+
+func resourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SFNConn()
+
+	output, err := FindAliasByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Step Functions State Machine Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Step Functions State Machine Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.StateMachineAliasArn)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	if err := d.Set("routing_configuration", flattenRoutingConfigurationListItems(output.RoutingConfiguration)); err != nil {
+		return diag.Errorf("setting routing_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SFNConn()
+
+	input := &sfn.UpdateStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("routing_configuration") {
+		input.RoutingConfiguration = expandRoutingConfigurationListItems(d.Get("routing_configuration").([]interface{}))
+	}
+
+	_, err := conn.UpdateStateMachineAliasWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Step Functions State Machine Alias (%s): %s", d.Id(), err)
+	}
+
+	return resourceAliasRead(ctx, d, meta)
+}
+
+func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SFNConn()
+
+	log.Printf("[DEBUG] Deleting Step Functions State Machine Alias: %s", d.Id())
+	_, err := conn.DeleteStateMachineAliasWithContext(ctx, &sfn.DeleteStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeResourceNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Step Functions State Machine Alias (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindAliasByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineAliasOutput, error) {
+	input := &sfn.DescribeStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeStateMachineAliasWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeResourceNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandRoutingConfigurationListItems(tfList []interface{}) []*sfn.RoutingConfigurationListItem {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*sfn.RoutingConfigurationListItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sfn.RoutingConfigurationListItem{}
+
+		if v, ok := tfMap["state_machine_version_arn"].(string); ok && v != "" {
+			apiObject.StateMachineVersionArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["weight"].(int); ok {
+			apiObject.Weight = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRoutingConfigurationListItems(apiObjects []*sfn.RoutingConfigurationListItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"state_machine_version_arn": aws.StringValue(apiObject.StateMachineVersionArn),
+			"weight":                    aws.Int64Value(apiObject.Weight),
+		})
+	}
+
+	return tfList
+}
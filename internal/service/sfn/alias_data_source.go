@@ -0,0 +1,119 @@
+package sfn
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_sfn_alias")
+func DataSourceAlias() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAliasRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state_machine_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"statemachine_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SFNConn()
+
+	name := d.Get("name").(string)
+	stateMachineARN := d.Get("statemachine_arn").(string)
+	input := &sfn.ListStateMachineAliasesInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+	var arns []string
+
+	for {
+		page, err := conn.ListStateMachineAliasesWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("listing Step Functions State Machine (%s) Aliases: %s", stateMachineARN, err)
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.StateMachineAliases {
+			if arn := aws.StringValue(v.StateMachineAliasArn); arn == stateMachineARN+":"+name {
+				arns = append(arns, arn)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	if n := len(arns); n == 0 {
+		return diag.Errorf("no Step Functions State Machine Aliases matched")
+	} else if n > 1 {
+		return diag.Errorf("%d Step Functions State Machine Aliases matched; use additional constraints to reduce matches to a single Alias", n)
+	}
+
+	arn := arns[0]
+	output, err := FindAliasByARN(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("reading Step Functions State Machine Alias (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+	d.Set("arn", output.StateMachineAliasArn)
+	d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	if err := d.Set("routing_configuration", flattenRoutingConfigurationListItems(output.RoutingConfiguration)); err != nil {
+		return diag.Errorf("setting routing_configuration: %s", err)
+	}
+
+	return nil
+}
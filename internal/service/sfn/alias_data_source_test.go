@@ -0,0 +1,45 @@
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNAliasDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sfn_alias.test"
+	resourceName := "aws_sfn_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "creation_date", dataSourceName, "creation_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "description", dataSourceName, "description"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.#", dataSourceName, "routing_configuration.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", dataSourceName, "routing_configuration.0.state_machine_version_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.weight", dataSourceName, "routing_configuration.0.weight"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAliasDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAliasConfig_basic(rName, 5, "description 1"), `
+data "aws_sfn_alias" "test" {
+  name             = aws_sfn_alias.test.name
+  statemachine_arn = aws_sfn_state_machine.test.arn
+}
+`)
+}
@@ -0,0 +1,209 @@
+package sfn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSFNAlias_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias sfn.DescribeStateMachineAliasOutput
+	resourceName := "aws_sfn_alias.test"
+	stateMachineResourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_basic(rName, 5, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "states", fmt.Sprintf("stateMachine:%[1]s:%[1]s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", stateMachineResourceName, "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAliasConfig_basic(rName, 5, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNAlias_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias sfn.DescribeStateMachineAliasOutput
+	resourceName := "aws_sfn_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_basic(rName, 5, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsfn.ResourceAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSFNAlias_weightedRouting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias sfn.DescribeStateMachineAliasOutput
+	resourceName := "aws_sfn_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_basic(rName, 5, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+				),
+			},
+			{
+				Config: testAccAliasConfig_weightedRouting(rName, 10, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "2"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "routing_configuration.0.state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "90"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "routing_configuration.1.state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.1.weight", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAliasExists(ctx context.Context, n string, v *sfn.DescribeStateMachineAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Step Functions State Machine Alias ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn()
+
+		output, err := tfsfn.FindAliasByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAliasDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sfn_alias" {
+				continue
+			}
+
+			_, err := tfsfn.FindAliasByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Step Functions State Machine Alias %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAliasConfig_basic(rName string, rMaxAttempts int, description string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_publish(rName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_sfn_alias" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = 100
+  }
+}
+`, rName, description))
+}
+
+// testAccAliasConfig_weightedRouting publishes a second version of the state machine
+// and splits traffic between it and the first version.
+func testAccAliasConfig_weightedRouting(rName string, rMaxAttempts, firstVersionWeight int) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_publish(rName, rMaxAttempts), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_sfn_alias" "test" {
+  name        = %[1]q
+  description = "description 1"
+
+  routing_configuration {
+    state_machine_version_arn = "arn:${data.aws_partition.current.partition}:states:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:stateMachine:%[1]s:1"
+    weight                    = %[2]d
+  }
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = %[3]d
+  }
+}
+`, rName, firstVersionWeight, 100-firstVersionWeight))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_sfn_state_machine")
 func ResourceStateMachine() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStateMachineCreate,
@@ -92,11 +94,20 @@ func ResourceStateMachine() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]+$`), "the name should only contain 0-9, A-Z, a-z, - and _"),
 				),
 			},
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"state_machine_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -127,7 +138,10 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			stateMachineUpdateComputedAttributesOnPublish,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -140,6 +154,7 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 	input := &sfn.CreateStateMachineInput{
 		Definition: aws.String(d.Get("definition").(string)),
 		Name:       aws.String(name),
+		Publish:    aws.Bool(d.Get("publish").(bool)),
 		RoleArn:    aws.String(d.Get("role_arn").(string)),
 		Tags:       Tags(tags.IgnoreAWS()),
 		Type:       aws.String(d.Get("type").(string)),
@@ -165,7 +180,10 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("creating Step Functions State Machine (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(outputRaw.(*sfn.CreateStateMachineOutput).StateMachineArn))
+	output := outputRaw.(*sfn.CreateStateMachineOutput)
+	d.SetId(aws.StringValue(output.StateMachineArn))
+	// Published versions are only returned by CreateStateMachine and UpdateStateMachine.
+	d.Set("state_machine_version_arn", output.StateMachineVersionArn)
 
 	return resourceStateMachineRead(ctx, d, meta)
 }
@@ -245,6 +263,7 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 		// "You must include at least one of definition or roleArn or you will receive a MissingRequiredParameter error"
 		input := &sfn.UpdateStateMachineInput{
 			Definition:      aws.String(d.Get("definition").(string)),
+			Publish:         aws.Bool(d.Get("publish").(bool)),
 			RoleArn:         aws.String(d.Get("role_arn").(string)),
 			StateMachineArn: aws.String(d.Id()),
 		}
@@ -261,12 +280,16 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 			}
 		}
 
-		_, err := conn.UpdateStateMachineWithContext(ctx, input)
+		output, err := conn.UpdateStateMachineWithContext(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating Step Functions State Machine (%s): %s", d.Id(), err)
 		}
 
+		if v := output.StateMachineVersionArn; v != nil {
+			d.Set("state_machine_version_arn", v)
+		}
+
 		// Handle eventual consistency after update.
 		err = resource.RetryContext(ctx, stateMachineUpdatedTimeout, func() *resource.RetryError { // nosemgrep:ci.helper-schema-resource-Retry-without-TimeoutError-check
 			output, err := FindStateMachineByARN(ctx, conn, d.Id())
@@ -376,7 +399,7 @@ func waitStateMachineDeleted(ctx context.Context, conn *sfn.SFN, stateMachineArn
 		Timeout: stateMachineDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sfn.DescribeStateMachineOutput); ok {
 		return output, err
@@ -385,6 +408,18 @@ func waitStateMachineDeleted(ctx context.Context, conn *sfn.SFN, stateMachineArn
 	return nil, err
 }
 
+func stateMachineUpdateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("publish").(bool) {
+		return nil
+	}
+
+	if d.HasChanges("definition", "logging_configuration", "publish", "role_arn", "tracing_configuration") {
+		return d.SetNewComputed("state_machine_version_arn")
+	}
+
+	return nil
+}
+
 func expandLoggingConfiguration(tfMap map[string]interface{}) *sfn.LoggingConfiguration {
 	if tfMap == nil {
 		return nil
@@ -370,6 +370,44 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_publish(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_publish(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:1", rName)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "state_machine_version_arn"},
+			},
+			{
+				Config: testAccStateMachineConfig_publish(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`.*\"MaxAttempts\": 10.*`)),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:2", rName)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -554,6 +592,40 @@ EOF
 `, rName, rMaxAttempts))
 }
 
+func testAccStateMachineConfig_publish(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  publish  = true
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "Retry": [
+        {
+          "ErrorEquals": [
+            "States.ALL"
+          ],
+          "IntervalSeconds": 5,
+          "MaxAttempts": %[2]d,
+          "BackoffRate": 8
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, rMaxAttempts))
+}
+
 func testAccStateMachineConfig_nameGenerated(rName string) string {
 	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), `
 resource "aws_sfn_state_machine" "test" {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKResource("aws_signer_signing_job")
 func ResourceSigningJob() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSigningJobCreate,
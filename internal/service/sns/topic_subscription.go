@@ -127,6 +127,7 @@ var (
 	}, subscriptionSchema).WithMissingSetToNil("*")
 )
 
+// @SDKResource("aws_sns_topic_subscription")
 func ResourceTopicSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicSubscriptionCreate,
@@ -356,7 +357,7 @@ func waitSubscriptionConfirmed(ctx context.Context, conn *sns.SNS, arn string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(map[string]string); ok {
 		return output, err
@@ -373,7 +374,7 @@ func waitSubscriptionDeleted(ctx context.Context, conn *sns.SNS, arn string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(map[string]string); ok {
 		return output, err
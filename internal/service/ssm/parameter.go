@@ -26,6 +26,7 @@ const (
 	parameterCreationValidationTimeout = 2 * time.Minute
 )
 
+// @SDKResource("aws_ssm_parameter")
 func ResourceParameter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceParameterCreate,
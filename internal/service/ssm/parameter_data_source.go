@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// @SDKDataSource("aws_ssm_parameter")
 func DataSourceParameter() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataParameterRead,
@@ -11,72 +11,116 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_ssm_document",
+			Factory:  DataSourceDocument,
+		},
+		{
+			TypeName: "aws_ssm_instances",
+			Factory:  DataSourceInstances,
+		},
+		{
+			TypeName: "aws_ssm_maintenance_windows",
+			Factory:  DataSourceMaintenanceWindows,
+		},
+		{
+			TypeName: "aws_ssm_parameter",
+			Factory:  DataSourceParameter,
+		},
+		{
+			TypeName: "aws_ssm_parameters_by_path",
+			Factory:  DataSourceParametersByPath,
+		},
+		{
+			TypeName: "aws_ssm_patch_baseline",
+			Factory:  DataSourcePatchBaseline,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "ssm"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_ssm_activation",
+			Factory:  ResourceActivation,
+		},
+		{
+			TypeName: "aws_ssm_association",
+			Factory:  ResourceAssociation,
+		},
+		{
+			TypeName: "aws_ssm_default_patch_baseline",
+			Factory:  ResourceDefaultPatchBaseline,
+		},
+		{
+			TypeName: "aws_ssm_document",
+			Factory:  ResourceDocument,
+		},
+		{
+			TypeName: "aws_ssm_maintenance_window",
+			Factory:  ResourceMaintenanceWindow,
+		},
+		{
+			TypeName: "aws_ssm_maintenance_window_target",
+			Factory:  ResourceMaintenanceWindowTarget,
+		},
+		{
+			TypeName: "aws_ssm_maintenance_window_task",
+			Factory:  ResourceMaintenanceWindowTask,
+		},
+		{
+			TypeName: "aws_ssm_parameter",
+			Factory:  ResourceParameter,
+		},
+		{
+			TypeName: "aws_ssm_patch_baseline",
+			Factory:  ResourcePatchBaseline,
+		},
+		{
+			TypeName: "aws_ssm_patch_group",
+			Factory:  ResourcePatchGroup,
+		},
+		{
+			TypeName: "aws_ssm_resource_data_sync",
+			Factory:  ResourceResourceDataSync,
+		},
+		{
+			TypeName: "aws_ssm_service_setting",
+			Factory:  ResourceServiceSetting,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "ssm"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
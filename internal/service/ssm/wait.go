@@ -24,7 +24,7 @@ func waitAssociationSuccess(ctx context.Context, conn *ssm.SSM, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.AssociationDescription); ok && output.Overview != nil {
 		if status := aws.StringValue(output.Overview.Status); status == ssm.AssociationStatusNameFailed {
@@ -45,7 +45,7 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.SSM, name string) (*ssm.
 		Timeout: documentDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.DocumentDescription); ok {
 		return output, err
@@ -63,7 +63,7 @@ func waitDocumentActive(ctx context.Context, conn *ssm.SSM, name string) (*ssm.D
 		Timeout: documentActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.DocumentDescription); ok {
 		return output, err
@@ -80,7 +80,7 @@ func waitServiceSettingUpdated(ctx context.Context, conn *ssm.SSM, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.ServiceSetting); ok {
 		return output, err
@@ -97,7 +97,7 @@ func waitServiceSettingReset(ctx context.Context, conn *ssm.SSM, id string, time
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.SSOAdmin,
 		MinTimeout: accountAssignmentMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
 		return v, err
 	}
@@ -45,7 +46,7 @@ func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.SSOAdmin,
 		MinTimeout: accountAssignmentMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
 		return v, err
 	}
@@ -61,7 +62,7 @@ func waitPermissionSetProvisioned(ctx context.Context, conn *ssoadmin.SSOAdmin,
 		Refresh: statusPermissionSetProvisioning(ctx, conn, instanceArn, requestID),
 		Timeout: permissionSetProvisionTimeout,
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
 		return v, err
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -31,7 +32,7 @@ func waitGatewayConnected(ctx context.Context, conn *storagegateway.StorageGatew
 		ContinuousTargetOccurence: gatewayConnectedContinuousTargetOccurence, // Gateway activations can take a few seconds and can trigger a reboot of the Gateway
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	switch output := outputRaw.(type) {
 	case *storagegateway.DescribeGatewayInformationOutput:
@@ -49,7 +50,7 @@ func waitGatewayJoinDomainJoined(ctx context.Context, conn *storagegateway.Stora
 		Timeout: gatewayJoinDomainJoinedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeSMBSettingsOutput); ok {
 		return output, err
@@ -67,7 +68,7 @@ func waitStorediSCSIVolumeAvailable(ctx context.Context, conn *storagegateway.St
 		Timeout: storediSCSIVolumeAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeStorediSCSIVolumesOutput); ok {
 		return output, err
@@ -85,7 +86,7 @@ func waitNFSFileShareCreated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   nfsFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -104,7 +105,7 @@ func waitNFSFileShareDeleted(ctx context.Context, conn *storagegateway.StorageGa
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -122,7 +123,7 @@ func waitNFSFileShareUpdated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   nfsFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -140,7 +141,7 @@ func waitSMBFileShareCreated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   smbFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -159,7 +160,7 @@ func waitSMBFileShareDeleted(ctx context.Context, conn *storagegateway.StorageGa
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -177,7 +178,7 @@ func waitSMBFileShareUpdated(ctx context.Context, conn *storagegateway.StorageGa
 		Delay:   smbFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -195,7 +196,7 @@ func waitFileSystemAssociationAvailable(ctx context.Context, conn *storagegatewa
 		Delay:   fileSystemAssociationAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
 		return output, err
@@ -214,7 +215,7 @@ func waitFileSystemAssociationDeleted(ctx context.Context, conn *storagegateway.
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
 		return output, err
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_storagegateway_working_storage")
 func ResourceWorkingStorage() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceWorkingStorageCreate,
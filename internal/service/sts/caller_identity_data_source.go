@@ -11,11 +11,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 )
 
-func init() {
-	_sp.registerFrameworkDataSourceFactory(newDataSourceCallerIdentity)
-}
-
 // newDataSourceCallerIdentity instantiates a new DataSource for the aws_caller_identity data source.
+// @FrameworkDataSource
 func newDataSourceCallerIdentity(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceCallerIdentity{}
 	d.SetMigratedFromPluginSDK(true)
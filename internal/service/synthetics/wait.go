@@ -26,7 +26,7 @@ func waitCanaryReady(ctx context.Context, conn *synthetics.Synthetics, name stri
 		Timeout: canaryCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
@@ -53,7 +53,7 @@ func waitCanaryStopped(ctx context.Context, conn *synthetics.Synthetics, name st
 		Timeout: canaryStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
@@ -79,7 +79,7 @@ func waitCanaryRunning(ctx context.Context, conn *synthetics.Synthetics, name st
 		Timeout: canaryRunningTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
@@ -100,7 +100,7 @@ func waitCanaryDeleted(ctx context.Context, conn *synthetics.Synthetics, name st
 		Timeout: canaryDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_transcribe_language_model")
 func ResourceLanguageModel() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLanguageModelCreate,
@@ -247,7 +248,7 @@ func waitLanguageModelCreated(ctx context.Context, conn *transcribe.Client, id s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.LanguageModel); ok {
 		return out, err
 	}
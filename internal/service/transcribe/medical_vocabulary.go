@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// @SDKResource("aws_transcribe_medical_vocabulary")
 func ResourceMedicalVocabulary() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMedicalVocabularyCreate,
@@ -227,7 +228,7 @@ func waitMedicalVocabularyCreated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -246,7 +247,7 @@ func waitMedicalVocabularyUpdated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -263,7 +264,7 @@ func waitMedicalVocabularyDeleted(ctx context.Context, conn *transcribe.Client,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_transcribe_vocabulary")
 func ResourceVocabulary() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVocabularyCreate,
@@ -252,7 +253,7 @@ func waitVocabularyCreated(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -274,7 +275,7 @@ func waitVocabularyUpdated(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -294,7 +295,7 @@ func waitVocabularyDeleted(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -0,0 +1,267 @@
+package transfer_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAgreement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedAgreement
+	resourceName := "aws_transfer_agreement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgreementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgreementConfig_basic(rName, "/DOC-EXAMPLE-BUCKET/home/mydirectory"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "access_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "agreement_id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "transfer", regexp.MustCompile(`agreement/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "base_directory", "/DOC-EXAMPLE-BUCKET/home/mydirectory"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "local_profile_id", "aws_transfer_profile.local", "profile_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "partner_profile_id", "aws_transfer_profile.partner", "profile_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_id", "aws_transfer_server.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAgreementConfig_basic(rName, "/DOC-EXAMPLE-BUCKET/home/mydirectory2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "base_directory", "/DOC-EXAMPLE-BUCKET/home/mydirectory2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAgreement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedAgreement
+	resourceName := "aws_transfer_agreement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgreementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgreementConfig_basic(rName, "/DOC-EXAMPLE-BUCKET/home/mydirectory"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftransfer.ResourceAgreement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAgreement_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedAgreement
+	resourceName := "aws_transfer_agreement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgreementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgreementConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAgreementConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAgreementConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgreementExists(ctx context.Context, n string, v *transfer.DescribedAgreement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Agreement ID is set")
+		}
+
+		serverID, agreementID, err := tftransfer.AgreementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+		output, err := tftransfer.FindAgreementByServerIDAndAgreementID(ctx, conn, serverID, agreementID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAgreementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_transfer_agreement" {
+				continue
+			}
+
+			serverID, agreementID, err := tftransfer.AgreementParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tftransfer.FindAgreementByServerIDAndAgreementID(ctx, conn, serverID, agreementID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Transfer Agreement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAgreementConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccServerConfig_vpcBase(rName),
+		testAccServerConfig_loggingRoleBase(rName),
+		fmt.Sprintf(`
+resource "aws_transfer_profile" "local" {
+  as2_id       = "%[1]s-local"
+  profile_type = "LOCAL"
+}
+
+resource "aws_transfer_profile" "partner" {
+  as2_id       = "%[1]s-partner"
+  profile_type = "PARTNER"
+}
+
+resource "aws_transfer_server" "test" {
+  endpoint_type = "VPC"
+  logging_role  = aws_iam_role.test.arn
+  protocols     = ["AS2"]
+
+  endpoint_details {
+    subnet_ids = [aws_subnet.test.id]
+    vpc_id     = aws_vpc.test.id
+  }
+}
+`, rName))
+}
+
+func testAccAgreementConfig_basic(rName, baseDirectory string) string {
+	return acctest.ConfigCompose(testAccAgreementConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_agreement" "test" {
+  access_role        = aws_iam_role.test.arn
+  base_directory     = %[1]q
+  local_profile_id   = aws_transfer_profile.local.profile_id
+  partner_profile_id = aws_transfer_profile.partner.profile_id
+  server_id          = aws_transfer_server.test.id
+}
+`, baseDirectory))
+}
+
+func testAccAgreementConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAgreementConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_agreement" "test" {
+  access_role        = aws_iam_role.test.arn
+  base_directory     = "/DOC-EXAMPLE-BUCKET/home/mydirectory"
+  local_profile_id   = aws_transfer_profile.local.profile_id
+  partner_profile_id = aws_transfer_profile.partner.profile_id
+  server_id          = aws_transfer_server.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAgreementConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAgreementConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_agreement" "test" {
+  access_role        = aws_iam_role.test.arn
+  base_directory     = "/DOC-EXAMPLE-BUCKET/home/mydirectory"
+  local_profile_id   = aws_transfer_profile.local.profile_id
+  partner_profile_id = aws_transfer_profile.partner.profile_id
+  server_id          = aws_transfer_server.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
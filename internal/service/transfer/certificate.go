@@ -0,0 +1,272 @@
+package transfer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_transfer_certificate")
+func ResourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCertificateCreate,
+		ReadWithoutTimeout:   resourceCertificateRead,
+		UpdateWithoutTimeout: resourceCertificateUpdate,
+		DeleteWithoutTimeout: resourceCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"active_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 16384),
+			},
+			"certificate_chain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 2097152),
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"inactive_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"not_after_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The private key is write-only: it is never returned by the API.
+			"private_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 16384),
+			},
+			"serial": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"usage": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transfer.CertificateUsageType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &transfer.ImportCertificateInput{
+		Certificate: aws.String(d.Get("certificate").(string)),
+		Usage:       aws.String(d.Get("usage").(string)),
+	}
+
+	if v, ok := d.GetOk("active_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ActiveDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("certificate_chain"); ok {
+		input.CertificateChain = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("inactive_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.InactiveDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("private_key"); ok {
+		input.PrivateKey = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.ImportCertificateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing Transfer Certificate: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CertificateId))
+
+	return append(diags, resourceCertificateRead(ctx, d, meta)...)
+}
+
+func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindCertificateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Certificate (%s): %s", d.Id(), err)
+	}
+
+	d.Set("active_date", flattenTimestamp(output.ActiveDate))
+	d.Set("arn", output.Arn)
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+	d.Set("certificate_id", output.CertificateId)
+	d.Set("description", output.Description)
+	d.Set("inactive_date", flattenTimestamp(output.InactiveDate))
+	d.Set("not_after_date", flattenTimestamp(output.NotAfterDate))
+	d.Set("not_before_date", flattenTimestamp(output.NotBeforeDate))
+	d.Set("serial", output.Serial)
+	d.Set("type", output.Type)
+	d.Set("usage", output.Usage)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &transfer.UpdateCertificateInput{
+			CertificateId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("active_date") {
+			v, _ := time.Parse(time.RFC3339, d.Get("active_date").(string))
+			input.ActiveDate = aws.Time(v)
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("inactive_date") {
+			v, _ := time.Parse(time.RFC3339, d.Get("inactive_date").(string))
+			input.InactiveDate = aws.Time(v)
+		}
+
+		_, err := conn.UpdateCertificateWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Transfer Certificate (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags: %s", err)
+		}
+	}
+
+	return append(diags, resourceCertificateRead(ctx, d, meta)...)
+}
+
+func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn()
+
+	log.Printf("[DEBUG] Deleting Transfer Certificate: (%s)", d.Id())
+	_, err := conn.DeleteCertificateWithContext(ctx, &transfer.DeleteCertificateInput{
+		CertificateId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer Certificate (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func flattenTimestamp(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+
+	return aws.TimeValue(v).Format(time.RFC3339)
+}
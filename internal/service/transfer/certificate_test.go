@@ -0,0 +1,299 @@
+package transfer_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTransferCertificate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedCertificate
+	resourceName := "aws_transfer_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_basic(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "transfer", regexp.MustCompile(`certificate/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "CERTIFICATE"),
+					resource.TestCheckResourceAttr(resourceName, "usage", "SIGNING"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferCertificate_privateKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedCertificate
+	resourceName := "aws_transfer_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_privateKey(certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "type", "CERTIFICATE_WITH_PRIVATE_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "usage", "ENCRYPTION"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+		},
+	})
+}
+
+func TestAccTransferCertificate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedCertificate
+	resourceName := "aws_transfer_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_basic(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftransfer.ResourceCertificate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferCertificate_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedCertificate
+	resourceName := "aws_transfer_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_description(certificate, "desc1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCertificateConfig_description(certificate, "desc2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTransferCertificate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedCertificate
+	resourceName := "aws_transfer_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_tags1(certificate, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCertificateConfig_tags2(certificate, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCertificateConfig_tags1(certificate, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCertificateExists(ctx context.Context, n string, v *transfer.DescribedCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Certificate ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+		output, err := tftransfer.FindCertificateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCertificateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_transfer_certificate" {
+				continue
+			}
+
+			_, err := tftransfer.FindCertificateByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Transfer Certificate %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCertificateConfig_basic(certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_certificate" "test" {
+  certificate = "%[1]s"
+  usage       = "SIGNING"
+}
+`, acctest.TLSPEMEscapeNewlines(certificate))
+}
+
+func testAccCertificateConfig_privateKey(certificate, key string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_certificate" "test" {
+  certificate = "%[1]s"
+  private_key = "%[2]s"
+  usage       = "ENCRYPTION"
+}
+`, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key))
+}
+
+func testAccCertificateConfig_description(certificate, description string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_certificate" "test" {
+  certificate = "%[1]s"
+  description = %[2]q
+  usage       = "SIGNING"
+}
+`, acctest.TLSPEMEscapeNewlines(certificate), description)
+}
+
+func testAccCertificateConfig_tags1(certificate, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_certificate" "test" {
+  certificate = "%[1]s"
+  usage       = "SIGNING"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(certificate), tagKey1, tagValue1)
+}
+
+func testAccCertificateConfig_tags2(certificate, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_certificate" "test" {
+  certificate = "%[1]s"
+  usage       = "SIGNING"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(certificate), tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,363 @@
+package transfer_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTransferConnector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, "http://www.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "access_role", "aws_iam_role.test", "arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "transfer", regexp.MustCompile(`connector/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.0.compression", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.0.encryption_algorithm", "AES128_CBC"),
+					resource.TestCheckResourceAttrPair(resourceName, "as2_config.0.local_profile_id", "aws_transfer_profile.local", "profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.0.mdn_response", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.0.message_subject", "For Connector"),
+					resource.TestCheckResourceAttrPair(resourceName, "as2_config.0.partner_profile_id", "aws_transfer_profile.partner", "profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.0.signing_algorithm", "NONE"),
+					resource.TestCheckResourceAttrSet(resourceName, "connector_id"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "url", "http://www.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorConfig_basic(rName, "http://www.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "url", "http://www.example.net"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTransferConnector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, "http://www.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftransfer.ResourceConnector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferConnector_sftpConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_sftpConfig(rName, "sftp://s-fakeserver.server.transfer.test.amazonaws.com", publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sftp_config.0.trusted_host_keys.*", publicKey),
+					resource.TestCheckResourceAttrPair(resourceName, "sftp_config.0.user_secret_id", "aws_secretsmanager_secret.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferConnector_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConnectorConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorExists(ctx context.Context, n string, v *transfer.DescribedConnector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Connector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+		output, err := tftransfer.FindConnectorByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConnectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_transfer_connector" {
+				continue
+			}
+
+			_, err := tftransfer.FindConnectorByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Transfer Connector %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccConnectorConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "transfer.amazonaws.com"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+      "s3:*",
+      "secretsmanager:GetSecretValue"
+    ],
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_transfer_profile" "local" {
+  as2_id       = "%[1]s-local"
+  profile_type = "LOCAL"
+}
+
+resource "aws_transfer_profile" "partner" {
+  as2_id       = "%[1]s-partner"
+  profile_type = "PARTNER"
+}
+`, rName)
+}
+
+func testAccConnectorConfig_basic(rName, url string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+
+  as2_config {
+    compression           = "DISABLED"
+    encryption_algorithm  = "AES128_CBC"
+    message_subject       = "For Connector"
+    local_profile_id      = aws_transfer_profile.local.profile_id
+    mdn_response          = "NONE"
+    mdn_signing_algorithm = "NONE"
+    partner_profile_id    = aws_transfer_profile.partner.profile_id
+    signing_algorithm     = "NONE"
+  }
+
+  url = %[1]q
+}
+`, url))
+}
+
+func testAccConnectorConfig_sftpConfig(rName, url, publicKey string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+
+  sftp_config {
+    trusted_host_keys = [%[3]q]
+    user_secret_id    = aws_secretsmanager_secret.test.id
+  }
+
+  url = %[2]q
+}
+`, rName, url, publicKey))
+}
+
+func testAccConnectorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+
+  as2_config {
+    compression           = "DISABLED"
+    encryption_algorithm  = "AES128_CBC"
+    message_subject       = "For Connector"
+    local_profile_id      = aws_transfer_profile.local.profile_id
+    mdn_response          = "NONE"
+    mdn_signing_algorithm = "NONE"
+    partner_profile_id    = aws_transfer_profile.partner.profile_id
+    signing_algorithm     = "NONE"
+  }
+
+  url = "http://www.example.com"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccConnectorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+
+  as2_config {
+    compression           = "DISABLED"
+    encryption_algorithm  = "AES128_CBC"
+    message_subject       = "For Connector"
+    local_profile_id      = aws_transfer_profile.local.profile_id
+    mdn_response          = "NONE"
+    mdn_signing_algorithm = "NONE"
+    partner_profile_id    = aws_transfer_profile.partner.profile_id
+    signing_algorithm     = "NONE"
+  }
+
+  url = "http://www.example.com"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -9,15 +9,46 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
+	resource.AddTestSweepers("aws_transfer_agreement", &resource.Sweeper{
+		Name: "aws_transfer_agreement",
+		F:    sweepAgreements,
+	})
+
+	resource.AddTestSweepers("aws_transfer_certificate", &resource.Sweeper{
+		Name: "aws_transfer_certificate",
+		F:    sweepCertificates,
+		Dependencies: []string{
+			"aws_transfer_profile",
+		},
+	})
+
+	resource.AddTestSweepers("aws_transfer_connector", &resource.Sweeper{
+		Name: "aws_transfer_connector",
+		F:    sweepConnectors,
+	})
+
+	resource.AddTestSweepers("aws_transfer_profile", &resource.Sweeper{
+		Name: "aws_transfer_profile",
+		F:    sweepProfiles,
+		Dependencies: []string{
+			"aws_transfer_agreement",
+			"aws_transfer_connector",
+		},
+	})
+
 	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
 		Name: "aws_transfer_server",
 		F:    sweepServers,
+		Dependencies: []string{
+			"aws_transfer_agreement",
+		},
 	})
 
 	resource.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
@@ -29,6 +60,202 @@ func init() {
 	})
 }
 
+func sweepAgreements(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListServersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListServersPagesWithContext(ctx, input, func(page *transfer.ListServersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, server := range page.Servers {
+			serverID := aws.StringValue(server.ServerId)
+			input := &transfer.ListAgreementsInput{
+				ServerId: aws.String(serverID),
+			}
+
+			err := conn.ListAgreementsPagesWithContext(ctx, input, func(page *transfer.ListAgreementsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, agreement := range page.Agreements {
+					r := ResourceAgreement()
+					d := r.Data(nil)
+					d.SetId(AgreementCreateResourceID(serverID, aws.StringValue(agreement.AgreementId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Transfer Agreements for Server (%s): %w", serverID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Agreement sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Transfer Servers (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Transfer Agreements (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepCertificates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListCertificatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCertificatesPagesWithContext(ctx, input, func(page *transfer.ListCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, certificate := range page.Certificates {
+			r := ResourceCertificate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(certificate.CertificateId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Certificate sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Certificates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Certificates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepConnectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListConnectorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListConnectorsPagesWithContext(ctx, input, func(page *transfer.ListConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, connector := range page.Connectors {
+			r := ResourceConnector()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(connector.ConnectorId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Connector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Connectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Connectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListProfilesPagesWithContext(ctx, input, func(page *transfer.ListProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, profile := range page.Profiles {
+			r := ResourceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(profile.ProfileId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepServers(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +22,7 @@ func waitServerCreated(ctx context.Context, conn *transfer.Transfer, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -38,7 +39,7 @@ func waitServerDeleted(ctx context.Context, conn *transfer.Transfer, id string)
 		Timeout: serverDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -55,7 +56,7 @@ func waitServerStarted(ctx context.Context, conn *transfer.Transfer, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -72,7 +73,7 @@ func waitServerStopped(ctx context.Context, conn *transfer.Transfer, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -89,7 +90,7 @@ func waitUserDeleted(ctx context.Context, conn *transfer.Transfer, serverID, use
 		Timeout: userDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedUser); ok {
 		return output, err
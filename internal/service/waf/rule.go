@@ -26,6 +26,7 @@ const (
 	RuleDeleteTimeout = 5 * time.Minute
 )
 
+// @SDKResource("aws_waf_rule")
 func ResourceRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRuleCreate,
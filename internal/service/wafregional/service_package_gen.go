@@ -11,72 +11,116 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 )
 
-type servicePackage struct {
-	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
-	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
-	sdkDataSourceFactories       []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-	sdkResourceFactories []struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}
-}
+type servicePackage struct{}
 
 func (p *servicePackage) Configure(ctx context.Context, meta any) error {
 	return nil
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error) {
-	return p.frameworkDataSourceFactories
+	return []func(context.Context) (datasource.DataSourceWithConfigure, error){}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return p.frameworkResourceFactories
+	return []func(context.Context) (resource.ResourceWithConfigure, error){}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkDataSourceFactories
+	return []struct {
+		TypeName string
+		Factory  func() *schema.Resource
+	}{
+		{
+			TypeName: "aws_wafregional_ipset",
+			Factory:  DataSourceIPSet,
+		},
+		{
+			TypeName: "aws_wafregional_rate_based_rule",
+			Factory:  DataSourceRateBasedRule,
+		},
+		{
+			TypeName: "aws_wafregional_rule",
+			Factory:  DataSourceRule,
+		},
+		{
+			TypeName: "aws_wafregional_subscribed_rule_group",
+			Factory:  DataSourceSubscribedRuleGroup,
+		},
+		{
+			TypeName: "aws_wafregional_web_acl",
+			Factory:  DataSourceWebACL,
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
 } {
-	return p.sdkResourceFactories
-}
-
-func (p *servicePackage) ServicePackageName() string {
-	return "wafregional"
-}
-
-func (p *servicePackage) registerFrameworkDataSourceFactory(factory func(context.Context) (datasource.DataSourceWithConfigure, error)) {
-	p.frameworkDataSourceFactories = append(p.frameworkDataSourceFactories, factory)
-}
-
-func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.Context) (resource.ResourceWithConfigure, error)) {
-	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
-}
-
-func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
+	return []struct {
 		TypeName string
 		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+	}{
+		{
+			TypeName: "aws_wafregional_byte_match_set",
+			Factory:  ResourceByteMatchSet,
+		},
+		{
+			TypeName: "aws_wafregional_geo_match_set",
+			Factory:  ResourceGeoMatchSet,
+		},
+		{
+			TypeName: "aws_wafregional_ipset",
+			Factory:  ResourceIPSet,
+		},
+		{
+			TypeName: "aws_wafregional_rate_based_rule",
+			Factory:  ResourceRateBasedRule,
+		},
+		{
+			TypeName: "aws_wafregional_regex_match_set",
+			Factory:  ResourceRegexMatchSet,
+		},
+		{
+			TypeName: "aws_wafregional_regex_pattern_set",
+			Factory:  ResourceRegexPatternSet,
+		},
+		{
+			TypeName: "aws_wafregional_rule",
+			Factory:  ResourceRule,
+		},
+		{
+			TypeName: "aws_wafregional_rule_group",
+			Factory:  ResourceRuleGroup,
+		},
+		{
+			TypeName: "aws_wafregional_size_constraint_set",
+			Factory:  ResourceSizeConstraintSet,
+		},
+		{
+			TypeName: "aws_wafregional_sql_injection_match_set",
+			Factory:  ResourceSQLInjectionMatchSet,
+		},
+		{
+			TypeName: "aws_wafregional_web_acl",
+			Factory:  ResourceWebACL,
+		},
+		{
+			TypeName: "aws_wafregional_web_acl_association",
+			Factory:  ResourceWebACLAssociation,
+		},
+		{
+			TypeName: "aws_wafregional_xss_match_set",
+			Factory:  ResourceXSSMatchSet,
+		},
+	}
 }
 
-func (p *servicePackage) registerSDKResourceFactory(typeName string, factory func() *schema.Resource) {
-	p.sdkResourceFactories = append(p.sdkResourceFactories, struct {
-		TypeName string
-		Factory  func() *schema.Resource
-	}{TypeName: typeName, Factory: factory})
+func (p *servicePackage) ServicePackageName() string {
+	return "wafregional"
 }
 
-var (
-	_sp                                = &servicePackage{}
-	ServicePackage intf.ServicePackage = _sp
-)
+var ServicePackage intf.ServicePackage = &servicePackage{}
@@ -25,6 +25,7 @@ const (
 	ipSetDeleteTimeout = 5 * time.Minute
 )
 
+// @SDKResource("aws_wafv2_ip_set")
 func ResourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIPSetCreate,
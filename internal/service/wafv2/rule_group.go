@@ -27,6 +27,7 @@ const (
 	ruleGroupDeleteTimeout = 5 * time.Minute
 )
 
+// @SDKResource("aws_wafv2_rule_group")
 func ResourceRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRuleGroupCreate,
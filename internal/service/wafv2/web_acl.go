@@ -27,6 +27,7 @@ const (
 	webACLDeleteTimeout = 5 * time.Minute
 )
 
+// @SDKResource("aws_wafv2_web_acl")
 func ResourceWebACL() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceWebACLCreate,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -39,7 +40,7 @@ func WaitDirectoryRegistered(ctx context.Context, conn *workspaces.WorkSpaces, d
 		Timeout: DirectoryRegisteredTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*workspaces.WorkspaceDirectory); ok {
 		return v, err
@@ -60,7 +61,7 @@ func WaitDirectoryDeregistered(ctx context.Context, conn *workspaces.WorkSpaces,
 		Timeout: DirectoryDeregisteredTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*workspaces.WorkspaceDirectory); ok {
 		return v, err
@@ -80,7 +81,7 @@ func WaitWorkspaceAvailable(ctx context.Context, conn *workspaces.WorkSpaces, wo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*workspaces.Workspace); ok {
 		return v, err
@@ -114,7 +115,7 @@ func WaitWorkspaceTerminated(ctx context.Context, conn *workspaces.WorkSpaces, w
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*workspaces.Workspace); ok {
 		return v, err
@@ -139,7 +140,7 @@ func WaitWorkspaceUpdated(ctx context.Context, conn *workspaces.WorkSpaces, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*workspaces.Workspace); ok {
 		return v, err
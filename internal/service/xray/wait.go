@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +22,7 @@ func waitEncryptionConfigAvailable(ctx context.Context, conn *xray.XRay) (*xray.
 		Timeout: encryptionConfigAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*xray.EncryptionConfig); ok {
 		return v, err
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -189,6 +191,7 @@ func Retry(ctx context.Context, timeout time.Duration, f resource.RetryFunc, opt
 	// avoid a data race.
 	var resultErr error
 	var resultErrMu sync.Mutex
+	var attempt int
 
 	options := Options{}
 	for _, fn := range optFns {
@@ -206,6 +209,8 @@ func Retry(ctx context.Context, timeout time.Duration, f resource.RetryFunc, opt
 			resultErrMu.Lock()
 			defer resultErrMu.Unlock()
 
+			attempt++
+
 			if rerr == nil {
 				resultErr = nil
 				return 42, "success", nil
@@ -214,6 +219,13 @@ func Retry(ctx context.Context, timeout time.Duration, f resource.RetryFunc, opt
 			resultErr = rerr.Err
 
 			if rerr.Retryable {
+				var attrs []attribute.KeyValue
+				attrs = append(attrs, tracing.AttrAttempt.Int(attempt))
+				if rerr.Err != nil {
+					attrs = append(attrs, tracing.AttrErrorMessage.String(rerr.Err.Error()))
+				}
+				tracing.AddEvent(ctx, "retry", attrs...)
+
 				return 42, "retryableerror", nil
 			}
 
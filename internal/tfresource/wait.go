@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type WaitOpts struct {
@@ -50,7 +52,42 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := WaitForStateContext(ctx, stateConf)
 
 	return err
 }
+
+// WaitForStateContext waits for the state described by `conf`, recording each call to its
+// Refresh function as a "poll" event on the tracing span in `ctx`.
+// Waiters should call this function rather than `conf.WaitForStateContext` directly.
+func WaitForStateContext(ctx context.Context, conf *resource.StateChangeConf) (interface{}, error) {
+	if tracing.Enabled() && conf.Refresh != nil {
+		traced := *conf
+		traced.Refresh = TracedRefreshFunc(ctx, conf.Refresh)
+		conf = &traced
+	}
+
+	return conf.WaitForStateContext(ctx)
+}
+
+// TracedRefreshFunc returns a StateRefreshFunc that records each call to `f` as a "poll" event
+// on the tracing span in `ctx`. It has no effect unless tracing is enabled.
+func TracedRefreshFunc(ctx context.Context, f resource.StateRefreshFunc) resource.StateRefreshFunc {
+	var poll int
+
+	return func() (interface{}, string, error) {
+		result, state, err := f()
+
+		poll++
+		attrs := []attribute.KeyValue{
+			tracing.AttrAttempt.Int(poll),
+			tracing.AttrObservedState.String(state),
+		}
+		if err != nil {
+			attrs = append(attrs, tracing.AttrErrorMessage.String(err.Error()))
+		}
+		tracing.AddEvent(ctx, "poll", attrs...)
+
+		return result, state, err
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -67,3 +68,39 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestWaitForStateContext(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var polls int
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			polls++
+
+			if polls < 2 {
+				return "pending", "PENDING", nil
+			}
+
+			return "done", "DONE", nil
+		},
+		Timeout:    5 * time.Second,
+		MinTimeout: 10 * time.Millisecond,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := outputRaw, "done"; got != expected {
+		t.Errorf("got %v, expected %s", got, expected)
+	}
+
+	if got, expected := polls, 2; got != expected {
+		t.Errorf("got %d polls, expected %d", got, expected)
+	}
+}
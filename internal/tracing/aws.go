@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentSession adds handlers to an AWS SDK for Go v1 session that trace each API call.
+func InstrumentSession(sess *session.Session) {
+	sess.Handlers.Validate.SetFrontNamed(request.NamedHandler{Name: "tracing.StartSpan", Fn: startSDKv1Span})
+	sess.Handlers.AfterRetry.SetBackNamed(request.NamedHandler{Name: "tracing.RetryEvent", Fn: retrySDKv1Event})
+	sess.Handlers.Complete.SetBackNamed(request.NamedHandler{Name: "tracing.EndSpan", Fn: endSDKv1Span})
+}
+
+type sdkv1SpanKey struct{}
+
+func startSDKv1Span(r *request.Request) {
+	ctx, span := Start(r.Context(), r.ClientInfo.ServiceID+"."+r.Operation.Name, trace.SpanKindClient,
+		AttrService.String(r.ClientInfo.ServiceID),
+		AttrOperation.String(r.Operation.Name),
+		AttrRegion.String(aws_sdkv2.ToString(r.Config.Region)),
+	)
+
+	r.SetContext(context.WithValue(ctx, sdkv1SpanKey{}, span))
+}
+
+func retrySDKv1Event(r *request.Request) {
+	if !r.WillRetry() {
+		return
+	}
+
+	AddEvent(r.Context(), "retry", AttrAttempt.Int(r.RetryCount), AttrErrorCode.String(sdkv1ErrorCode(r.Error)))
+}
+
+func endSDKv1Span(r *request.Request) {
+	span, ok := r.Context().Value(sdkv1SpanKey{}).(trace.Span)
+
+	if !ok {
+		return
+	}
+
+	if r.RequestID != "" {
+		span.SetAttributes(AttrRequestID.String(r.RequestID))
+	}
+
+	if r.Error != nil {
+		span.SetAttributes(AttrErrorCode.String(sdkv1ErrorCode(r.Error)))
+	}
+
+	End(span, r.Error)
+}
+
+func sdkv1ErrorCode(err error) string {
+	var awsErr awserr.Error
+
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+
+	return ""
+}
+
+// InstrumentAWSConfig adds middleware to an AWS SDK for Go v2 configuration that traces each API call.
+func InstrumentAWSConfig(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, addSDKv2Middleware)
+}
+
+type sdkv2AttemptKey struct{}
+
+func addSDKv2Middleware(stack *middleware.Stack) error {
+	// Add after the service metadata middleware so that the service ID and operation name are available.
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tracing.Span", sdkv2SpanMiddleware), middleware.After); err != nil {
+		return err
+	}
+
+	if _, ok := stack.Finalize.Get("Retry"); !ok {
+		return nil
+	}
+
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("tracing.Attempt", sdkv2AttemptMiddleware), "Retry", middleware.After)
+}
+
+func sdkv2SpanMiddleware(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	service, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+	ctx, span := Start(ctx, service+"."+operation, trace.SpanKindClient,
+		AttrService.String(service),
+		AttrOperation.String(operation),
+		AttrRegion.String(awsmiddleware.GetRegion(ctx)),
+	)
+	ctx = context.WithValue(ctx, sdkv2AttemptKey{}, new(int))
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		span.SetAttributes(AttrRequestID.String(v))
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		span.SetAttributes(AttrErrorCode.String(apiErr.ErrorCode()))
+	}
+
+	End(span, err)
+
+	return out, metadata, err
+}
+
+func sdkv2AttemptMiddleware(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	if attempt, ok := ctx.Value(sdkv2AttemptKey{}).(*int); ok {
+		// Attempts are made sequentially by the retry middleware.
+		*attempt++
+
+		if *attempt > 1 {
+			AddEvent(ctx, "retry", AttrAttempt.Int(*attempt-1))
+		}
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
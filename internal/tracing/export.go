@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// otlpExporterOptions returns the options for an OTLP/HTTP exporter sending spans to the collector at the specified base URL.
+func otlpExporterOptions(endpoint string) ([]otlptracehttp.Option, error) {
+	u, err := url.Parse(endpoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []otlptracehttp.Option
+
+	switch u.Scheme {
+	case "http":
+		opts = append(opts, otlptracehttp.WithInsecure())
+	case "https":
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q, expected \"http\" or \"https\"", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing host")
+	}
+
+	opts = append(opts,
+		otlptracehttp.WithEndpoint(u.Host),
+		otlptracehttp.WithURLPath(strings.TrimSuffix(u.Path, "/")+"/v1/traces"),
+	)
+
+	return opts, nil
+}
+
+func newOTLPExporter(ctx context.Context, endpoint string) (sdktrace.SpanExporter, error) {
+	opts, err := otlpExporterOptions(endpoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return otlptracehttp.New(ctx, opts...)
+}
+
+// fileExporter appends spans to a local file, one JSON object per span.
+type fileExporter struct {
+	*stdouttrace.Exporter
+	file *os.File
+}
+
+var _ sdktrace.SpanExporter = (*fileExporter)(nil)
+
+func newFileExporter(path string) (*fileExporter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+
+	if err != nil {
+		file.Close()
+
+		return nil, err
+	}
+
+	return &fileExporter{Exporter: exporter, file: file}, nil
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	if err := e.Exporter.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	return e.file.Close()
+}
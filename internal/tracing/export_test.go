@@ -0,0 +1,169 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans creates a parent and child span, the child ending in error, and exports them.
+func recordSpans(t *testing.T, exporter sdktrace.SpanExporter) {
+	t.Helper()
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracer := tp.Tracer(instrumentationName)
+
+	ctx, parent := tracer.Start(context.Background(), "aws_test.Create")
+	ctx, child := tracer.Start(ctx, "EC2.RunInstances", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		AttrService.String("EC2"),
+		AttrRequestID.String("abc-123"),
+		AttrAttempt.Int(2),
+	))
+	AddEvent(ctx, "retry", AttrAttempt.Int(1))
+	End(child, errors.New("boom"))
+	End(parent, nil)
+
+	if err := tp.Shutdown(context.Background()); err != nil {
+		t.Fatalf("shutting down: %s", err)
+	}
+}
+
+func TestFileExporter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	exporter, err := newFileExporter(path)
+
+	if err != nil {
+		t.Fatalf("creating exporter: %s", err)
+	}
+
+	recordSpans(t, exporter)
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("opening file: %s", err)
+	}
+
+	defer file.Close()
+
+	type span struct {
+		Name   string
+		Parent struct {
+			SpanID string
+		}
+		Status struct {
+			Code string
+		}
+		Events []struct {
+			Name string
+		}
+	}
+
+	spans := make(map[string]span)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var v span
+
+		if err := json.Unmarshal(scanner.Bytes(), &v); err != nil {
+			t.Fatalf("unmarshaling line %d: %s", len(spans), err)
+		}
+
+		spans[v.Name] = v
+	}
+
+	if got, expected := len(spans), 2; got != expected {
+		t.Fatalf("got %d spans, expected %d", got, expected)
+	}
+
+	child := spans["EC2.RunInstances"]
+
+	if child.Parent.SpanID == "" || child.Parent.SpanID == "0000000000000000" {
+		t.Errorf("expected parent span ID")
+	}
+	if got, expected := child.Status.Code, "Error"; got != expected {
+		t.Errorf("got status code %s, expected %s", got, expected)
+	}
+	if got, expected := len(child.Events), 2; got != expected { // "retry" and "exception".
+		t.Errorf("got %d events, expected %d", got, expected)
+	}
+
+	if got, expected := spans["aws_test.Create"].Status.Code, "Unset"; got != expected {
+		t.Errorf("got status code %s, expected %s", got, expected)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var n int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, expected := r.URL.Path, "/collector/v1/traces"; got != expected {
+			t.Errorf("got path %s, expected %s", got, expected)
+		}
+		if got, expected := r.Header.Get("Content-Type"), "application/x-protobuf"; got != expected {
+			t.Errorf("got Content-Type %s, expected %s", got, expected)
+		}
+
+		mu.Lock()
+		n++
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	exporter, err := newOTLPExporter(context.Background(), server.URL+"/collector/")
+
+	if err != nil {
+		t.Fatalf("creating exporter: %s", err)
+	}
+
+	recordSpans(t, exporter)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if got, expected := n, 2; got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+}
+
+func TestOTLPExporterOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		endpoint    string
+		expectError bool
+	}{
+		{endpoint: "http://localhost:4318"},
+		{endpoint: "https://collector.example.com/otlp/"},
+		{endpoint: "localhost:4318", expectError: true},
+		{endpoint: "grpc://localhost:4317", expectError: true},
+		{endpoint: "http://", expectError: true},
+		{endpoint: "http://%zz", expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.endpoint, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := otlpExporterOptions(testCase.endpoint)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Errorf("got error %v, expected error: %t", err, expected)
+			}
+		})
+	}
+}
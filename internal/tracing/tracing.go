@@ -0,0 +1,138 @@
+// Package tracing implements opt-in OpenTelemetry tracing of resource operations and AWS API calls.
+//
+// Tracing is enabled by setting one or both of the TF_AWS_TRACING_OTLP_ENDPOINT and TF_AWS_TRACING_FILE
+// environment variables. When neither is set all spans are no-ops.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+const (
+	// EnvVarOTLPEndpoint is the base URL of an OTLP/HTTP collector, e.g. "http://localhost:4318".
+	// Spans are sent to the collector's /v1/traces path.
+	EnvVarOTLPEndpoint = "TF_AWS_TRACING_OTLP_ENDPOINT"
+
+	// EnvVarFile is the path of a local file to which spans are appended,
+	// one JSON object per span.
+	EnvVarFile = "TF_AWS_TRACING_FILE"
+)
+
+const (
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+	serviceName         = "terraform-provider-aws"
+)
+
+// Span attribute keys.
+const (
+	AttrErrorCode     = attribute.Key("aws.error_code")
+	AttrOperation     = attribute.Key("aws.operation")
+	AttrRegion        = attribute.Key("aws.region")
+	AttrRequestID     = attribute.Key("aws.request_id")
+	AttrService       = attribute.Key("aws.service")
+	AttrAttempt       = attribute.Key("attempt")
+	AttrIsDataSource  = attribute.Key("terraform.data_source")
+	AttrResourceOp    = attribute.Key("terraform.operation")
+	AttrResourceType  = attribute.Key("terraform.type_name")
+	AttrObservedState = attribute.Key("terraform.observed_state")
+	AttrErrorMessage  = attribute.Key("error.message")
+)
+
+var tracerProvider *sdktrace.TracerProvider
+
+// Enabled returns whether tracing has been enabled by Init.
+func Enabled() bool {
+	return tracerProvider != nil
+}
+
+// Flush exports any buffered spans. It has no effect unless tracing is enabled.
+func Flush(ctx context.Context) error {
+	if tracerProvider == nil {
+		return nil
+	}
+
+	return tracerProvider.ForceFlush(ctx)
+}
+
+// Init configures the global tracer provider from the environment.
+// The returned function flushes any buffered spans and must be called before the process exits.
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	var opts []sdktrace.TracerProviderOption
+
+	if v := os.Getenv(EnvVarOTLPEndpoint); v != "" {
+		exporter, err := newOTLPExporter(ctx, v)
+
+		if err != nil {
+			return nil, fmt.Errorf("creating tracing OTLP exporter (%s): %w", v, err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	if v := os.Getenv(EnvVarFile); v != "" {
+		exporter, err := newFileExporter(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("opening tracing file (%s): %w", v, err)
+		}
+
+		// Write synchronously so that spans aren't lost if the provider process is killed.
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	}
+
+	if len(opts) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts = append(opts, sdktrace.WithResource(resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.ProviderVersion),
+	)))
+
+	tracerProvider = sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// Start starts a new span as a child of any span in ctx.
+func Start(ctx context.Context, name string, kind trace.SpanKind, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// End ends the span, recording err if non-nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// AddEvent adds an event to the span in ctx.
+func AddEvent(ctx context.Context, name string, attrs ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).AddEvent(name, trace.WithAttributes(attrs...))
+}
+
+// StartOperation starts a span for a Terraform resource or data source CRUD operation.
+func StartOperation(ctx context.Context, typeName, operation string, isDataSource bool) (context.Context, trace.Span) {
+	return Start(ctx, typeName+"."+operation, trace.SpanKindInternal,
+		AttrResourceType.String(typeName),
+		AttrResourceOp.String(operation),
+		AttrIsDataSource.Bool(isDataSource),
+	)
+}
@@ -5,20 +5,34 @@ import (
 	"flag"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 func main() {
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	ctx := context.Background()
+
+	shutdownTracing, err := tracing.Init(ctx)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	if tracing.Enabled() {
+		serverFactory = flushTracingOnStop(serverFactory)
+	}
+
 	var serveOpts []tf5server.ServeOpt
 
 	if *debugFlag {
@@ -35,7 +49,33 @@ func main() {
 		serveOpts...,
 	)
 
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] Shutting down tracing: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
 }
+
+// flushTracingOnStop wraps a provider server factory so that buffered tracing spans are
+// exported when Terraform stops the provider, e.g. on interrupt, without waiting for Serve to return.
+func flushTracingOnStop(factory func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return tracingProviderServer{factory()}
+	}
+}
+
+type tracingProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s tracingProviderServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	resp, err := s.ProviderServer.StopProvider(ctx, req)
+
+	if err := tracing.Flush(ctx); err != nil {
+		log.Printf("[WARN] Flushing tracing: %s", err)
+	}
+
+	return resp, err
+}
@@ -6,6 +6,7 @@ const (
 	AttrEnabled     = "enabled"
 	AttrKMSKeyARN   = "kms_key_arn"
 	AttrName        = "name"
+	AttrRegion      = "region"
 	AttrTags        = "tags"
 	AttrTagsAll     = "tags_all"
 	AttrType        = "type"
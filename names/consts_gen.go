@@ -182,7 +182,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
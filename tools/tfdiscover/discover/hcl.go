@@ -0,0 +1,265 @@
+package discover
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Writer accumulates import blocks and resource configuration for discovered resources.
+//
+// Only arguments are written: computed-only and deprecated attributes are omitted,
+// as are sensitive attributes and optional arguments with zero or default values.
+// Of arguments that conflict with each other, only the first, in lexical order, is written.
+// The generated configuration is a starting point that must be reviewed with `terraform plan`.
+type Writer struct {
+	file  *hclwrite.File
+	names map[string]int
+}
+
+func NewWriter() *Writer {
+	return &Writer{
+		file:  hclwrite.NewEmptyFile(),
+		names: make(map[string]int),
+	}
+}
+
+// Add writes an import block and a resource block for the resource and returns the resource's local name.
+// The local name is derived from the resource's Name tag, if any, or from its ID.
+func (w *Writer) Add(typeName, importID string, r *schema.Resource, d *schema.ResourceData) string {
+	name := w.localName(typeName, d)
+	body := w.file.Body()
+
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: name},
+	})
+	block.SetAttributeValue("id", cty.StringVal(importID))
+
+	body.AppendNewline()
+
+	block = body.AppendNewBlock("resource", []string{typeName, name}).Body()
+	values := make(map[string]any, len(r.Schema))
+	for k := range r.Schema {
+		values[k] = d.Get(k)
+	}
+	writeBody(block, r.Schema, values)
+
+	return name
+}
+
+// Bytes returns the formatted configuration.
+func (w *Writer) Bytes() []byte {
+	return w.file.Bytes()
+}
+
+var invalidNameCharsRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// localName returns a unique, valid local name for a resource.
+func (w *Writer) localName(typeName string, d *schema.ResourceData) string {
+	name := Tags(d)["Name"]
+
+	if name == "" {
+		name = d.Id()
+	}
+
+	name = strings.Trim(invalidNameCharsRegexp.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z' || name[0] == '_') {
+		name = "r_" + name
+	}
+
+	key := typeName + "." + name
+	w.names[key]++
+
+	if n := w.names[key]; n > 1 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+
+	return name
+}
+
+// writeBody writes the arguments in the schema that have values worth configuring.
+// Attributes are written before nested blocks.
+func writeBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]any) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	written := make(map[string]bool)
+	var blocks []*hclwrite.Block
+
+	for _, k := range keys {
+		v := s[k]
+
+		if !isArgument(v) || conflicts(v, written) {
+			continue
+		}
+
+		value := values[k]
+
+		if isDefaultValue(v, value) {
+			continue
+		}
+
+		if elem, ok := v.Elem.(*schema.Resource); ok && (v.Type == schema.TypeList || v.Type == schema.TypeSet) {
+			var n int
+
+			for _, m := range elements(value) {
+				m, ok := m.(map[string]any)
+
+				if !ok {
+					continue
+				}
+
+				block := hclwrite.NewBlock(k, nil)
+				writeBody(block.Body(), elem.Schema, m)
+
+				// Skip blocks whose arguments all have default values.
+				if len(block.Body().Attributes()) > 0 || len(block.Body().Blocks()) > 0 {
+					blocks = append(blocks, block)
+					n++
+				}
+			}
+
+			if n > 0 {
+				written[k] = true
+			}
+
+			continue
+		}
+
+		if ctyValue, ok := toCtyValue(value); ok {
+			body.SetAttributeValue(k, ctyValue)
+			written[k] = true
+		}
+	}
+
+	for _, block := range blocks {
+		body.AppendBlock(block)
+	}
+}
+
+// isArgument returns whether the attribute can be configured and should be written.
+func isArgument(v *schema.Schema) bool {
+	return (v.Required || v.Optional) && v.Deprecated == "" && !v.Sensitive
+}
+
+// conflicts returns whether the attribute conflicts with an attribute that has been written at the same level.
+func conflicts(v *schema.Schema, written map[string]bool) bool {
+	for _, list := range [][]string{v.ConflictsWith, v.ExactlyOneOf} {
+		for _, address := range list {
+			// Addresses are absolute, e.g. "block.0.attr"; compare the final element.
+			k := address[strings.LastIndex(address, ".")+1:]
+
+			if written[k] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isDefaultValue returns whether value is the attribute's default, or the zero value if it has no default.
+func isDefaultValue(v *schema.Schema, value any) bool {
+	if v.Required {
+		return false
+	}
+
+	if v.Default != nil {
+		return reflect.DeepEqual(v.Default, value)
+	}
+
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case int:
+		return value == 0
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	default:
+		return len(elements(value)) == 0
+	}
+}
+
+// elements returns the elements of a list, set or map value.
+func elements(value any) []any {
+	switch value := value.(type) {
+	case []any:
+		return value
+	case *schema.Set:
+		return value.List()
+	case map[string]any:
+		l := make([]any, 0, len(value))
+		for _, v := range value {
+			l = append(l, v)
+		}
+		return l
+	default:
+		return nil
+	}
+}
+
+// toCtyValue converts a value read from ResourceData to a cty.Value for writing.
+// Lists and sets are written as tuples and maps as objects.
+func toCtyValue(value any) (cty.Value, bool) {
+	switch value := value.(type) {
+	case string:
+		return cty.StringVal(value), true
+	case int:
+		return cty.NumberIntVal(int64(value)), true
+	case float64:
+		return cty.NumberFloatVal(value), true
+	case bool:
+		return cty.BoolVal(value), true
+	case []any, *schema.Set:
+		var vals []cty.Value
+
+		for _, v := range elements(value) {
+			v, ok := toCtyValue(v)
+
+			if !ok {
+				return cty.NilVal, false
+			}
+
+			vals = append(vals, v)
+		}
+
+		return cty.TupleVal(vals), true
+	case map[string]any:
+		vals := make(map[string]cty.Value, len(value))
+
+		for k, v := range value {
+			v, ok := toCtyValue(v)
+
+			if !ok {
+				return cty.NilVal, false
+			}
+
+			vals[k] = v
+		}
+
+		return cty.ObjectVal(vals), true
+	default:
+		return cty.NilVal, false
+	}
+}
@@ -0,0 +1,178 @@
+package discover
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_block": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enable_dns_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"instance_tenancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "default",
+			},
+			"ipv4_ipam_pool_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"cidr_block"},
+			},
+			"legacy": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Use cidr_block instead.",
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"cidr_block":         "10.0.0.0/16",
+		"enable_dns_support": false,
+		"instance_tenancy":   "default",
+		"ipv4_ipam_pool_id":  "ipam-pool-12345678",
+		"legacy":             "x",
+		"password":           "secret",
+		"rule": []any{
+			map[string]any{"port": 443, "protocol": "tcp"},
+			map[string]any{"port": 0, "protocol": ""},
+		},
+		"security_group_ids": []any{"sg-12345678"},
+		"tags":               map[string]any{"Name": "Main VPC", "aws:cloudformation:stack-name": "stack"},
+	})
+	d.SetId("vpc-12345678")
+
+	w := NewWriter()
+	w.Add("aws_vpc", "vpc-12345678", r, d)
+	w.Add("aws_vpc", "vpc-12345678@us-west-2", r, d)
+
+	expected := `import {
+  to = aws_vpc.main_vpc
+  id = "vpc-12345678"
+}
+
+resource "aws_vpc" "main_vpc" {
+  cidr_block         = "10.0.0.0/16"
+  enable_dns_support = false
+  security_group_ids = ["sg-12345678"]
+  tags = {
+    Name                            = "Main VPC"
+    "aws:cloudformation:stack-name" = "stack"
+  }
+  rule {
+    port     = 443
+    protocol = "tcp"
+  }
+}
+
+import {
+  to = aws_vpc.main_vpc_2
+  id = "vpc-12345678@us-west-2"
+}
+
+resource "aws_vpc" "main_vpc_2" {
+  cidr_block         = "10.0.0.0/16"
+  enable_dns_support = false
+  security_group_ids = ["sg-12345678"]
+  tags = {
+    Name                            = "Main VPC"
+    "aws:cloudformation:stack-name" = "stack"
+  }
+  rule {
+    port     = 443
+    protocol = "tcp"
+  }
+}
+`
+
+	if diff := cmp.Diff(string(w.Bytes()), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWriterLocalName(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	testCases := []struct {
+		ID       string
+		Expected string
+	}{
+		{ID: "my-bucket.example.com", Expected: "my-bucket_example_com"},
+		{ID: "/aws/lambda/test", Expected: "aws_lambda_test"},
+		{ID: "123abc", Expected: "r_123abc"},
+		{ID: "123abc", Expected: "r_123abc_2"},
+	}
+
+	w := NewWriter()
+
+	for _, testCase := range testCases {
+		d := r.Data(nil)
+		d.SetId(testCase.ID)
+
+		if got := w.Add("aws_test", testCase.ID, r, d); got != testCase.Expected {
+			t.Errorf("%s: got %q, expected %q", testCase.ID, got, testCase.Expected)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package discover
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// Read reads an existing resource the way that `terraform import` does, by running the resource's
+// importer followed by its Read handler. It returns nil if the resource doesn't exist.
+// The import ID may have a Region suffix, which the provider's importer wrapper removes.
+func Read(ctx context.Context, r *schema.Resource, meta any, importID string) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(importID)
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		ds, err := r.Importer.StateContext(ctx, d, meta)
+
+		if err != nil {
+			return nil, fmt.Errorf("importing: %w", err)
+		}
+
+		// Importers that return more than one resource return the primary resource first.
+		if len(ds) == 0 {
+			return nil, nil
+		}
+
+		d = ds[0]
+	}
+
+	state := d.State()
+
+	if state == nil {
+		return nil, nil
+	}
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, state, meta)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading: %w", sdkdiag.DiagnosticsError(diags))
+	}
+
+	if state == nil || state.ID == "" {
+		return nil, nil
+	}
+
+	return r.Data(state), nil
+}
+
+// Tags returns the resource's tags, preferring tags_all, which includes any provider default tags.
+func Tags(d *schema.ResourceData) map[string]string {
+	tags := make(map[string]string)
+
+	for _, k := range []string{"tags_all", "tags"} {
+		v, ok := d.GetOk(k)
+
+		if !ok {
+			continue
+		}
+
+		for k, v := range v.(map[string]any) {
+			tags[k], _ = v.(string)
+		}
+
+		break
+	}
+
+	return tags
+}
@@ -0,0 +1,239 @@
+package discover
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// ResourceType describes how existing resources of a Terraform resource type are found and imported.
+type ResourceType struct {
+	TypeName string
+
+	// The ARN service and the prefix of the ARN resource, e.g. "ec2" and "vpc/".
+	// An empty prefix matches ARN resources that contain neither ':' nor '/'.
+	ARNService        string
+	ARNResourcePrefix string
+
+	// The resource type as known to the Resource Groups Tagging API and to Resource Explorer, e.g. "ec2:vpc".
+	// An empty value means that the source can't find resources of this type.
+	TaggingAPIType       string
+	ResourceExplorerType string
+
+	// Global is true for resources of global services, which are found once and imported without a Region.
+	Global bool
+
+	// ImportID returns the import ID of the resource with the specified ARN.
+	ImportID func(arn.ARN) string
+
+	// List optionally lists the ARNs of resources in a Region using the service's own APIs.
+	List Lister
+}
+
+// Matches returns whether the ARN identifies a resource of this type.
+func (rt *ResourceType) Matches(v arn.ARN) bool {
+	if v.Service != rt.ARNService {
+		return false
+	}
+
+	if rt.ARNResourcePrefix == "" {
+		return !strings.ContainsAny(v.Resource, ":/")
+	}
+
+	return strings.HasPrefix(v.Resource, rt.ARNResourcePrefix)
+}
+
+// resourceTypes is the set of resource types supported by the discovery tool, keyed by type name.
+var resourceTypes = map[string]*ResourceType{}
+
+func register(rt *ResourceType) {
+	if rt.ImportID == nil {
+		rt.ImportID = idAfterPrefix(rt.ARNResourcePrefix)
+	}
+
+	resourceTypes[rt.TypeName] = rt
+}
+
+// LookupResourceType returns the discovery configuration for the specified Terraform resource type.
+func LookupResourceType(typeName string) (*ResourceType, error) {
+	rt, ok := resourceTypes[typeName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource type %s is not supported; supported types: %s", typeName, strings.Join(ResourceTypeNames(), ", "))
+	}
+
+	return rt, nil
+}
+
+// ResourceTypeNames returns the sorted names of the supported resource types.
+func ResourceTypeNames() []string {
+	names := make([]string, 0, len(resourceTypes))
+
+	for k := range resourceTypes {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// idAfterPrefix returns an ImportID function that returns the ARN resource after the prefix,
+// up to any qualifier, e.g. "vpc/vpc-12345678" returns "vpc-12345678".
+func idAfterPrefix(prefix string) func(arn.ARN) string {
+	return func(v arn.ARN) string {
+		id := strings.TrimPrefix(v.Resource, prefix)
+
+		if i := strings.Index(id, ":"); i >= 0 {
+			id = id[:i]
+		}
+
+		return id
+	}
+}
+
+// idLastPathElement returns the last element of a path-style ARN resource, e.g. "role/service/my-role" returns "my-role".
+func idLastPathElement(v arn.ARN) string {
+	return v.Resource[strings.LastIndex(v.Resource, "/")+1:]
+}
+
+// idARN returns the ARN itself.
+func idARN(v arn.ARN) string {
+	return v.String()
+}
+
+func init() {
+	register(&ResourceType{
+		TypeName:             "aws_cloudwatch_log_group",
+		ARNService:           "logs",
+		ARNResourcePrefix:    "log-group:",
+		TaggingAPIType:       "logs:log-group",
+		ResourceExplorerType: "logs:log-group",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_db_instance",
+		ARNService:           "rds",
+		ARNResourcePrefix:    "db:",
+		TaggingAPIType:       "rds:db",
+		ResourceExplorerType: "rds:db",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_dynamodb_table",
+		ARNService:           "dynamodb",
+		ARNResourcePrefix:    "table/",
+		TaggingAPIType:       "dynamodb:table",
+		ResourceExplorerType: "dynamodb:table",
+		ImportID:             idLastPathElement,
+	})
+	register(&ResourceType{
+		TypeName:             "aws_ecr_repository",
+		ARNService:           "ecr",
+		ARNResourcePrefix:    "repository/",
+		TaggingAPIType:       "ecr:repository",
+		ResourceExplorerType: "ecr:repository",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_ecs_cluster",
+		ARNService:           "ecs",
+		ARNResourcePrefix:    "cluster/",
+		TaggingAPIType:       "ecs:cluster",
+		ResourceExplorerType: "ecs:cluster",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_iam_role",
+		ARNService:           "iam",
+		ARNResourcePrefix:    "role/",
+		ResourceExplorerType: "iam:role",
+		Global:               true,
+		ImportID:             idLastPathElement,
+		List:                 listIAMRoles,
+	})
+	register(&ResourceType{
+		TypeName:             "aws_instance",
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "instance/",
+		TaggingAPIType:       "ec2:instance",
+		ResourceExplorerType: "ec2:instance",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_internet_gateway",
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "internet-gateway/",
+		TaggingAPIType:       "ec2:internet-gateway",
+		ResourceExplorerType: "ec2:internet-gateway",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_kms_key",
+		ARNService:           "kms",
+		ARNResourcePrefix:    "key/",
+		TaggingAPIType:       "kms:key",
+		ResourceExplorerType: "kms:key",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_lambda_function",
+		ARNService:           "lambda",
+		ARNResourcePrefix:    "function:",
+		TaggingAPIType:       "lambda:function",
+		ResourceExplorerType: "lambda:function",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_rds_cluster",
+		ARNService:           "rds",
+		ARNResourcePrefix:    "cluster:",
+		TaggingAPIType:       "rds:cluster",
+		ResourceExplorerType: "rds:cluster",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_route_table",
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "route-table/",
+		TaggingAPIType:       "ec2:route-table",
+		ResourceExplorerType: "ec2:route-table",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_s3_bucket",
+		ARNService:           "s3",
+		TaggingAPIType:       "s3",
+		ResourceExplorerType: "s3:bucket",
+		ImportID:             idAfterPrefix(""),
+		List:                 listS3Buckets,
+	})
+	register(&ResourceType{
+		TypeName:             "aws_secretsmanager_secret",
+		ARNService:           "secretsmanager",
+		ARNResourcePrefix:    "secret:",
+		TaggingAPIType:       "secretsmanager:secret",
+		ResourceExplorerType: "secretsmanager:secret",
+		ImportID:             idARN,
+	})
+	register(&ResourceType{
+		TypeName:             "aws_security_group",
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "security-group/",
+		TaggingAPIType:       "ec2:security-group",
+		ResourceExplorerType: "ec2:security-group",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_sns_topic",
+		ARNService:           "sns",
+		TaggingAPIType:       "sns",
+		ResourceExplorerType: "sns:topic",
+		ImportID:             idARN,
+	})
+	register(&ResourceType{
+		TypeName:             "aws_subnet",
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "subnet/",
+		TaggingAPIType:       "ec2:subnet",
+		ResourceExplorerType: "ec2:subnet",
+	})
+	register(&ResourceType{
+		TypeName:             "aws_vpc",
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "vpc/",
+		TaggingAPIType:       "ec2:vpc",
+		ResourceExplorerType: "ec2:vpc",
+	})
+}
@@ -0,0 +1,110 @@
+package discover
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+func TestResourceTypeImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ARN      string
+		TypeName string
+		Expected string
+	}{
+		{
+			ARN:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678",
+			TypeName: "aws_vpc",
+			Expected: "vpc-12345678",
+		},
+		{
+			ARN:      "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/test:*",
+			TypeName: "aws_cloudwatch_log_group",
+			Expected: "/aws/lambda/test",
+		},
+		{
+			ARN:      "arn:aws:iam::123456789012:role/service-role/test-role",
+			TypeName: "aws_iam_role",
+			Expected: "test-role",
+		},
+		{
+			ARN:      "arn:aws:s3:::test-bucket",
+			TypeName: "aws_s3_bucket",
+			Expected: "test-bucket",
+		},
+		{
+			ARN:      "arn:aws:sns:us-west-2:123456789012:test-topic",
+			TypeName: "aws_sns_topic",
+			Expected: "arn:aws:sns:us-west-2:123456789012:test-topic",
+		},
+		{
+			ARN:      "arn:aws:rds:us-west-2:123456789012:db:test-db",
+			TypeName: "aws_db_instance",
+			Expected: "test-db",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := arn.Parse(testCase.ARN)
+
+			if err != nil {
+				t.Fatalf("parsing ARN: %s", err)
+			}
+
+			var matches []string
+			for _, typeName := range ResourceTypeNames() {
+				if resourceTypes[typeName].Matches(v) {
+					matches = append(matches, typeName)
+				}
+			}
+
+			if len(matches) != 1 || matches[0] != testCase.TypeName {
+				t.Fatalf("ARN matches %v, expected %s", matches, testCase.TypeName)
+			}
+
+			if got := resourceTypes[testCase.TypeName].ImportID(v); got != testCase.Expected {
+				t.Errorf("got import ID %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceTypeMatchesNot(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{
+		"arn:aws:s3:::test-bucket/object",
+		"arn:aws:sns:us-west-2:123456789012:test-topic:8a21d249-4329-4871-acc6-7be709c6ea7f",
+		"arn:aws:ec2:us-west-2:123456789012:network-interface/eni-12345678",
+	} {
+		v, err := arn.Parse(v)
+
+		if err != nil {
+			t.Fatalf("parsing ARN: %s", err)
+		}
+
+		for _, typeName := range ResourceTypeNames() {
+			if resourceTypes[typeName].Matches(v) {
+				t.Errorf("%s matches %s", v, typeName)
+			}
+		}
+	}
+}
+
+func TestLookupResourceType(t *testing.T) {
+	t.Parallel()
+
+	if _, err := LookupResourceType("aws_vpc"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if _, err := LookupResourceType("aws_not_supported"); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -0,0 +1,268 @@
+package discover
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Source finds the ARNs of existing resources of the specified types in a Region.
+// A source may apply the tag filters itself; discovered resources are filtered by tag again after they are read.
+type Source interface {
+	Name() string
+	ARNs(ctx context.Context, rts []*ResourceType, region string, tags TagFilters) ([]string, error)
+}
+
+// taggingAPIMaxResourceTypeFilters is the maximum number of resource type filters in a GetResources request.
+const taggingAPIMaxResourceTypeFilters = 100
+
+// TaggingAPISource finds resources using the Resource Groups Tagging API.
+// Only resources that have, or have had, tags are found.
+type TaggingAPISource struct {
+	Conn resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+}
+
+func (s *TaggingAPISource) Name() string {
+	return "Resource Groups Tagging API"
+}
+
+func (s *TaggingAPISource) ARNs(ctx context.Context, rts []*ResourceType, _ string, tags TagFilters) ([]string, error) {
+	var filters []string
+
+	for _, rt := range rts {
+		if rt.TaggingAPIType != "" {
+			filters = append(filters, rt.TaggingAPIType)
+		}
+	}
+
+	var tagFilters []*resourcegroupstaggingapi.TagFilter
+
+	for k, v := range tags {
+		tagFilter := &resourcegroupstaggingapi.TagFilter{Key: aws.String(k)}
+
+		if v != nil {
+			tagFilter.Values = aws.StringSlice([]string{*v})
+		}
+
+		tagFilters = append(tagFilters, tagFilter)
+	}
+
+	var arns []string
+
+	for len(filters) > 0 {
+		n := len(filters)
+		if n > taggingAPIMaxResourceTypeFilters {
+			n = taggingAPIMaxResourceTypeFilters
+		}
+
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceTypeFilters: aws.StringSlice(filters[:n]),
+			TagFilters:          tagFilters,
+		}
+		filters = filters[n:]
+
+		err := s.Conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				if v != nil {
+					arns = append(arns, aws.StringValue(v.ResourceARN))
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("getting resources: %w", err)
+		}
+	}
+
+	return arns, nil
+}
+
+// ResourceExplorerSource finds resources using Resource Explorer.
+// The Region must have a Resource Explorer index and a default view.
+type ResourceExplorerSource struct {
+	Client resourceexplorer2.SearchAPIClient
+}
+
+func (s *ResourceExplorerSource) Name() string {
+	return "Resource Explorer"
+}
+
+func (s *ResourceExplorerSource) ARNs(ctx context.Context, rts []*ResourceType, region string, tags TagFilters) ([]string, error) {
+	var arns []string
+
+	for _, rt := range rts {
+		if rt.ResourceExplorerType == "" {
+			continue
+		}
+
+		query := ResourceExplorerQuery(rt, region, tags)
+		pages := resourceexplorer2.NewSearchPaginator(s.Client, &resourceexplorer2.SearchInput{
+			QueryString: aws.String(query),
+		})
+
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("searching for %q: %w", query, err)
+			}
+
+			for _, v := range page.Resources {
+				arns = append(arns, aws.StringValue(v.Arn))
+			}
+		}
+	}
+
+	return arns, nil
+}
+
+// ResourceExplorerQuery returns the Resource Explorer query string for resources of the specified type.
+// Resource Explorer ORs filters on the same property, so only the first tag filter is included in the query.
+func ResourceExplorerQuery(rt *ResourceType, region string, tags TagFilters) string {
+	terms := []string{"resourcetype:" + rt.ResourceExplorerType}
+
+	if !rt.Global {
+		terms = append(terms, "region:"+region)
+	}
+
+	if len(tags) > 0 {
+		keys := make([]string, 0, len(tags))
+		for k := range tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		if k, v := keys[0], tags[keys[0]]; v == nil {
+			terms = append(terms, "tag.key:"+k)
+		} else {
+			terms = append(terms, "tag:"+k+"="+*v)
+		}
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// Lister lists the ARNs of resources in the Region of the specified provider Meta.
+type Lister func(ctx context.Context, meta *conns.AWSClient) ([]string, error)
+
+// ListSource finds resources using the per-service list APIs of resource types that have a Lister.
+type ListSource struct {
+	Meta *conns.AWSClient
+}
+
+func (s *ListSource) Name() string {
+	return "service list APIs"
+}
+
+func (s *ListSource) ARNs(ctx context.Context, rts []*ResourceType, _ string, _ TagFilters) ([]string, error) {
+	var arns []string
+
+	for _, rt := range rts {
+		if rt.List == nil {
+			continue
+		}
+
+		v, err := rt.List(ctx, s.Meta)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing %s resources: %w", rt.TypeName, err)
+		}
+
+		arns = append(arns, v...)
+	}
+
+	return arns, nil
+}
+
+func listIAMRoles(ctx context.Context, meta *conns.AWSClient) ([]string, error) {
+	var arns []string
+
+	err := meta.IAMConn().ListRolesPagesWithContext(ctx, &iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Roles {
+			if v != nil {
+				arns = append(arns, aws.StringValue(v.Arn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return arns, nil
+}
+
+// listS3Buckets lists the buckets in the provider Meta's Region.
+func listS3Buckets(ctx context.Context, meta *conns.AWSClient) ([]string, error) {
+	conn := meta.S3Conn()
+
+	output, err := conn.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var arns []string
+
+	for _, v := range output.Buckets {
+		if v == nil {
+			continue
+		}
+
+		name := aws.StringValue(v.Name)
+
+		location, err := conn.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
+			Bucket: aws.String(name),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("getting S3 Bucket (%s) location: %w", name, err)
+		}
+
+		if bucketRegion(aws.StringValue(location.LocationConstraint)) != meta.Region {
+			continue
+		}
+
+		arns = append(arns, arn.ARN{
+			Partition: meta.Partition,
+			Service:   s3.ServiceName,
+			Resource:  name,
+		}.String())
+	}
+
+	return arns, nil
+}
+
+// bucketRegion returns the Region corresponding to an S3 bucket location constraint.
+func bucketRegion(locationConstraint string) string {
+	switch locationConstraint {
+	case "":
+		return "us-east-1"
+	case s3.BucketLocationConstraintEu:
+		return "eu-west-1"
+	default:
+		return locationConstraint
+	}
+}
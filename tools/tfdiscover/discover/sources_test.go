@@ -0,0 +1,143 @@
+package discover
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestTaggingAPISource runs against a stand-in Resource Groups Tagging API endpoint.
+func TestTaggingAPISource(t *testing.T) {
+	t.Parallel()
+
+	var requests []resourcegroupstaggingapi.GetResourcesInput
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, expected := r.Header.Get("X-Amz-Target"), "ResourceGroupsTaggingAPI_20170126.GetResources"; got != expected {
+			t.Errorf("got target %s, expected %s", got, expected)
+		}
+
+		var input resourcegroupstaggingapi.GetResourcesInput
+
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			t.Errorf("decoding request: %s", err)
+		}
+
+		requests = append(requests, input)
+
+		var output resourcegroupstaggingapi.GetResourcesOutput
+
+		if aws.StringValue(input.PaginationToken) == "" {
+			output.PaginationToken = aws.String("page-2")
+			output.ResourceTagMappingList = []*resourcegroupstaggingapi.ResourceTagMapping{
+				{ResourceARN: aws.String("arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678")},
+			}
+		} else {
+			output.ResourceTagMappingList = []*resourcegroupstaggingapi.ResourceTagMapping{
+				{ResourceARN: aws.String("arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678")},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		if err := json.NewEncoder(w).Encode(output); err != nil {
+			t.Errorf("encoding response: %s", err)
+		}
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	source := &TaggingAPISource{Conn: resourcegroupstaggingapi.New(sess)}
+	rts := []*ResourceType{resourceTypes["aws_vpc"], resourceTypes["aws_subnet"], resourceTypes["aws_iam_role"]}
+	env := "production"
+
+	got, err := source.ARNs(context.Background(), rts, "us-west-2", TagFilters{"Environment": &env})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678",
+		"arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, expected := len(requests), 2; got != expected {
+		t.Fatalf("got %d requests, expected %d", got, expected)
+	}
+
+	// IAM roles aren't supported by the Tagging API.
+	if diff := cmp.Diff(aws.StringValueSlice(requests[0].ResourceTypeFilters), []string{"ec2:vpc", "ec2:subnet"}); diff != "" {
+		t.Errorf("unexpected resource type filters (+wanted, -got): %s", diff)
+	}
+
+	if got := requests[0].TagFilters; len(got) != 1 || aws.StringValue(got[0].Key) != "Environment" || len(got[0].Values) != 1 || aws.StringValue(got[0].Values[0]) != env {
+		t.Errorf("unexpected tag filters: %v", got)
+	}
+}
+
+func TestResourceExplorerQuery(t *testing.T) {
+	t.Parallel()
+
+	env := "production"
+
+	testCases := []struct {
+		Name     string
+		TypeName string
+		Tags     TagFilters
+		Expected string
+	}{
+		{
+			Name:     "regional",
+			TypeName: "aws_vpc",
+			Expected: "resourcetype:ec2:vpc region:us-west-2",
+		},
+		{
+			Name:     "global",
+			TypeName: "aws_iam_role",
+			Expected: "resourcetype:iam:role",
+		},
+		{
+			Name:     "tag value",
+			TypeName: "aws_vpc",
+			Tags:     TagFilters{"Environment": &env, "Owner": nil},
+			Expected: "resourcetype:ec2:vpc region:us-west-2 tag:Environment=production",
+		},
+		{
+			Name:     "tag key",
+			TypeName: "aws_vpc",
+			Tags:     TagFilters{"Owner": nil},
+			Expected: "resourcetype:ec2:vpc region:us-west-2 tag.key:Owner",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ResourceExplorerQuery(resourceTypes[testCase.TypeName], "us-west-2", testCase.Tags); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package discover
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TagFilters selects resources by tag. A resource matches if it has all of the tag keys
+// and, for filters with a value, the tag has that value.
+type TagFilters map[string]*string
+
+// String implements flag.Value.
+func (f TagFilters) String() string {
+	var s []string
+
+	for k, v := range f {
+		if v == nil {
+			s = append(s, k)
+		} else {
+			s = append(s, k+"="+*v)
+		}
+	}
+
+	sort.Strings(s)
+
+	return strings.Join(s, ",")
+}
+
+// Set implements flag.Value, parsing "key" or "key=value".
+func (f TagFilters) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+
+	if k == "" {
+		return fmt.Errorf("invalid tag filter %q: expected key or key=value", s)
+	}
+
+	if ok {
+		f[k] = &v
+	} else {
+		f[k] = nil
+	}
+
+	return nil
+}
+
+// Match returns whether the tags satisfy all the filters.
+func (f TagFilters) Match(tags map[string]string) bool {
+	for k, want := range f {
+		got, ok := tags[k]
+
+		if !ok {
+			return false
+		}
+
+		if want != nil && got != *want {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,59 @@
+package discover
+
+import (
+	"testing"
+)
+
+func TestTagFilters(t *testing.T) {
+	t.Parallel()
+
+	filters := TagFilters{}
+
+	for _, v := range []string{"Environment=production", "Owner", "Empty="} {
+		if err := filters.Set(v); err != nil {
+			t.Fatalf("setting %q: %s", v, err)
+		}
+	}
+
+	if err := filters.Set("=value"); err == nil {
+		t.Errorf("expected error")
+	}
+
+	if got, expected := filters.String(), "Empty=,Environment=production,Owner"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	testCases := []struct {
+		Name     string
+		Tags     map[string]string
+		Expected bool
+	}{
+		{
+			Name:     "all match",
+			Tags:     map[string]string{"Environment": "production", "Owner": "team-a", "Empty": "", "Other": "x"},
+			Expected: true,
+		},
+		{
+			Name: "value mismatch",
+			Tags: map[string]string{"Environment": "staging", "Owner": "team-a", "Empty": ""},
+		},
+		{
+			Name: "key missing",
+			Tags: map[string]string{"Environment": "production", "Empty": ""},
+		},
+		{
+			Name: "no tags",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := filters.Match(testCase.Tags); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfdiscover/discover"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	sourceList             = "list"
+	sourceResourceExplorer = "explorer"
+	sourceTaggingAPI       = "tagging"
+)
+
+// stringsFlag is a repeatable flag whose values may also be comma-separated.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(s string) error {
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
+		}
+	}
+
+	return nil
+}
+
+var (
+	endpoints   stringsFlag
+	local       = flag.Bool("local", false, "Configure the provider for a local stand-in API endpoint: skip credentials, account ID, Region and metadata API checks")
+	outputFile  = flag.String("out", "", "File to write to (default stdout)")
+	regions     stringsFlag
+	sources     stringsFlag
+	tagFilters  = discover.TagFilters{}
+	types       stringsFlag
+	sourceNames = []string{sourceTaggingAPI, sourceResourceExplorer, sourceList}
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\ttfdiscover -type <resource-type>[,<resource-type>...] [-region <region>...] [-tag <key>[=<value>]...] [-source <source>...] [-endpoint <service>=<url>...] [-local] [-out <file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Supported resource types:\n\t%s\n\n", strings.Join(discover.ResourceTypeNames(), "\n\t"))
+	fmt.Fprintf(os.Stderr, "Only the resource types above are supported. Each needs its ARN format and import ID registered in the\n")
+	fmt.Fprintf(os.Stderr, "tool, which can't be derived from the provider, so other resource types in the provider can't be discovered.\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Var(&endpoints, "endpoint", "Service endpoint override, as <service>=<url>, where <service> is a key of the provider's endpoints block (repeatable)")
+	flag.Var(&regions, "region", "Region to search (repeatable; default the provider's Region)")
+	flag.Var(&sources, "source", fmt.Sprintf("Source of resources: %s (repeatable; default tagging and list)", strings.Join(sourceNames, ", ")))
+	flag.Var(tagFilters, "tag", "Only discover resources with the tag, as <key> or <key>=<value> (repeatable)")
+	flag.Var(&types, "type", "Resource type to discover (repeatable)")
+	flag.Usage = usage
+	flag.Parse()
+
+	log.SetFlags(0)
+
+	if len(types) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if len(sources) == 0 {
+		sources = stringsFlag{sourceTaggingAPI, sourceList}
+	}
+
+	ctx := context.Background()
+
+	if err := run(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context) error {
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	var rts []*discover.ResourceType
+
+	for _, v := range types {
+		rt, err := discover.LookupResourceType(v)
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := p.ResourcesMap[v]; !ok {
+			return fmt.Errorf("resource type %s is not a Terraform Plugin SDK resource", v)
+		}
+
+		rts = append(rts, rt)
+	}
+
+	config, err := providerConfig()
+
+	if err != nil {
+		return err
+	}
+
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		return fmt.Errorf("configuring provider: %s", diags[0].Summary)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	if len(regions) == 0 {
+		regions = stringsFlag{meta.Region}
+	}
+
+	w := discover.NewWriter()
+	seen := make(map[string]bool)
+
+	for i, region := range regions {
+		regionalMeta, err := meta.RegionalClient(ctx, region)
+
+		if err != nil {
+			return err
+		}
+
+		// Resources of global services are discovered in the first Region only.
+		var regionalTypes []*discover.ResourceType
+		for _, rt := range rts {
+			if !rt.Global || i == 0 {
+				regionalTypes = append(regionalTypes, rt)
+			}
+		}
+
+		for _, source := range newSources(regionalMeta) {
+			arns, err := source.ARNs(ctx, regionalTypes, region, tagFilters)
+
+			if err != nil {
+				log.Printf("[WARN] %s (%s): %s", source.Name(), region, err)
+				continue
+			}
+
+			for _, v := range arns {
+				if seen[v] {
+					continue
+				}
+				seen[v] = true
+
+				if err := add(ctx, w, p.ResourcesMap, meta, regionalTypes, region, v); err != nil {
+					log.Printf("[WARN] %s: %s", v, err)
+				}
+			}
+		}
+	}
+
+	if *outputFile == "" {
+		_, err = os.Stdout.Write(w.Bytes())
+
+		return err
+	}
+
+	return os.WriteFile(*outputFile, w.Bytes(), 0644)
+}
+
+// add reads the resource with the specified ARN and, if it matches the tag filters, writes its configuration.
+func add(ctx context.Context, w *discover.Writer, resources map[string]*schema.Resource, meta *conns.AWSClient, rts []*discover.ResourceType, region, v string) error {
+	resourceARN, err := arn.Parse(v)
+
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range rts {
+		if !rt.Matches(resourceARN) {
+			continue
+		}
+
+		importID := rt.ImportID(resourceARN)
+
+		if !rt.Global && region != meta.Region {
+			importID += conns.ImportIDRegionSeparator + region
+		}
+
+		r := resources[rt.TypeName]
+		d, err := discover.Read(ctx, r, meta, importID)
+
+		if err != nil {
+			return fmt.Errorf("%s (%s): %w", rt.TypeName, importID, err)
+		}
+
+		if d == nil || !tagFilters.Match(discover.Tags(d)) {
+			return nil
+		}
+
+		name := w.Add(rt.TypeName, importID, r, d)
+		log.Printf("[INFO] %s.%s: %s", rt.TypeName, name, importID)
+
+		return nil
+	}
+
+	return nil
+}
+
+func newSources(meta *conns.AWSClient) []discover.Source {
+	var s []discover.Source
+
+	for _, v := range sources {
+		switch v {
+		case sourceList:
+			s = append(s, &discover.ListSource{Meta: meta})
+		case sourceResourceExplorer:
+			s = append(s, &discover.ResourceExplorerSource{Client: meta.ResourceExplorer2Client()})
+		case sourceTaggingAPI:
+			s = append(s, &discover.TaggingAPISource{Conn: meta.ResourceGroupsTaggingAPIConn()})
+		}
+	}
+
+	return s
+}
+
+// providerConfig returns the provider configuration from the command line flags.
+// Other settings, such as credentials, are read by the provider from the environment.
+func providerConfig() (map[string]any, error) {
+	config := make(map[string]any)
+
+	for _, v := range sources {
+		if !slices.Contains(sourceNames, v) {
+			return nil, fmt.Errorf("invalid source %q: expected one of %s", v, strings.Join(sourceNames, ", "))
+		}
+	}
+
+	if len(regions) > 0 {
+		config["region"] = regions[0]
+	}
+
+	if len(endpoints) > 0 {
+		m := make(map[string]any)
+
+		for _, v := range endpoints {
+			service, url, ok := strings.Cut(v, "=")
+
+			if !ok {
+				return nil, fmt.Errorf("invalid endpoint %q: expected <service>=<url>", v)
+			}
+
+			m[service] = url
+		}
+
+		config["endpoints"] = []any{m}
+	}
+
+	if *local {
+		config["skip_credentials_validation"] = true
+		config["skip_metadata_api_check"] = "true"
+		config["skip_region_validation"] = true
+		config["skip_requesting_account_id"] = true
+
+		// Stand-in endpoints typically accept any credentials.
+		if os.Getenv("AWS_ACCESS_KEY_ID") == "" && os.Getenv("AWS_PROFILE") == "" {
+			config["access_key"] = "mock_access_key"
+			config["secret_key"] = "mock_secret_key"
+		}
+	}
+
+	return config, nil
+}